@@ -0,0 +1,58 @@
+package dto
+
+import "github.com/nicklaros/jalanrusak-be/core/domain/entities"
+
+// AuthEventDTO represents an authentication event in the activity timeline
+type AuthEventDTO struct {
+	EventType string `json:"event_type" example:"login"`
+	IPAddress string `json:"ip_address" example:"203.0.113.10"`
+	UserAgent string `json:"user_agent" example:"Mozilla/5.0"`
+	Success   bool   `json:"success" example:"true"`
+}
+
+// ActivityItemResponse represents a single activity timeline entry.
+// Exactly one of auth_event or report is present, depending on type.
+type ActivityItemResponse struct {
+	Type       string               `json:"type" example:"report" enums:"auth_event,report"`
+	OccurredAt string               `json:"occurred_at" example:"2025-10-20T10:00:00Z"`
+	AuthEvent  *AuthEventDTO        `json:"auth_event,omitempty"`
+	Report     *DamagedRoadResponse `json:"report,omitempty"`
+}
+
+// ActivityPaginationMeta represents pagination metadata for the activity timeline
+type ActivityPaginationMeta struct {
+	Limit   int  `json:"limit" example:"20"`
+	Offset  int  `json:"offset" example:"0"`
+	Page    int  `json:"page" example:"1"`
+	HasMore bool `json:"has_more" example:"false"`
+}
+
+// ActivityListResponse represents a paginated activity timeline
+type ActivityListResponse struct {
+	Data       []ActivityItemResponse `json:"data"`
+	Pagination ActivityPaginationMeta `json:"pagination"`
+}
+
+// FromActivityItem converts an ActivityItem entity to a response DTO
+func FromActivityItem(item *entities.ActivityItem) ActivityItemResponse {
+	response := ActivityItemResponse{
+		Type:       item.Type,
+		OccurredAt: FormatTimestamp(item.OccurredAt),
+	}
+
+	if item.AuthEvent != nil {
+		response.AuthEvent = &AuthEventDTO{
+			EventType: item.AuthEvent.EventType,
+			IPAddress: item.AuthEvent.IPAddress,
+			UserAgent: item.AuthEvent.UserAgent,
+			Success:   item.AuthEvent.Success,
+		}
+	}
+
+	if item.Report != nil {
+		report := FromDamagedRoad(item.Report)
+		response.Report = &report
+	}
+
+	return response
+}
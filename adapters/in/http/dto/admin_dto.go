@@ -0,0 +1,15 @@
+package dto
+
+// CleanupTokensResponse represents the result of a manual expired-token cleanup
+type CleanupTokensResponse struct {
+	RefreshTokensRemoved       int64 `json:"refresh_tokens_removed" example:"42"`
+	PasswordResetTokensRemoved int64 `json:"password_reset_tokens_removed" example:"3"`
+}
+
+// FeatureFlagsResponse represents the feature flag values the server is running with
+type FeatureFlagsResponse struct {
+	StrictCentroidCheck    bool `json:"strict_centroid_check" example:"false"`
+	LenientPhotoValidation bool `json:"lenient_photo_validation" example:"false"`
+	PublicReadMode         bool `json:"public_read_mode" example:"false"`
+	ResponseEnvelope       bool `json:"response_envelope" example:"false"`
+}
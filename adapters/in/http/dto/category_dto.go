@@ -0,0 +1,24 @@
+package dto
+
+import "github.com/nicklaros/jalanrusak-be/core/domain/entities"
+
+// CategoryResponse represents a report category in the response
+type CategoryResponse struct {
+	ID          string  `json:"id" example:"pothole"`
+	Name        string  `json:"name" example:"Pothole"`
+	Description *string `json:"description,omitempty" example:"Holes in the road surface"`
+}
+
+// CategoryListResponse represents the report category taxonomy in display order
+type CategoryListResponse struct {
+	Data []CategoryResponse `json:"data"`
+}
+
+// FromCategory converts a Category entity to a response DTO
+func FromCategory(category *entities.Category) CategoryResponse {
+	return CategoryResponse{
+		ID:          category.ID,
+		Name:        category.Name,
+		Description: category.Description,
+	}
+}
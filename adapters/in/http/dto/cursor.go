@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+// errInvalidCursor is returned for cursors that were not produced by EncodeReportCursor
+var errInvalidCursor = errors.New("invalid cursor")
+
+// EncodeReportCursor builds the opaque next_cursor pointing past the given report. Clients
+// must treat it as opaque; it is the base64url encoding of "<created_at>|<id>"
+func EncodeReportCursor(road *entities.DamagedRoad) string {
+	raw := road.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + road.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeReportCursor parses a cursor produced by EncodeReportCursor
+func DecodeReportCursor(cursor string) (*entities.ReportCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, errInvalidCursor
+	}
+
+	parsedTime, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	return &entities.ReportCursor{CreatedAt: parsedTime, ID: parsedID}, nil
+}
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+func TestReportCursorRoundTrip(t *testing.T) {
+	road := &entities.DamagedRoad{
+		ID:        uuid.New(),
+		CreatedAt: time.Date(2025, 10, 20, 17, 0, 0, 123456000, time.FixedZone("WIB", 7*60*60)),
+	}
+
+	cursor, err := DecodeReportCursor(EncodeReportCursor(road))
+	if err != nil {
+		t.Fatalf("DecodeReportCursor() error = %v", err)
+	}
+	if cursor.ID != road.ID || !cursor.CreatedAt.Equal(road.CreatedAt) {
+		t.Errorf("cursor = %+v, want the report's created_at %s and id %s", cursor, road.CreatedAt, road.ID)
+	}
+}
+
+func TestDecodeReportCursorRejectsForeignValues(t *testing.T) {
+	encode := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+	for _, cursor := range []string{
+		"not base64!",
+		encode("2025-10-20T10:00:00Z"),
+		encode("yesterday|" + uuid.NewString()),
+		encode("2025-10-20T10:00:00Z|42"),
+	} {
+		if _, err := DecodeReportCursor(cursor); err == nil {
+			t.Errorf("DecodeReportCursor(%q) accepted a cursor it did not produce", cursor)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package dto
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+// AuthEventExportDTO represents an authentication event in a data export
+type AuthEventExportDTO struct {
+	EventType string `json:"event_type" example:"login"`
+	IPAddress string `json:"ip_address" example:"203.0.113.10"`
+	UserAgent string `json:"user_agent" example:"Mozilla/5.0"`
+	Success   bool   `json:"success" example:"true"`
+	CreatedAt string `json:"created_at" example:"2025-10-20T10:00:00Z"`
+}
+
+// UserExportResponse documents the data-portability bundle for the authenticated user.
+// The bundle is streamed section by section in this field order
+type UserExportResponse struct {
+	ExportedAt string                `json:"exported_at" example:"2025-10-20T10:00:00Z"`
+	Profile    UserInfo              `json:"profile"`
+	Reports    []DamagedRoadResponse `json:"reports"`
+	AuthEvents []AuthEventExportDTO  `json:"auth_events"`
+}
+
+// FromAuthEventLogExport converts an AuthEventLog entity to an export DTO
+func FromAuthEventLogExport(event *entities.AuthEventLog) AuthEventExportDTO {
+	return AuthEventExportDTO{
+		EventType: event.EventType,
+		IPAddress: event.IPAddress,
+		UserAgent: event.UserAgent,
+		Success:   event.Success,
+		CreatedAt: FormatTimestamp(event.CreatedAt),
+	}
+}
+
+// GeoJSONFeatureCollection documents the GeoJSON export of damaged road reports.
+// The features array is streamed one report at a time
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type" example:"FeatureCollection"`
+	Features []GeoJSONFeature `json:"features"`
+}
+
+// GeoJSONFeature represents one report as a GeoJSON Feature with its path as the geometry
+type GeoJSONFeature struct {
+	Type       string                   `json:"type" example:"Feature"`
+	Geometry   GeometryDTO              `json:"geometry"`
+	Properties GeoJSONFeatureProperties `json:"properties"`
+}
+
+// GeoJSONFeatureProperties holds the report attributes carried by a GeoJSON Feature
+type GeoJSONFeatureProperties struct {
+	ID              string `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title           string `json:"title" example:"Jalan berlubang di depan SDN 01"`
+	Status          string `json:"status" example:"submitted"`
+	SubDistrictCode string `json:"subdistrict_code" example:"35.10.02.2005"`
+}
+
+// ToGeoJSONFeature converts a DamagedRoad entity to a GeoJSON Feature
+func ToGeoJSONFeature(road *entities.DamagedRoad) GeoJSONFeature {
+	return GeoJSONFeature{
+		Type:     "Feature",
+		Geometry: pathGeometryDTO(road),
+		Properties: GeoJSONFeatureProperties{
+			ID:              road.ID.String(),
+			Title:           road.Title.String(),
+			Status:          road.Status.String(),
+			SubDistrictCode: road.SubDistrictCode.String(),
+		},
+	}
+}
+
+// ReportCSVHeader names the columns of the CSV export of damaged road reports
+var ReportCSVHeader = []string{
+	"id", "title", "subdistrict_code", "status", "author_id", "created_at",
+	"first_lat", "first_lng", "photo_count",
+}
+
+// ToReportCSVRecord flattens a DamagedRoad entity into a CSV row matching ReportCSVHeader.
+// The first coordinate is always taken from the stored WGS84 path. Text cells go through
+// csvSafe; the coordinates stay numeric, so a negative latitude keeps its sign
+func ToReportCSVRecord(road *entities.DamagedRoad) []string {
+	var firstLat, firstLng string
+	if points := road.Path.ToPoints(); len(points) > 0 {
+		firstLat = strconv.FormatFloat(points[0].Lat, 'f', coordinatePrecision, 64)
+		firstLng = strconv.FormatFloat(points[0].Lng, 'f', coordinatePrecision, 64)
+	}
+
+	return []string{
+		road.ID.String(),
+		csvSafe(road.Title.String()),
+		csvSafe(road.SubDistrictCode.String()),
+		road.Status.String(),
+		road.AuthorID.String(),
+		FormatTimestamp(road.CreatedAt),
+		firstLat,
+		firstLng,
+		strconv.Itoa(len(road.PhotoURLs)),
+	}
+}
+
+// csvFormulaPrefixes are the leading characters spreadsheets treat as the start of a formula
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// csvSafe neutralizes CSV injection: a cell that would open as a formula in a spreadsheet is
+// prefixed with a single quote so it is shown as text
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune(csvFormulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+func TestToReportCSVRecordNeutralizesFormulas(t *testing.T) {
+	for _, title := range []string{
+		"=HYPERLINK(\"http://evil.example\",\"klik\")",
+		"+1+cmd|' /C calc'!A0",
+		"-2+3",
+		"@SUM(A1:A9)",
+		"\t=1+1",
+		"\r=1+1",
+	} {
+		record := ToReportCSVRecord(&entities.DamagedRoad{ID: uuid.New(), Title: entities.Title(title)})
+		if got := record[1]; got != "'"+title {
+			t.Errorf("title cell for %q = %q, want it prefixed with a quote", title, got)
+		}
+	}
+}
+
+func TestToReportCSVRecordKeepsPlainCells(t *testing.T) {
+	path, err := entities.NewGeometry([][]float64{{106.8, -6.2}, {106.81, -6.21}})
+	if err != nil {
+		t.Fatalf("NewGeometry() error = %v", err)
+	}
+	road := &entities.DamagedRoad{
+		ID:              uuid.New(),
+		Title:           "Jalan rusak - depan pasar",
+		SubDistrictCode: "31.71.01.1001",
+		Path:            *path,
+	}
+
+	record := ToReportCSVRecord(road)
+	if record[1] != "Jalan rusak - depan pasar" {
+		t.Errorf("title cell = %q, want it unchanged", record[1])
+	}
+	if record[2] != "31.71.01.1001" {
+		t.Errorf("subdistrict cell = %q, want it unchanged", record[2])
+	}
+	if record[6] != "-6.200000" {
+		t.Errorf("first_lat cell = %q, want the negative latitude kept numeric", record[6])
+	}
+}
@@ -1,10 +1,10 @@
 package dto
 
-import "time"
+import "github.com/nicklaros/jalanrusak-be/core/domain/entities"
 
 // LoginRequest represents the request body for user login
 type LoginRequest struct {
-	Email    string `json:"email" binding:"required,email"`
+	Email    string `json:"email" binding:"required,email" normalize:"trim,lower"`
 	Password string `json:"password" binding:"required"`
 }
 
@@ -24,9 +24,10 @@ type RefreshTokenRequest struct {
 
 // RefreshTokenResponse represents the response after token refresh
 type RefreshTokenResponse struct {
-	AccessToken string `json:"access_token"`
-	TokenType   string `json:"token_type"`
-	ExpiresIn   int    `json:"expires_in"` // in seconds
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"` // replaces the presented refresh token, which is now revoked
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"` // in seconds
 }
 
 // LogoutRequest represents the optional logout payload
@@ -34,12 +35,62 @@ type LogoutRequest struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// RevokeDeviceSessionsResponse represents the response after revoking a device's sessions
+type RevokeDeviceSessionsResponse struct {
+	Message         string `json:"message"`
+	RevokedSessions int    `json:"revoked_sessions"`
+}
+
+// SessionResponse represents an active login session (refresh token) of the user.
+// The token itself is never returned; ID identifies the session
+type SessionResponse struct {
+	ID         string  `json:"id" example:"8f14e45f-ceea-467f-a0e6-0d6b9c2f1a3e"`
+	DeviceID   *string `json:"device_id,omitempty" example:"pixel-7"`
+	IPAddress  *string `json:"ip_address,omitempty" example:"203.0.113.10"`
+	UserAgent  *string `json:"user_agent,omitempty" example:"Mozilla/5.0"`
+	CreatedAt  string  `json:"created_at" example:"2025-10-20T10:00:00Z"`
+	LastUsedAt *string `json:"last_used_at,omitempty" example:"2025-10-21T08:30:00Z"`
+	ExpiresAt  string  `json:"expires_at" example:"2025-10-27T10:00:00Z"`
+}
+
+// SessionListResponse represents the user's active sessions
+type SessionListResponse struct {
+	Data []SessionResponse `json:"data"`
+}
+
+// FromRefreshToken converts a RefreshToken entity to a session response DTO
+func FromRefreshToken(token *entities.RefreshToken) SessionResponse {
+	return SessionResponse{
+		ID:         token.ID.String(),
+		DeviceID:   token.DeviceID,
+		IPAddress:  token.IPAddress,
+		UserAgent:  token.UserAgent,
+		CreatedAt:  FormatTimestamp(token.CreatedAt),
+		LastUsedAt: FormatOptionalTimestamp(token.LastUsedAt),
+		ExpiresAt:  FormatTimestamp(token.ExpiresAt),
+	}
+}
+
 // UserInfo represents user information in responses
 type UserInfo struct {
-	ID        string     `json:"id"`
-	Name      string     `json:"name"`
-	Email     string     `json:"email"`
-	Role      string     `json:"role"`
-	CreatedAt time.Time  `json:"created_at"`
-	LastLogin *time.Time `json:"last_login,omitempty"`
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// Role is read from the database when the response is built. In the login response it is
+	// a snapshot; GET /users/me always returns the current role
+	Role      string  `json:"role" example:"user"`
+	CreatedAt string  `json:"created_at" example:"2025-10-20T10:00:00Z"`
+	LastLogin *string `json:"last_login,omitempty" example:"2025-10-21T08:30:00Z"`
+}
+
+// FromUser converts a User entity to a UserInfo DTO
+func FromUser(user *entities.User) UserInfo {
+	return UserInfo{
+		ID:        user.ID.String(),
+		Name:      user.Name,
+		Email:     user.Email,
+		Role:      user.Role,
+		CreatedAt: FormatTimestamp(user.CreatedAt),
+		LastLogin: FormatOptionalTimestamp(user.LastLoginAt),
+	}
 }
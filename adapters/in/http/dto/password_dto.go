@@ -2,7 +2,7 @@ package dto
 
 // PasswordResetRequestRequest represents the request to initiate password reset
 type PasswordResetRequestRequest struct {
-	Email string `json:"email" binding:"required,email"`
+	Email string `json:"email" binding:"required,email" normalize:"trim,lower"`
 }
 
 // PasswordResetRequestResponse represents the response after password reset request
@@ -1,25 +1,24 @@
 package dto
 
-import "time"
-
 // RegistrationRequest represents the request body for user registration
 type RegistrationRequest struct {
-	Name     string `json:"name" binding:"required"`
-	Email    string `json:"email" binding:"required,email"`
+	Name     string `json:"name" binding:"required" normalize:"trim"`
+	Email    string `json:"email" binding:"required,email" normalize:"trim,lower"`
 	Password string `json:"password" binding:"required,min=8"`
 }
 
 // RegistrationResponse represents the response after successful registration
 type RegistrationResponse struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	Role      string    `json:"role"`
-	CreatedAt time.Time `json:"created_at"`
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at" example:"2025-10-20T10:00:00Z"`
 }
 
 // ErrorResponse represents an error response
 type ErrorResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message,omitempty"`
+	Error   string      `json:"error"`
+	Message string      `json:"message,omitempty"`
+	Details interface{} `json:"details,omitempty"`
 }
@@ -1,40 +1,205 @@
 package dto
 
-import "github.com/nicklaros/jalanrusak-be/core/domain/entities"
+import (
+	"encoding/json"
+	"math"
+	"strconv"
 
-// PointDTO represents a coordinate point in the request
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	domainerrors "github.com/nicklaros/jalanrusak-be/core/domain/errors"
+)
+
+// PointDTO represents a coordinate point in the request.
+// Points must be within national bounds, widened only when the "any" boundary mode is configured;
+// the geometry service then applies the mode to the path as a whole.
 type PointDTO struct {
-	Lat float64 `json:"lat" binding:"required,gte=-11,lte=6" example:"-7.2575"`
-	Lng float64 `json:"lng" binding:"required,gte=95,lte=141" example:"112.7521"`
+	Lat float64 `json:"lat" binding:"required,national_lat" example:"-7.2575"`
+	Lng float64 `json:"lng" binding:"required,national_lng" example:"112.7521"`
 }
 
 // CreateDamagedRoadRequest represents the request to create a damaged road report
 type CreateDamagedRoadRequest struct {
-	Title           string     `json:"title" binding:"required,min=3,max=100" example:"Jalan berlubang di depan SDN 01"`
-	SubDistrictCode string     `json:"subdistrict_code" binding:"required" example:"35.10.02.2005"`
-	PathPoints      []PointDTO `json:"path_points" binding:"required,min=1,max=100"`
-	PhotoURLs       []string   `json:"photo_urls" binding:"required,min=1,max=10"`
-	Description     *string    `json:"description,omitempty" binding:"omitempty,max=500" example:"Jalan berlubang sepanjang 50 meter"`
+	Title           string `json:"title" binding:"required,min=3,max=100" normalize:"trim" example:"Jalan berlubang di depan SDN 01"`
+	SubDistrictCode string `json:"subdistrict_code" binding:"required" normalize:"trim" example:"35.10.02.2005"`
+	// PathPoints is stored as a Point when it holds a single location and as a LineString otherwise
+	PathPoints  []PointDTO `json:"path_points" binding:"required,min=1,max=100"`
+	PhotoURLs   []string   `json:"photo_urls" binding:"required,photo_count"`
+	Description *string    `json:"description,omitempty" binding:"omitempty,max=500" example:"Jalan berlubang sepanjang 50 meter"`
+	// CategoryID is an id from GET /categories; required when the server enforces categories
+	CategoryID *string `json:"category_id,omitempty" binding:"omitempty,max=50" normalize:"trim" example:"pothole"`
+	// Force creates the report even when an unresolved report already exists nearby
+	Force bool `json:"force,omitempty" example:"false"`
+}
+
+// DuplicateReportDetails are the details of a 409 duplicate_report error, so the client can
+// offer to view the existing report or to submit again with force
+type DuplicateReportDetails struct {
+	ExistingReportID string `json:"existing_report_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// ExistingReport is omitted if the existing report could not be loaded
+	ExistingReport *DamagedRoadResponse `json:"existing_report,omitempty"`
+}
+
+// UpdateDamagedRoadRequest represents the request to edit a submitted damaged road report.
+// The subdistrict cannot be changed; the path is validated against the report's subdistrict
+type UpdateDamagedRoadRequest struct {
+	Title       string     `json:"title" binding:"required,min=3,max=100" normalize:"trim" example:"Jalan berlubang di depan SDN 01"`
+	PathPoints  []PointDTO `json:"path_points" binding:"required,min=1,max=100"`
+	PhotoURLs   []string   `json:"photo_urls" binding:"required,photo_count"`
+	Description *string    `json:"description,omitempty" binding:"omitempty,max=500" example:"Jalan berlubang sepanjang 50 meter"`
+}
+
+// UpdateDamagedRoadPathRequest represents the request to correct only the path of a submitted report
+type UpdateDamagedRoadPathRequest struct {
+	PathPoints []PointDTO `json:"path_points" binding:"required,min=1,max=100"`
+}
+
+// ToEntity converts UpdateDamagedRoadPathRequest to domain points
+func (r *UpdateDamagedRoadPathRequest) ToEntity() []entities.Point {
+	points := make([]entities.Point, len(r.PathPoints))
+	for i, p := range r.PathPoints {
+		points[i] = entities.Point{Lat: p.Lat, Lng: p.Lng}
+	}
+	return points
+}
+
+// DefaultCoordinatePrecision is the number of decimal places coordinates are written with,
+// about 11 cm at the equator
+const DefaultCoordinatePrecision = 6
+
+// MaxCoordinatePrecision is the most decimal places that still carry float64 precision
+const MaxCoordinatePrecision = 15
+
+// coordinatePrecision is the number of decimal places of coordinates in responses
+var coordinatePrecision = DefaultCoordinatePrecision
+
+// SetCoordinatePrecision sets the number of decimal places of coordinates in responses.
+// Only the response is rounded; stored coordinates keep their full precision.
+// Must be called at startup, before any response is built
+func SetCoordinatePrecision(precision int) {
+	coordinatePrecision = max(0, min(precision, MaxCoordinatePrecision))
+}
+
+// formatCoordinates writes each coordinate with the configured number of decimal places,
+// never in scientific notation, keeping the GeoJSON nesting of the geometry
+func formatCoordinates(path entities.Geometry) interface{} {
+	formatPair := func(pair []float64) []json.Number {
+		formatted := make([]json.Number, len(pair))
+		for i, value := range pair {
+			formatted[i] = json.Number(strconv.FormatFloat(value, 'f', coordinatePrecision, 64))
+		}
+		return formatted
+	}
+
+	if pair, ok := path.GeoJSONCoordinates().([]float64); ok {
+		return formatPair(pair)
+	}
+	pairs := make([][]json.Number, len(path.Coordinates))
+	for i, pair := range path.Coordinates {
+		pairs[i] = formatPair(pair)
+	}
+	return pairs
 }
 
 // GeometryDTO represents a PostGIS geometry in the response
 type GeometryDTO struct {
-	Type        string      `json:"type" example:"LineString"`
-	Coordinates [][]float64 `json:"coordinates"`
+	Type string `json:"type" example:"LineString"`
+	// Coordinates is a single [lng, lat] pair for a Point and a list of pairs otherwise,
+	// written with a fixed number of decimal places
+	Coordinates interface{} `json:"coordinates" swaggertype:"array,number"`
+	// SRID names the CRS of the coordinates when it is not WGS84 (EPSG:4326), e.g. 3857 for Web Mercator
+	SRID int `json:"srid,omitempty" example:"3857"`
+}
+
+// pathGeometryDTO returns a report's path for output, in the projected CRS when one was requested
+func pathGeometryDTO(road *entities.DamagedRoad) GeometryDTO {
+	if road.ProjectedPath != nil {
+		return GeometryDTO{
+			Type:        road.ProjectedPath.Type,
+			Coordinates: formatCoordinates(*road.ProjectedPath),
+			SRID:        road.ProjectedSRID,
+		}
+	}
+	return GeometryDTO{
+		Type:        road.Path.Type,
+		Coordinates: formatCoordinates(road.Path),
+	}
 }
 
 // DamagedRoadResponse represents a damaged road report in the response
 type DamagedRoadResponse struct {
-	ID              string      `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Title           string      `json:"title" example:"Jalan berlubang di depan SDN 01"`
-	SubDistrictCode string      `json:"subdistrict_code" example:"35.10.02.2005"`
-	Path            GeometryDTO `json:"path"`
-	Description     *string     `json:"description,omitempty" example:"Jalan berlubang sepanjang 50 meter"`
-	PhotoURLs       []string    `json:"photo_urls"`
-	AuthorID        string      `json:"author_id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Status          string      `json:"status" example:"submitted"`
-	CreatedAt       string      `json:"created_at" example:"2025-10-20T10:00:00Z"`
-	UpdatedAt       string      `json:"updated_at" example:"2025-10-20T10:00:00Z"`
+	ID                  string            `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title               string            `json:"title" example:"Jalan berlubang di depan SDN 01"`
+	SubDistrictCode     string            `json:"subdistrict_code" example:"35.10.02.2005"`
+	Path                GeometryDTO       `json:"path"`
+	LengthMeters        float64           `json:"length_meters" example:"52.37"` // 0 for single-point reports
+	Description         *string           `json:"description,omitempty" example:"Jalan berlubang sepanjang 50 meter"`
+	PhotoURLs           []string          `json:"photo_urls"`
+	ResolutionPhotoURLs []string          `json:"resolution_photo_urls,omitempty"`
+	DroppedPhotos       []DroppedPhotoDTO `json:"dropped_photos,omitempty"`
+	AuthorID            string            `json:"author_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Status              string            `json:"status" example:"submitted"`
+	CreatedAt           string            `json:"created_at" example:"2025-10-20T10:00:00Z"`
+	UpdatedAt           string            `json:"updated_at" example:"2025-10-20T10:00:00Z"`
+	StatusChangedAt     string            `json:"status_changed_at" example:"2025-10-20T10:00:00Z"`
+	CategoryID          *string           `json:"category_id,omitempty" example:"pothole"`
+	SLABreached         bool              `json:"sla_breached" example:"false"`
+	// DeletedAt is only present on soft-deleted reports, which admins can list with include_deleted
+	DeletedAt *string `json:"deleted_at,omitempty" example:"2025-10-21T08:00:00Z"`
+
+	// Warnings are non-fatal concerns about a created or edited report, for the client to surface
+	Warnings []string `json:"warnings,omitempty" example:"path is more than 200 meters from the subdistrict centroid"`
+
+	// NearestReportDistanceMeters is advisory, returned on create when an unresolved report is nearby
+	NearestReportDistanceMeters *float64 `json:"nearest_report_distance_meters,omitempty" example:"42.5"`
+
+	// DistanceToCentroidMeters is returned on report details when the subdistrict has centroid data;
+	// a large value hints at a mis-tagged location
+	DistanceToCentroidMeters *float64 `json:"distance_to_centroid_meters,omitempty" example:"85.3"`
+
+	// DistanceMeters is the distance from the search center, returned by nearby searches
+	DistanceMeters *float64 `json:"distance_meters,omitempty" example:"120.4"`
+
+	// Author is null when the author's account no longer exists
+	Author *ReportAuthorDTO `json:"author"`
+}
+
+// ReportAuthorDTO represents the author embedded in a report response
+type ReportAuthorDTO struct {
+	Name  string  `json:"name" example:"Budi Santoso"`
+	Role  string  `json:"role" example:"user"`
+	Email *string `json:"email,omitempty" example:"budi@example.com"` // admin callers only
+}
+
+// DroppedPhotoDTO represents a photo URL that was excluded from a report during lenient validation
+type DroppedPhotoDTO struct {
+	URL    string `json:"url" example:"https://example.com/broken.jpg"`
+	Reason string `json:"reason" example:"HTTP 404: URL not accessible"`
+}
+
+// CoordinateViolationDTO describes one path coordinate that is outside the allowed bounds
+type CoordinateViolationDTO struct {
+	Index int     `json:"index" example:"2"`
+	Lat   float64 `json:"lat" example:"7.1"`
+	Lng   float64 `json:"lng" example:"112.75"`
+	Axis  string  `json:"axis" example:"lat" enums:"lat,lng"`
+	Bound string  `json:"bound" example:"max" enums:"min,max"`
+	Limit float64 `json:"limit" example:"6"`
+}
+
+// FromCoordinateBoundsError converts a coordinate bounds error to response details
+func FromCoordinateBoundsError(err *domainerrors.CoordinateBoundsError) []CoordinateViolationDTO {
+	details := make([]CoordinateViolationDTO, len(err.Violations))
+	for i, v := range err.Violations {
+		details[i] = CoordinateViolationDTO{
+			Index: v.Index,
+			Lat:   v.Lat,
+			Lng:   v.Lng,
+			Axis:  v.Axis,
+			Bound: v.Bound,
+			Limit: v.Limit,
+		}
+	}
+	return details
 }
 
 // DamagedRoadListResponse represents a paginated list of damaged road reports
@@ -43,17 +208,88 @@ type DamagedRoadListResponse struct {
 	Pagination PaginationMeta        `json:"pagination"`
 }
 
+// NearbyDamagedRoadsResponse represents reports near a point, closest first
+type NearbyDamagedRoadsResponse struct {
+	Data         []DamagedRoadResponse `json:"data"`
+	RadiusMeters float64               `json:"radius_meters" example:"1000"`
+}
+
+// StatusChangeResponse represents a single status transition of a report
+type StatusChangeResponse struct {
+	FromStatus string `json:"from_status" example:"verified"`
+	ToStatus   string `json:"to_status" example:"resolved"`
+	ChangedBy  string `json:"changed_by" example:"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`
+	ChangedAt  string `json:"changed_at" example:"2025-10-20T10:00:00Z"`
+	// Reason explains the change; omitted when none was given
+	Reason *string `json:"reason,omitempty" example:"Pothole patched by the public works office"`
+}
+
+// ReportStatusEventResponse is the data of a status_changed server-sent event
+type ReportStatusEventResponse struct {
+	ReportID        string `json:"report_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title           string `json:"title" example:"Jalan berlubang di depan SDN 01"`
+	SubDistrictCode string `json:"subdistrict_code" example:"35.10.02.2005"`
+	StatusChangeResponse
+}
+
+// FromReportStatusChangedEvent converts a report and its status change to event data
+func FromReportStatusChangedEvent(report *entities.DamagedRoad, change *entities.StatusChange) ReportStatusEventResponse {
+	return ReportStatusEventResponse{
+		ReportID:             report.ID.String(),
+		Title:                report.Title.String(),
+		SubDistrictCode:      report.SubDistrictCode.String(),
+		StatusChangeResponse: FromStatusChange(change),
+	}
+}
+
+// StatusHistoryResponse represents the status timeline of a report, oldest first
+type StatusHistoryResponse struct {
+	Data []StatusChangeResponse `json:"data"`
+}
+
+// FromStatusChange converts a StatusChange entity to a response DTO
+func FromStatusChange(change *entities.StatusChange) StatusChangeResponse {
+	response := StatusChangeResponse{
+		FromStatus: change.FromStatus.String(),
+		ToStatus:   change.ToStatus.String(),
+		ChangedBy:  change.ChangedBy.String(),
+		ChangedAt:  FormatTimestamp(change.ChangedAt),
+	}
+	if change.Reason != nil {
+		reason := change.Reason.String()
+		response.Reason = &reason
+	}
+	return response
+}
+
+// DamagedRoadMapResponse represents the reports inside a map viewport, newest first
+type DamagedRoadMapResponse struct {
+	Data []DamagedRoadResponse `json:"data"`
+	// Truncated is true when the viewport holds more reports than the limit; zoom in to see all
+	Truncated bool `json:"truncated" example:"false"`
+	Limit     int  `json:"limit" example:"500"`
+}
+
 // PaginationMeta represents pagination metadata
 type PaginationMeta struct {
 	Total  int `json:"total" example:"100"`
 	Limit  int `json:"limit" example:"20"`
 	Offset int `json:"offset" example:"0"`
 	Page   int `json:"page" example:"1"`
+	// UnfilteredTotal is the number of reports ignoring filters, only present when requested
+	UnfilteredTotal *int `json:"unfiltered_total,omitempty" example:"1234"`
+	// NextCursor continues the listing after this page when passed as cursor. Only present when
+	// sorting by created_at and the page is full. Offset and Page are 0 in cursor mode
+	NextCursor string `json:"next_cursor,omitempty" example:"MjAyNS0xMC0yMFQxMDowMDowMFp8MTIzZTQ1NjctZTg5Yi0xMmQzLWE0NTYtNDI2NjE0MTc0MDAw"`
 }
 
 // UpdateStatusRequest represents the request to update report status
 type UpdateStatusRequest struct {
-	Status string `json:"status" binding:"required" example:"under_verification"`
+	Status string `json:"status" binding:"required" normalize:"trim,lower" example:"under_verification"`
+	// Reason documents the outcome and is kept in the status history; the server may require it for some statuses
+	Reason string `json:"reason,omitempty" binding:"max=500" normalize:"trim" example:"Pothole patched by the public works office"`
+	// ResolutionPhotoURLs are proof-of-repair photos, only accepted when resolving
+	ResolutionPhotoURLs []string `json:"resolution_photo_urls,omitempty" binding:"omitempty,max=10,dive,url"`
 }
 
 // ToEntity converts CreateDamagedRoadRequest to domain entities
@@ -74,25 +310,51 @@ func (r *CreateDamagedRoadRequest) ToEntity() (
 		return "", "", nil, nil, err
 	}
 
+	// National bounds are checked by the geometry service so every offending point is reported
 	points := make([]entities.Point, len(r.PathPoints))
 	for i, p := range r.PathPoints {
-		point, err := entities.NewPoint(p.Lat, p.Lng)
+		points[i] = entities.Point{Lat: p.Lat, Lng: p.Lng}
+	}
+
+	var description *entities.Description
+	if r.Description != nil && *r.Description != "" {
+		desc, err := entities.NewDescription(*r.Description)
 		if err != nil {
 			return "", "", nil, nil, err
 		}
-		points[i] = *point
+		description = &desc
+	}
+
+	return title, subdistrictCode, points, description, nil
+}
+
+// ToEntity converts UpdateDamagedRoadRequest to domain values
+func (r *UpdateDamagedRoadRequest) ToEntity() (
+	entities.Title,
+	[]entities.Point,
+	*entities.Description,
+	error,
+) {
+	title, err := entities.NewTitle(r.Title)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	points := make([]entities.Point, len(r.PathPoints))
+	for i, p := range r.PathPoints {
+		points[i] = entities.Point{Lat: p.Lat, Lng: p.Lng}
 	}
 
 	var description *entities.Description
 	if r.Description != nil && *r.Description != "" {
 		desc, err := entities.NewDescription(*r.Description)
 		if err != nil {
-			return "", "", nil, nil, err
+			return "", nil, nil, err
 		}
 		description = &desc
 	}
 
-	return title, subdistrictCode, points, description, nil
+	return title, points, description, nil
 }
 
 // FromDamagedRoad converts a DamagedRoad entity to a response DTO
@@ -103,19 +365,57 @@ func FromDamagedRoad(road *entities.DamagedRoad) DamagedRoadResponse {
 		description = &desc
 	}
 
+	var droppedPhotos []DroppedPhotoDTO
+	for _, dropped := range road.DroppedPhotos {
+		droppedPhotos = append(droppedPhotos, DroppedPhotoDTO{
+			URL:    dropped.URL,
+			Reason: dropped.Reason,
+		})
+	}
+
 	return DamagedRoadResponse{
-		ID:              road.ID.String(),
-		Title:           road.Title.String(),
-		SubDistrictCode: road.SubDistrictCode.String(),
-		Path: GeometryDTO{
-			Type:        road.Path.Type,
-			Coordinates: road.Path.Coordinates,
-		},
-		Description: description,
-		PhotoURLs:   road.PhotoURLs,
-		AuthorID:    road.AuthorID.String(),
-		Status:      road.Status.String(),
-		CreatedAt:   road.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   road.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:                  road.ID.String(),
+		Title:               road.Title.String(),
+		SubDistrictCode:     road.SubDistrictCode.String(),
+		Path:                pathGeometryDTO(road),
+		LengthMeters:        math.Round(road.Path.LengthMeters()*100) / 100,
+		Description:         description,
+		PhotoURLs:           road.PhotoURLs,
+		ResolutionPhotoURLs: road.ResolutionPhotoURLs,
+		DroppedPhotos:       droppedPhotos,
+		AuthorID:            road.AuthorID.String(),
+		Status:              road.Status.String(),
+		CreatedAt:           FormatTimestamp(road.CreatedAt),
+		UpdatedAt:           FormatTimestamp(road.UpdatedAt),
+		StatusChangedAt:     FormatTimestamp(road.StatusChangedAt),
+		CategoryID:          road.CategoryID,
+		SLABreached:         road.SLABreached,
+		DeletedAt:           FormatOptionalTimestamp(road.DeletedAt),
+		Warnings:            road.Warnings,
+
+		NearestReportDistanceMeters: road.NearestReportDistanceMeters,
+		DistanceToCentroidMeters:    road.DistanceToCentroidMeters,
+		DistanceMeters:              road.DistanceMeters,
+		Author:                      fromReportAuthor(road.Author),
+	}
+}
+
+// fromReportAuthor converts the embedded author, leaving out the email
+func fromReportAuthor(author *entities.ReportAuthor) *ReportAuthorDTO {
+	if author == nil {
+		return nil
+	}
+	return &ReportAuthorDTO{
+		Name: author.Name,
+		Role: author.Role,
+	}
+}
+
+// WithAuthorEmail adds the author's email to the response, for admin callers
+func (r *DamagedRoadResponse) WithAuthorEmail(road *entities.DamagedRoad) {
+	if r.Author == nil || road.Author == nil || road.Author.Email == "" {
+		return
 	}
+	email := road.Author.Email
+	r.Author.Email = &email
 }
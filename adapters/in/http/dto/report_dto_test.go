@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+func TestGeometryCoordinatePrecision(t *testing.T) {
+	t.Cleanup(func() { SetCoordinatePrecision(DefaultCoordinatePrecision) })
+
+	line := entities.Geometry{Type: entities.GeometryTypeLineString, Coordinates: [][]float64{
+		{112.75213456789, -7.25751234567},
+		{112.7530, -7.2580},
+	}}
+	point := entities.Geometry{Type: entities.GeometryTypePoint, Coordinates: [][]float64{{112.7521, 0.0000001}}}
+
+	tests := []struct {
+		name      string
+		precision int
+		path      entities.Geometry
+		want      string
+	}{
+		{"default", DefaultCoordinatePrecision, line, `[[112.752135,-7.257512],[112.753000,-7.258000]]`},
+		{"three places", 3, line, `[[112.752,-7.258],[112.753,-7.258]]`},
+		{"whole degrees", 0, line, `[[113,-7],[113,-7]]`},
+		{"point without scientific notation", DefaultCoordinatePrecision, point, `[112.752100,0.000000]`},
+		{"negative precision clamps to zero", -2, point, `[113,0]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetCoordinatePrecision(tt.precision)
+			got, err := json.Marshal(pathGeometryDTO(&entities.DamagedRoad{Path: tt.path}).Coordinates)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("coordinates = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetCoordinatePrecisionCapsAtFloat64Precision(t *testing.T) {
+	t.Cleanup(func() { SetCoordinatePrecision(DefaultCoordinatePrecision) })
+
+	SetCoordinatePrecision(40)
+	if coordinatePrecision != MaxCoordinatePrecision {
+		t.Errorf("precision = %d, want it capped at %d", coordinatePrecision, MaxCoordinatePrecision)
+	}
+}
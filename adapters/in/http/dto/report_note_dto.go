@@ -0,0 +1,43 @@
+package dto
+
+import "github.com/nicklaros/jalanrusak-be/core/domain/entities"
+
+// CreateReportNoteRequest represents the request to add a verifier note to a report
+type CreateReportNoteRequest struct {
+	Body string `json:"body" binding:"required,max=2000" example:"Checked with the district office, repair is scheduled"`
+	// Internal notes are only visible to verificators and admins. Defaults to true
+	Internal *bool `json:"internal,omitempty" example:"true"`
+}
+
+// IsInternal reports whether the note should be internal, defaulting to true so that
+// working notes are never published by accident
+func (r *CreateReportNoteRequest) IsInternal() bool {
+	return r.Internal == nil || *r.Internal
+}
+
+// ReportNoteResponse represents a note on a report
+type ReportNoteResponse struct {
+	ID        string `json:"id" example:"4b1e4d2a-8c9f-4f7e-9a51-2f0c6d9e1a23"`
+	ReportID  string `json:"report_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	AuthorID  string `json:"author_id" example:"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`
+	Body      string `json:"body" example:"Checked with the district office, repair is scheduled"`
+	Internal  bool   `json:"internal" example:"true"`
+	CreatedAt string `json:"created_at" example:"2025-10-20T10:00:00Z"`
+}
+
+// ReportNoteListResponse represents the notes of a report, oldest first
+type ReportNoteListResponse struct {
+	Data []ReportNoteResponse `json:"data"`
+}
+
+// FromReportNote converts a ReportNote entity to a response DTO
+func FromReportNote(note *entities.ReportNote) ReportNoteResponse {
+	return ReportNoteResponse{
+		ID:        note.ID.String(),
+		ReportID:  note.ReportID.String(),
+		AuthorID:  note.AuthorID.String(),
+		Body:      note.Body,
+		Internal:  note.Internal,
+		CreatedAt: FormatTimestamp(note.CreatedAt),
+	}
+}
@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+// FormatTimestamp formats a timestamp as RFC3339 in UTC with second precision, e.g.
+// 2025-10-20T10:00:00Z, the single format used for every timestamp in API responses.
+// Converting to UTC keeps the output independent of the server's time zone
+func FormatTimestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// FormatOptionalTimestamp formats an optional timestamp, returning nil when it is unset
+func FormatOptionalTimestamp(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := FormatTimestamp(*t)
+	return &formatted
+}
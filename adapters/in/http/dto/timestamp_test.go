@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+func TestResponseTimestampsShareOneFormat(t *testing.T) {
+	// Nanoseconds and a non-UTC zone, as timestamps come back from the database and time.Now
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	instant := time.Date(2025, 10, 20, 17, 0, 0, 123456789, jakarta)
+	const want = "2025-10-20T10:00:00Z"
+
+	road := FromDamagedRoad(&entities.DamagedRoad{
+		ID:              uuid.New(),
+		CreatedAt:       instant,
+		UpdatedAt:       instant,
+		StatusChangedAt: instant,
+	})
+	user := FromUser(&entities.User{ID: uuid.New(), CreatedAt: instant, LastLoginAt: &instant})
+	session := FromRefreshToken(&entities.RefreshToken{ID: uuid.New(), CreatedAt: instant, ExpiresAt: instant, LastUsedAt: &instant})
+	activity := FromActivityItem(&entities.ActivityItem{OccurredAt: instant})
+
+	for name, got := range map[string]string{
+		"report created_at":        road.CreatedAt,
+		"report updated_at":        road.UpdatedAt,
+		"report status_changed_at": road.StatusChangedAt,
+		"user created_at":          user.CreatedAt,
+		"user last_login":          *user.LastLogin,
+		"session created_at":       session.CreatedAt,
+		"session last_used_at":     *session.LastUsedAt,
+		"session expires_at":       session.ExpiresAt,
+		"activity occurred_at":     activity.OccurredAt,
+	} {
+		if got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestFormatOptionalTimestamp(t *testing.T) {
+	if got := FormatOptionalTimestamp(nil); got != nil {
+		t.Errorf("FormatOptionalTimestamp(nil) = %q, want nil", *got)
+	}
+}
+
+func TestFromDamagedRoadTimestampsIgnoreServerZone(t *testing.T) {
+	instant := time.Date(2025, 10, 20, 10, 0, 0, 0, time.UTC)
+	const want = "2025-10-20T10:00:00Z"
+
+	// The same instant in the zones a server might run in serializes identically
+	for _, zone := range []*time.Location{time.UTC, time.FixedZone("WIB", 7*60*60), time.FixedZone("EST", -5*60*60)} {
+		local := instant.In(zone)
+		response := FromDamagedRoad(&entities.DamagedRoad{ID: uuid.New(), CreatedAt: local, UpdatedAt: local})
+		if response.CreatedAt != want || response.UpdatedAt != want {
+			t.Errorf("in %s: created_at %q, updated_at %q, want %q", zone, response.CreatedAt, response.UpdatedAt, want)
+		}
+	}
+}
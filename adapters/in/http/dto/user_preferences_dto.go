@@ -0,0 +1,23 @@
+package dto
+
+import "github.com/nicklaros/jalanrusak-be/core/domain/entities"
+
+// UserPreferencesResponse represents the authenticated user's notification preferences
+type UserPreferencesResponse struct {
+	EmailOnStatusChange bool `json:"email_on_status_change" example:"true"`
+}
+
+// UpdateUserPreferencesRequest represents a partial update of notification preferences; omitted fields are unchanged
+type UpdateUserPreferencesRequest struct {
+	EmailOnStatusChange *bool `json:"email_on_status_change,omitempty" example:"false"`
+}
+
+// ToEntity converts the request to a preferences update
+func (r UpdateUserPreferencesRequest) ToEntity() entities.UserPreferencesUpdate {
+	return entities.UserPreferencesUpdate{EmailOnStatusChange: r.EmailOnStatusChange}
+}
+
+// FromUserPreferences converts UserPreferences to a response DTO
+func FromUserPreferences(preferences *entities.UserPreferences) UserPreferencesResponse {
+	return UserPreferencesResponse{EmailOnStatusChange: preferences.EmailOnStatusChange}
+}
@@ -2,7 +2,7 @@ package dto
 
 // ValidateLocationRequest represents the request to validate coordinates before report submission
 type ValidateLocationRequest struct {
-	SubDistrictCode string     `json:"subdistrict_code" binding:"required" example:"35.10.02.2005"`
+	SubDistrictCode string     `json:"subdistrict_code" binding:"required" normalize:"trim" example:"35.10.02.2005"`
 	PathPoints      []PointDTO `json:"path_points" binding:"required,min=1,max=50,dive"`
 }
 
@@ -16,11 +16,23 @@ type ValidateLocationResponse struct {
 	MinDistanceToCenter float64 `json:"min_distance_to_center_meters,omitempty" example:"45.3"`
 	CentroidLat         float64 `json:"centroid_lat,omitempty" example:"-7.257472"`
 	CentroidLng         float64 `json:"centroid_lng,omitempty" example:"112.752090"`
+	Degraded            bool    `json:"degraded,omitempty" example:"false"` // boundary dataset not seeded; only national bounds checked
+}
+
+// SubDistrictsExistRequest represents a bulk check of subdistrict codes
+type SubDistrictsExistRequest struct {
+	Codes []string `json:"codes" binding:"required,min=1,max=500" normalize:"trim" example:"35.10.02.2005,35.10.02.2006"`
+}
+
+// SubDistrictsExistResponse maps each requested code to whether it exists in the boundary dataset
+type SubDistrictsExistResponse struct {
+	Results  map[string]bool `json:"results"`
+	Degraded bool            `json:"degraded,omitempty" example:"false"` // boundary dataset not seeded; every code is missing
 }
 
 // ValidatePhotosRequest represents the request to validate photo URLs
 type ValidatePhotosRequest struct {
-	PhotoURLs []string `json:"photo_urls" binding:"required,min=1,max=10,dive,url" example:"https://example.com/photo1.jpg"`
+	PhotoURLs []string `json:"photo_urls" binding:"required,photo_count,dive,url" example:"https://example.com/photo1.jpg"`
 }
 
 // ValidatePhotosResponse represents the photo validation results
@@ -36,4 +48,5 @@ type PhotoValidationResult struct {
 	Error       string `json:"error,omitempty" example:""`
 	ContentType string `json:"content_type,omitempty" example:"image/jpeg"`
 	SizeBytes   int64  `json:"size_bytes,omitempty" example:"524288"`
+	Warning     string `json:"warning,omitempty" example:"photo too old: last modified 2021-03-01, more than 365 days ago"`
 }
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/middleware"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+)
+
+// AdminHandler handles administrative and maintenance endpoints
+type AdminHandler struct {
+	maintenanceService usecases.MaintenanceService
+	featureFlags       dto.FeatureFlagsResponse
+}
+
+// NewAdminHandler creates a new AdminHandler
+func NewAdminHandler(maintenanceService usecases.MaintenanceService, featureFlags dto.FeatureFlagsResponse) *AdminHandler {
+	return &AdminHandler{
+		maintenanceService: maintenanceService,
+		featureFlags:       featureFlags,
+	}
+}
+
+// GetFeatureFlags handles GET /api/v1/admin/flags
+// @Summary Show feature flags
+// @Description Return the feature flag values the server was started with. Admin only.
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} dto.FeatureFlagsResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Router /admin/flags [get]
+func (h *AdminHandler) GetFeatureFlags(c *gin.Context) {
+	c.JSON(http.StatusOK, h.featureFlags)
+}
+
+// CleanupTokens handles POST /api/v1/admin/maintenance/cleanup-tokens
+// @Summary Delete expired tokens now
+// @Description Synchronously delete expired refresh and password reset tokens and return how many were removed. Admin only.
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} dto.CleanupTokensResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Router /admin/maintenance/cleanup-tokens [post]
+func (h *AdminHandler) CleanupTokens(c *gin.Context) {
+	middleware.SetAuditAction(c, entities.AdminActionCleanupTokens, "")
+
+	result, err := h.maintenanceService.CleanupExpiredTokens(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to clean up expired tokens",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CleanupTokensResponse{
+		RefreshTokensRemoved:       result.RefreshTokensRemoved,
+		PasswordResetTokensRemoved: result.PasswordResetTokensRemoved,
+	})
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/middleware"
+)
+
+// authCookiePath scopes the refresh token cookie to the auth endpoints that consume it
+const authCookiePath = "/api/v1/auth"
+
+// AuthCookieConfig controls delivery of tokens as cookies for browser clients.
+// Cookies are always HttpOnly; Secure and SameSite are configurable for local development.
+type AuthCookieConfig struct {
+	Enabled         bool
+	Secure          bool
+	SameSite        http.SameSite
+	Domain          string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+}
+
+// ParseSameSite maps a configured SameSite value (strict, lax or none) to its cookie mode,
+// defaulting to strict
+func ParseSameSite(value string) http.SameSite {
+	switch strings.ToLower(value) {
+	case "lax":
+		return http.SameSiteLaxMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteStrictMode
+	}
+}
+
+// setAuthCookies sets the access and refresh token cookies when cookie delivery is enabled
+func (h *AuthHandler) setAuthCookies(c *gin.Context, accessToken, refreshToken string) {
+	if !h.cookies.Enabled {
+		return
+	}
+	h.setCookie(c, middleware.AccessTokenCookie, accessToken, "/", h.cookies.AccessTokenTTL)
+	h.setCookie(c, middleware.RefreshTokenCookie, refreshToken, authCookiePath, h.cookies.RefreshTokenTTL)
+}
+
+// clearAuthCookies expires both token cookies
+func (h *AuthHandler) clearAuthCookies(c *gin.Context) {
+	if !h.cookies.Enabled {
+		return
+	}
+	h.setCookie(c, middleware.AccessTokenCookie, "", "/", -1)
+	h.setCookie(c, middleware.RefreshTokenCookie, "", authCookiePath, -1)
+}
+
+// refreshTokenCookie returns the refresh token cookie, or "" when cookies are disabled or absent
+func (h *AuthHandler) refreshTokenCookie(c *gin.Context) string {
+	if !h.cookies.Enabled {
+		return ""
+	}
+	token, _ := c.Cookie(middleware.RefreshTokenCookie)
+	return token
+}
+
+// setCookie writes an HttpOnly cookie; a negative ttl deletes it
+func (h *AuthHandler) setCookie(c *gin.Context, name, value, path string, ttl time.Duration) {
+	maxAge := int(ttl.Seconds())
+	if ttl < 0 {
+		maxAge = -1
+	}
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   h.cookies.Domain,
+		MaxAge:   maxAge,
+		Secure:   h.cookies.Secure,
+		HttpOnly: true,
+		SameSite: h.cookies.SameSite,
+	})
+}
@@ -1,10 +1,15 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/middleware"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
 	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
 	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
 )
@@ -14,24 +19,32 @@ type AuthHandler struct {
 	authService    usecases.AuthService
 	userService    usecases.UserService
 	accessTokenTTL int // in hours
+	cookies        AuthCookieConfig
 }
 
 // NewAuthHandler creates a new AuthHandler
-func NewAuthHandler(authService usecases.AuthService, userService usecases.UserService, accessTokenTTL int) *AuthHandler {
+func NewAuthHandler(authService usecases.AuthService, userService usecases.UserService, accessTokenTTL int, cookies AuthCookieConfig) *AuthHandler {
 	return &AuthHandler{
 		authService:    authService,
 		userService:    userService,
 		accessTokenTTL: accessTokenTTL,
+		cookies:        cookies,
 	}
 }
 
+// DeviceIDHeader is the request header clients use to identify their device at login
+const DeviceIDHeader = "X-Device-ID"
+
 // Login handles POST /api/v1/auth/login
 // @Summary Authenticate user credentials
 // @Description Login with email and password to receive access and refresh tokens.
+// @Description When cookie auth is enabled the tokens are also set as HttpOnly cookies.
+// @Description user.role is a snapshot taken at login; use GET /users/me for the current role.
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Param request body dto.LoginRequest true "Login payload"
+// @Param X-Device-ID header string false "Client device identifier used for device-scoped session revocation"
 // @Success 200 {object} dto.LoginResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
@@ -41,20 +54,24 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	var req dto.LoginRequest
 
 	// Bind and validate request
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "validation_error",
-			Message: err.Error(),
-		})
+	if !middleware.BindAndValidate(c, &req) {
 		return
 	}
 
-	// Get client IP and User-Agent
+	// Get client IP, User-Agent and optional device identifier
 	ipAddress := c.ClientIP()
 	userAgent := c.Request.UserAgent()
+	deviceID := strings.TrimSpace(c.GetHeader(DeviceIDHeader))
+	if len(deviceID) > entities.MaxDeviceIDLength {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_device_id",
+			Message: fmt.Sprintf("%s header cannot exceed %d characters", DeviceIDHeader, entities.MaxDeviceIDLength),
+		})
+		return
+	}
 
 	// Call auth service
-	accessToken, refreshToken, err := h.authService.Login(c.Request.Context(), req.Email, req.Password, ipAddress, userAgent)
+	accessToken, refreshToken, err := h.authService.Login(c.Request.Context(), req.Email, req.Password, ipAddress, userAgent, deviceID)
 	if err != nil {
 		// Handle domain errors
 		switch err {
@@ -83,25 +100,20 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	// Return success response
+	h.setAuthCookies(c, accessToken, refreshToken)
 	c.JSON(http.StatusOK, dto.LoginResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 		TokenType:    "Bearer",
 		ExpiresIn:    h.accessTokenTTL * 3600, // convert hours to seconds
-		User: dto.UserInfo{
-			ID:        user.ID.String(),
-			Name:      user.Name,
-			Email:     user.Email,
-			Role:      user.Role,
-			CreatedAt: user.CreatedAt,
-			LastLogin: user.LastLoginAt,
-		},
+		User:         dto.FromUser(user),
 	})
 }
 
 // RefreshToken handles POST /api/v1/auth/refresh
 // @Summary Refresh access token
-// @Description Exchange a valid refresh token for a new access token.
+// @Description Exchange a valid refresh token for a new access token and a new refresh token. The presented refresh token is revoked.
+// @Description When cookie auth is enabled the refresh token may be sent as a cookie instead of in the body, and the new tokens are set as cookies.
 // @Tags Auth
 // @Accept json
 // @Produce json
@@ -114,13 +126,16 @@ func (h *AuthHandler) Login(c *gin.Context) {
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	var req dto.RefreshTokenRequest
 
-	// Bind and validate request
+	// Bind and validate request; browser clients may present the token as a cookie instead
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "validation_error",
-			Message: err.Error(),
-		})
-		return
+		req.RefreshToken = h.refreshTokenCookie(c)
+		if req.RefreshToken == "" {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+			return
+		}
 	}
 
 	// Get client IP and User-Agent
@@ -128,7 +143,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	userAgent := c.Request.UserAgent()
 
 	// Call auth service
-	accessToken, err := h.authService.RefreshToken(c.Request.Context(), req.RefreshToken, ipAddress, userAgent)
+	accessToken, refreshToken, err := h.authService.RefreshToken(c.Request.Context(), req.RefreshToken, ipAddress, userAgent)
 	if err != nil {
 		// Handle domain errors
 		switch err {
@@ -142,6 +157,11 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 				Error:   "token_expired",
 				Message: "Refresh token has expired",
 			})
+		case errors.ErrTokenReused:
+			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+				Error:   "token_reused",
+				Message: "Refresh token was already used; all sessions have been signed out",
+			})
 		default:
 			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
 				Error:   "internal_error",
@@ -152,16 +172,19 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	}
 
 	// Return success response
+	h.setAuthCookies(c, accessToken, refreshToken)
 	c.JSON(http.StatusOK, dto.RefreshTokenResponse{
-		AccessToken: accessToken,
-		TokenType:   "Bearer",
-		ExpiresIn:   h.accessTokenTTL * 3600, // convert hours to seconds
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    h.accessTokenTTL * 3600, // convert hours to seconds
 	})
 }
 
 // Logout handles POST /api/v1/auth/logout
 // @Summary Logout and revoke tokens
-// @Description Revoke the active session and optional refresh token.
+// @Description Revoke the access token used for this request and the optional refresh token.
+// @Description Without a refresh token every session of the user is revoked.
 // @Tags Auth
 // @Accept json
 // @Produce json
@@ -185,9 +208,12 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 	// Get refresh token from request body (optional)
 	var req dto.LogoutRequest
 	_ = c.ShouldBindJSON(&req)
+	if req.RefreshToken == "" {
+		req.RefreshToken = h.refreshTokenCookie(c)
+	}
 
 	// Call auth service to revoke token(s)
-	if err := h.authService.Logout(c.Request.Context(), userID.(string), req.RefreshToken); err != nil {
+	if err := h.authService.Logout(c.Request.Context(), userID.(string), c.GetString("accessToken"), req.RefreshToken); err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
 			Error:   "internal_error",
 			Message: "Failed to logout",
@@ -196,7 +222,194 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 	}
 
 	// Return success response
+	h.clearAuthCookies(c)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Logged out successfully",
 	})
 }
+
+// LogoutAll handles POST /api/v1/auth/logout-all
+// @Summary Logout from all sessions
+// @Description Revoke every refresh token of the authenticated user and the access token used for this request.
+// @Description Access tokens held by other sessions remain valid until they expire.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Router /auth/logout-all [post]
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	if err := h.authService.LogoutAll(c.Request.Context(), userID.(string), c.GetString("accessToken")); err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to logout",
+		})
+		return
+	}
+
+	h.clearAuthCookies(c)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Logged out from all sessions",
+	})
+}
+
+// ListSessions handles GET /api/v1/auth/sessions
+// @Summary List active sessions
+// @Description List the authenticated user's active sessions (unexpired, non-revoked refresh tokens), newest first.
+// @Description The client address and user agent are only known for sessions started after they began to be recorded.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} dto.SessionListResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Router /auth/sessions [get]
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	sessions, err := h.authService.ListSessions(c.Request.Context(), userID.(string))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to list sessions",
+		})
+		return
+	}
+
+	responses := make([]dto.SessionResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = dto.FromRefreshToken(session)
+	}
+
+	c.JSON(http.StatusOK, dto.SessionListResponse{Data: responses})
+}
+
+// RevokeSession handles DELETE /api/v1/auth/sessions/:id
+// @Summary Revoke a session
+// @Description Revoke one of the authenticated user's active sessions by the ID returned from GET /auth/sessions.
+// @Description Access tokens already issued to that session stay valid until they expire.
+// @Tags Auth
+// @Param id path string true "Session ID (UUID)"
+// @Success 204 "Session revoked"
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Router /auth/sessions/{id} [delete]
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	sessionID := c.Param("id")
+	if _, err := uuid.Parse(sessionID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid session ID format",
+		})
+		return
+	}
+
+	err := h.authService.RevokeSession(c.Request.Context(), userID.(string), sessionID)
+	if err != nil {
+		switch err {
+		case errors.ErrSessionNotFound:
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{
+				Error:   "session_not_found",
+				Message: "Session not found",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to revoke session",
+			})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeDeviceSessions handles DELETE /api/v1/auth/sessions/device/:deviceId
+// @Summary Revoke all sessions on a device
+// @Description Revoke every refresh token the authenticated user holds on the given device.
+// @Tags Auth
+// @Produce json
+// @Param deviceId path string true "Device identifier sent as X-Device-ID at login"
+// @Success 200 {object} dto.RevokeDeviceSessionsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Router /auth/sessions/device/{deviceId} [delete]
+func (h *AuthHandler) RevokeDeviceSessions(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	deviceID := strings.TrimSpace(c.Param("deviceId"))
+	if deviceID == "" || len(deviceID) > entities.MaxDeviceIDLength {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_device_id",
+			Message: fmt.Sprintf("Device ID must be between 1 and %d characters", entities.MaxDeviceIDLength),
+		})
+		return
+	}
+
+	// Call auth service to revoke the device's tokens
+	revoked, err := h.authService.RevokeDeviceSessions(c.Request.Context(), userID.(string), deviceID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to revoke device sessions",
+		})
+		return
+	}
+
+	if revoked == 0 {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "not_found",
+			Message: "No active sessions found for this device",
+		})
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, dto.RevokeDeviceSessionsResponse{
+		Message:         "Device sessions revoked successfully",
+		RevokedSessions: revoked,
+	})
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/middleware"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+)
+
+// stubAuthService answers RefreshToken with a fixed error, or with a new token pair when
+// refreshErr is nil. The refresh token it was given is kept in presented
+type stubAuthService struct {
+	usecases.AuthService
+	refreshErr error
+	presented  string
+}
+
+func (s *stubAuthService) RefreshToken(ctx context.Context, refreshToken, ipAddress, userAgent string) (string, string, error) {
+	s.presented = refreshToken
+	if s.refreshErr != nil {
+		return "", "", s.refreshErr
+	}
+	return "new-access-token", "new-refresh-token", nil
+}
+
+func TestRefreshTokenReuseRespondsUnauthorized(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	handler := NewAuthHandler(&stubAuthService{refreshErr: errors.ErrTokenReused}, nil, 1, AuthCookieConfig{})
+	router := gin.New()
+	router.POST("/auth/refresh", handler.RefreshToken)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/auth/refresh", strings.NewReader(`{"refresh_token":"rotated-token"}`))
+	request.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", recorder.Code, http.StatusUnauthorized)
+	}
+	var response dto.ErrorResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if response.Error != "token_reused" {
+		t.Errorf("error code = %q, want token_reused", response.Error)
+	}
+}
+
+func TestRefreshTokenSetsSecureCookies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	service := &stubAuthService{}
+	handler := NewAuthHandler(service, nil, 1, AuthCookieConfig{
+		Enabled:         true,
+		Secure:          true,
+		SameSite:        http.SameSiteStrictMode,
+		AccessTokenTTL:  time.Hour,
+		RefreshTokenTTL: 7 * 24 * time.Hour,
+	})
+	router := gin.New()
+	router.POST("/api/v1/auth/refresh", handler.RefreshToken)
+
+	// A browser client presents the refresh token as a cookie, without a body
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/auth/refresh", nil)
+	request.AddCookie(&http.Cookie{Name: middleware.RefreshTokenCookie, Value: "cookie-refresh-token"})
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", recorder.Code)
+	}
+	if service.presented != "cookie-refresh-token" {
+		t.Errorf("refreshed with %q, want the cookie's token", service.presented)
+	}
+
+	cookies := map[string]*http.Cookie{}
+	for _, cookie := range recorder.Result().Cookies() {
+		cookies[cookie.Name] = cookie
+	}
+	for _, want := range []struct {
+		name, value, path string
+		maxAge            int
+	}{
+		{middleware.AccessTokenCookie, "new-access-token", "/", 3600},
+		{middleware.RefreshTokenCookie, "new-refresh-token", authCookiePath, 7 * 24 * 3600},
+	} {
+		cookie, ok := cookies[want.name]
+		if !ok {
+			t.Errorf("no %s cookie set", want.name)
+			continue
+		}
+		if cookie.Value != want.value || cookie.Path != want.path || cookie.MaxAge != want.maxAge {
+			t.Errorf("%s cookie = %q path %s max-age %d, want %q path %s max-age %d",
+				want.name, cookie.Value, cookie.Path, cookie.MaxAge, want.value, want.path, want.maxAge)
+		}
+		if !cookie.HttpOnly || !cookie.Secure || cookie.SameSite != http.SameSiteStrictMode {
+			t.Errorf("%s cookie HttpOnly=%v Secure=%v SameSite=%v, want HttpOnly, Secure and strict",
+				want.name, cookie.HttpOnly, cookie.Secure, cookie.SameSite)
+		}
+	}
+}
+
+func TestRefreshTokenSetsNoCookiesWhenDisabled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	handler := NewAuthHandler(&stubAuthService{}, nil, 1, AuthCookieConfig{})
+	router := gin.New()
+	router.POST("/api/v1/auth/refresh", handler.RefreshToken)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/auth/refresh", strings.NewReader(`{"refresh_token":"login-token"}`))
+	request.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK || len(recorder.Result().Cookies()) != 0 {
+		t.Errorf("status = %d with %d cookies, want 200 and no cookies", recorder.Code, len(recorder.Result().Cookies()))
+	}
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+)
+
+// CategoryHandler handles HTTP requests for the report category taxonomy
+type CategoryHandler struct {
+	categoryService usecases.CategoryService
+}
+
+// NewCategoryHandler creates a new category handler
+func NewCategoryHandler(categoryService usecases.CategoryService) *CategoryHandler {
+	return &CategoryHandler{
+		categoryService: categoryService,
+	}
+}
+
+// ListCategories godoc
+// @Summary List report categories
+// @Description List the categories reports can be classified by, in display order. Use the id as category_id when creating a report
+// @Tags Damaged Roads
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.CategoryListResponse "Categories"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /categories [get]
+func (h *CategoryHandler) ListCategories(c *gin.Context) {
+	categories, err := h.categoryService.ListCategories(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve categories",
+		})
+		return
+	}
+
+	responses := make([]dto.CategoryResponse, len(categories))
+	for i, category := range categories {
+		responses[i] = dto.FromCategory(category)
+	}
+
+	c.JSON(http.StatusOK, dto.CategoryListResponse{Data: responses})
+}
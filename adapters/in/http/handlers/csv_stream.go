@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// csvStream writes a CSV file to the response one row at a time, like jsonArrayStream.
+// The status line, download headers and header row are sent lazily on the first row, which
+// lets callers still reply with a regular error if nothing has been written yet.
+type csvStream struct {
+	writer   gin.ResponseWriter
+	csv      *csv.Writer
+	filename string
+	header   []string
+	count    int
+	started  bool
+}
+
+// newCSVStream creates a stream that browsers download as filename
+func newCSVStream(writer gin.ResponseWriter, filename string, header []string) *csvStream {
+	return &csvStream{
+		writer:   writer,
+		csv:      csv.NewWriter(writer),
+		filename: filename,
+		header:   header,
+	}
+}
+
+// Started reports whether any bytes of the response have been sent
+func (s *csvStream) Started() bool {
+	return s.started
+}
+
+// Write writes a single row
+func (s *csvStream) Write(record []string) error {
+	if !s.started {
+		if err := s.begin(); err != nil {
+			return err
+		}
+	}
+	if err := s.csv.Write(record); err != nil {
+		return err
+	}
+
+	s.count++
+	if s.count%streamFlushInterval == 0 {
+		return s.flush()
+	}
+	return nil
+}
+
+// Close flushes the remaining rows, writing only the header row if no row was streamed
+func (s *csvStream) Close() error {
+	if !s.started {
+		if err := s.begin(); err != nil {
+			return err
+		}
+	}
+	return s.flush()
+}
+
+// begin sends the headers and the header row
+func (s *csvStream) begin() error {
+	s.started = true
+	s.writer.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	s.writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", s.filename))
+	s.writer.WriteHeader(http.StatusOK)
+	if err := s.csv.Write(s.header); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+// flush pushes buffered rows through to the client
+func (s *csvStream) flush() error {
+	s.csv.Flush()
+	if err := s.csv.Error(); err != nil {
+		return err
+	}
+	s.writer.Flush()
+	return nil
+}
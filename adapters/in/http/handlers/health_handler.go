@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/jmoiron/sqlx"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
 )
 
 // HealthHandler handles health check endpoints
@@ -59,7 +60,7 @@ func (h *HealthHandler) HealthCheck(c *gin.Context) {
 		Status:    overallStatus,
 		Uptime:    uptime.String(),
 		Checks:    checks,
-		Timestamp: time.Now().Format(time.RFC3339),
+		Timestamp: dto.FormatTimestamp(time.Now()),
 	}
 
 	statusCode := http.StatusOK
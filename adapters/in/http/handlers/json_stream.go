@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// streamFlushInterval is how many array elements are written between flushes
+const streamFlushInterval = 100
+
+// jsonArrayStream writes a JSON array to the response one element at a time, so large
+// result sets are sent as they are produced instead of being materialized in memory.
+// The status line and opening bracket are sent lazily on the first element, which lets
+// callers still reply with a regular error if nothing has been written yet.
+// The array may be wrapped in an enclosing document through the prefix and suffix.
+type jsonArrayStream struct {
+	writer      gin.ResponseWriter
+	contentType string
+	prefix      string
+	suffix      string
+	count       int
+	started     bool
+}
+
+// newJSONArrayStream creates a stream writing a bare array to the given response writer
+func newJSONArrayStream(writer gin.ResponseWriter) *jsonArrayStream {
+	return newJSONDocumentStream(writer, "application/json; charset=utf-8", "[", "]")
+}
+
+// newJSONDocumentStream creates a stream whose array is enclosed by prefix and suffix,
+// e.g. `{"items":[` and `]}`; the prefix must end with the opening bracket
+func newJSONDocumentStream(writer gin.ResponseWriter, contentType, prefix, suffix string) *jsonArrayStream {
+	return &jsonArrayStream{
+		writer:      writer,
+		contentType: contentType,
+		prefix:      prefix,
+		suffix:      suffix,
+	}
+}
+
+// Started reports whether any bytes of the response have been sent
+func (s *jsonArrayStream) Started() bool {
+	return s.started
+}
+
+// Write encodes a single array element
+func (s *jsonArrayStream) Write(item interface{}) error {
+	encoded, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	if !s.started {
+		s.begin()
+	} else if _, err := s.writer.WriteString(","); err != nil {
+		return err
+	}
+
+	if _, err := s.writer.Write(encoded); err != nil {
+		return err
+	}
+
+	s.count++
+	if s.count%streamFlushInterval == 0 {
+		s.writer.Flush()
+	}
+	return nil
+}
+
+// Close terminates the array and document, writing an empty array if no element was streamed
+func (s *jsonArrayStream) Close() error {
+	if !s.started {
+		s.begin()
+	}
+	if _, err := s.writer.WriteString(s.suffix); err != nil {
+		return err
+	}
+	s.writer.Flush()
+	return nil
+}
+
+// begin sends the headers and the prefix
+func (s *jsonArrayStream) begin() {
+	s.started = true
+	s.writer.Header().Set("Content-Type", s.contentType)
+	s.writer.WriteHeader(http.StatusOK)
+	_, _ = s.writer.WriteString(s.prefix)
+	s.writer.Flush()
+}
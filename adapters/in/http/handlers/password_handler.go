@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/middleware"
 	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
 	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
 )
@@ -36,11 +37,7 @@ func (h *PasswordHandler) RequestPasswordReset(c *gin.Context) {
 	var req dto.PasswordResetRequestRequest
 
 	// Bind and validate request
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "validation_error",
-			Message: err.Error(),
-		})
+	if !middleware.BindAndValidate(c, &req) {
 		return
 	}
 
@@ -80,11 +77,7 @@ func (h *PasswordHandler) ResetPassword(c *gin.Context) {
 	var req dto.PasswordResetConfirmRequest
 
 	// Bind and validate request
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "validation_error",
-			Message: err.Error(),
-		})
+	if !middleware.BindAndValidate(c, &req) {
 		return
 	}
 
@@ -149,11 +142,7 @@ func (h *PasswordHandler) ChangePassword(c *gin.Context) {
 	var req dto.PasswordChangeRequest
 
 	// Bind and validate request
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "validation_error",
-			Message: err.Error(),
-		})
+	if !middleware.BindAndValidate(c, &req) {
 		return
 	}
 
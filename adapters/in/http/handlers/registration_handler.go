@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/middleware"
 	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
 	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
 )
@@ -37,11 +38,7 @@ func (h *RegistrationHandler) Register(c *gin.Context) {
 	var req dto.RegistrationRequest
 
 	// Bind and validate request
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "validation_error",
-			Message: err.Error(),
-		})
+	if !middleware.BindAndValidate(c, &req) {
 		return
 	}
 
@@ -84,6 +81,6 @@ func (h *RegistrationHandler) Register(c *gin.Context) {
 		Name:      user.Name,
 		Email:     user.Email,
 		Role:      user.Role,
-		CreatedAt: user.CreatedAt,
+		CreatedAt: dto.FormatTimestamp(user.CreatedAt),
 	})
 }
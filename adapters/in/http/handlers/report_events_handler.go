@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+const (
+	// reportEventsHeartbeat is how often an idle stream sends a comment line, so proxies
+	// and load balancers don't close the connection
+	reportEventsHeartbeat = 30 * time.Second
+
+	// reportEventsBuffer is how many events a client may fall behind before it misses some
+	reportEventsBuffer = 16
+)
+
+// reportEvent is a server-sent event queued for one client
+type reportEvent struct {
+	id   string
+	name string
+	data interface{}
+}
+
+// reportEventsClient is who a stream belongs to, to decide which events it may see
+type reportEventsClient struct {
+	userID uuid.UUID
+	staff  bool
+}
+
+// ReportEventsHandler streams report status changes to live dashboards as server-sent events.
+// It subscribes once to the event bus and fans each event out to the connected clients
+// allowed to see it
+type ReportEventsHandler struct {
+	mu      sync.Mutex
+	clients map[chan reportEvent]reportEventsClient
+}
+
+// NewReportEventsHandler creates a new report events handler fed by the event bus
+func NewReportEventsHandler(eventBus external.EventBus) *ReportEventsHandler {
+	h := &ReportEventsHandler{
+		clients: make(map[chan reportEvent]reportEventsClient),
+	}
+	eventBus.Subscribe(entities.EventReportStatusChanged, h.broadcast)
+	return h
+}
+
+// StreamEvents godoc
+// @Summary Stream report status changes
+// @Description Server-sent events (text/event-stream) for live dashboards: one status_changed event per report
+// @Description status change, with the status change ID as the event ID. Citizens only receive changes to their
+// @Description own reports; verificators and admins receive every change. Idle streams receive a comment line
+// @Description every 30 seconds.
+// @Tags Damaged Roads
+// @Produce text/event-stream
+// @Security BearerAuth
+// @Success 200 {object} dto.ReportStatusEventResponse "Stream of status_changed events carrying this data"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Router /damaged-roads/events [get]
+func (h *ReportEventsHandler) StreamEvents(c *gin.Context) {
+	requesterID, ok := requesterIDFromContext(c)
+	if !ok {
+		return
+	}
+	events := h.addClient(reportEventsClient{
+		userID: requesterID,
+		staff:  entities.IsStaffRole(c.GetString("userRole")),
+	})
+	defer h.removeClient(events)
+
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no") // keep nginx from buffering the stream
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(reportEventsHeartbeat)
+	defer heartbeat.Stop()
+
+	// The request context ends when the client disconnects or the server shuts down
+	ctx := c.Request.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := io.WriteString(c.Writer, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case event := <-events:
+			if err := writeServerSentEvent(c.Writer, event); err != nil {
+				logger.WarnContext(ctx, "Failed to write report event", map[string]interface{}{
+					"error": err.Error(),
+				})
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
+
+// addClient registers a new client and returns its event channel
+func (h *ReportEventsHandler) addClient(client reportEventsClient) chan reportEvent {
+	events := make(chan reportEvent, reportEventsBuffer)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.clients[events] = client
+	return events
+}
+
+// removeClient unregisters a client once its stream has ended
+func (h *ReportEventsHandler) removeClient(events chan reportEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.clients, events)
+}
+
+// broadcast queues a status change for the report's author and for staff. The bus calls it synchronously
+// from the request that changed the status, so a client whose buffer is full misses the event
+// rather than holding up that request
+func (h *ReportEventsHandler) broadcast(ctx context.Context, event entities.DomainEvent) error {
+	changed, ok := event.(entities.ReportStatusChangedEvent)
+	if !ok {
+		return nil
+	}
+
+	message := reportEvent{
+		id:   changed.Change.ID.String(),
+		name: "status_changed",
+		data: dto.FromReportStatusChangedEvent(changed.Report, changed.Change),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	dropped := 0
+	for events, client := range h.clients {
+		if !client.staff && client.userID != changed.Report.AuthorID {
+			continue
+		}
+		select {
+		case events <- message:
+		default:
+			dropped++
+		}
+	}
+	if dropped > 0 {
+		logger.WarnContext(ctx, "Report event dropped for slow stream clients", map[string]interface{}{
+			"report_id": changed.Report.ID.String(),
+			"clients":   dropped,
+		})
+	}
+	return nil
+}
+
+// writeServerSentEvent writes one event in the text/event-stream format. The JSON data never
+// contains newlines, so it fits on a single data line
+func writeServerSentEvent(w io.Writer, event reportEvent) error {
+	data, err := json.Marshal(event.data)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.id, event.name, data)
+	return err
+}
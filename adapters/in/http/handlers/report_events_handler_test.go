@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/adapters/out/messaging"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+func TestReportEventsOnlyReachAuthorAndStaff(t *testing.T) {
+	bus := messaging.NewSyncEventBus()
+	handler := NewReportEventsHandler(bus)
+
+	authorID := uuid.New()
+	author := handler.addClient(reportEventsClient{userID: authorID})
+	otherCitizen := handler.addClient(reportEventsClient{userID: uuid.New()})
+	verificator := handler.addClient(reportEventsClient{userID: uuid.New(), staff: true})
+
+	report := &entities.DamagedRoad{ID: uuid.New(), AuthorID: authorID, Title: "Jalan berlubang"}
+	change := &entities.StatusChange{
+		ID:         uuid.New(),
+		ReportID:   report.ID,
+		FromStatus: entities.StatusSubmitted,
+		ToStatus:   entities.StatusVerified,
+		ChangedAt:  time.Now(),
+	}
+	bus.Publish(context.Background(), entities.NewReportStatusChangedEvent(report, change))
+
+	for name, events := range map[string]chan reportEvent{"author": author, "verificator": verificator} {
+		select {
+		case event := <-events:
+			if event.id != change.ID.String() {
+				t.Errorf("%s received event %s, want %s", name, event.id, change.ID)
+			}
+		default:
+			t.Errorf("%s did not receive the status change", name)
+		}
+	}
+	select {
+	case event := <-otherCitizen:
+		t.Errorf("another citizen received event %s for a report they did not write", event.id)
+	default:
+	}
+}
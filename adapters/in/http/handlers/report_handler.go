@@ -3,7 +3,12 @@ package handlers
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -12,17 +17,31 @@ import (
 	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
 	domainerrors "github.com/nicklaros/jalanrusak-be/core/domain/errors"
 	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
 )
 
+// ReportHandlerConfig holds tunable response behavior for the report handler
+type ReportHandlerConfig struct {
+	// GeometryErrorDetails includes the per-coordinate violation list in error responses
+	GeometryErrorDetails bool
+	// MapResultLimit is the cap on reports per map viewport, echoed in map responses
+	MapResultLimit int
+	// OutputSRIDs allowlists the CRSs report geometries may be requested in with the srid
+	// query parameter; WGS84 (4326) is always allowed
+	OutputSRIDs []int
+}
+
 // ReportHandler handles HTTP requests for damaged road reports
 type ReportHandler struct {
 	reportService usecases.ReportService
+	config        ReportHandlerConfig
 }
 
 // NewReportHandler creates a new report handler
-func NewReportHandler(reportService usecases.ReportService) *ReportHandler {
+func NewReportHandler(reportService usecases.ReportService, config ReportHandlerConfig) *ReportHandler {
 	return &ReportHandler{
 		reportService: reportService,
+		config:        config,
 	}
 }
 
@@ -34,28 +53,19 @@ func NewReportHandler(reportService usecases.ReportService) *ReportHandler {
 // @Produce json
 // @Security BearerAuth
 // @Param request body dto.CreateDamagedRoadRequest true "Create damaged road request"
-// @Success 201 {object} dto.DamagedRoadResponse "Report created successfully"
+// @Param Prefer header string false "Send return=minimal to receive only the Location header and an empty body"
+// @Success 201 {object} dto.DamagedRoadResponse "Report created successfully (empty body with return=minimal)"
+// @Header 201 {string} Location "URL of the created report"
 // @Failure 400 {object} dto.ErrorResponse "Bad request - validation errors"
 // @Failure 401 {object} dto.ErrorResponse "Unauthorized - authentication required"
+// @Failure 409 {object} dto.ErrorResponse{details=dto.DuplicateReportDetails} "An unresolved report already exists nearby; resubmit with force to create anyway"
+// @Failure 429 {object} dto.ErrorResponse "Too soon since the previous report (see Retry-After)"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
 // @Router /damaged-roads [post]
 func (h *ReportHandler) CreateReport(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
-	userID, exists := c.Get("userID")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "User authentication required",
-		})
-		return
-	}
-
-	authorID, err := uuid.Parse(userID.(string))
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "internal_error",
-			Message: "Invalid user ID format: " + err.Error(),
-		})
+	authorID, ok := requesterIDFromContext(c)
+	if !ok {
 		return
 	}
 
@@ -84,19 +94,33 @@ func (h *ReportHandler) CreateReport(c *gin.Context) {
 		req.PhotoURLs,
 		authorID,
 		description,
+		req.CategoryID,
+		req.Force,
 	)
 
 	if err != nil {
-		// Handle validation errors
-		var validationErr *domainerrors.ValidationError
-		if errors.As(err, &validationErr) {
-			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-				Error:   "validation_error",
-				Message: validationErr.Error(),
+		// Point the client to the report this one duplicates
+		var duplicateErr *domainerrors.DuplicateReportError
+		if errors.As(err, &duplicateErr) {
+			h.respondDuplicate(c, duplicateErr)
+			return
+		}
+
+		// Handle per-user submission interval
+		var tooSoonErr *domainerrors.TooSoonError
+		if errors.As(err, &tooSoonErr) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(tooSoonErr.RetryAfter.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, dto.ErrorResponse{
+				Error:   "too_soon",
+				Message: tooSoonErr.Error(),
 			})
 			return
 		}
 
+		if h.respondContentError(c, err) {
+			return
+		}
+
 		// Handle other errors
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
 			Error:   "internal_error",
@@ -105,19 +129,114 @@ func (h *ReportHandler) CreateReport(c *gin.Context) {
 		return
 	}
 
+	c.Header("Location", "/api/v1/damaged-roads/"+road.ID.String())
+
+	// Honor Prefer: return=minimal for clients that don't need the entity echoed back
+	if prefersMinimalReturn(c) {
+		c.Header("Preference-Applied", "return=minimal")
+		c.Status(http.StatusCreated)
+		return
+	}
+
 	// Return created report
 	response := dto.FromDamagedRoad(road)
 	c.JSON(http.StatusCreated, response)
 }
 
+// respondDuplicate writes the 409 response for a duplicate report, including a summary of the
+// existing report when it can be loaded
+func (h *ReportHandler) respondDuplicate(c *gin.Context, duplicateErr *domainerrors.DuplicateReportError) {
+	details := dto.DuplicateReportDetails{ExistingReportID: duplicateErr.ExistingReportID.String()}
+	if existing, err := h.reportService.GetReport(c.Request.Context(), duplicateErr.ExistingReportID, 0); err == nil {
+		response := dto.FromDamagedRoad(existing)
+		details.ExistingReport = &response
+	}
+
+	c.JSON(http.StatusConflict, dto.ErrorResponse{
+		Error:   "duplicate_report",
+		Message: duplicateErr.Error(),
+		Details: details,
+	})
+}
+
+// respondContentError writes the 400 response for report content that failed validation
+// on create or update. It returns false when err is not a content error.
+func (h *ReportHandler) respondContentError(c *gin.Context, err error) bool {
+	// Handle out-of-bounds coordinates, optionally listing every offending point
+	var boundsErr *domainerrors.CoordinateBoundsError
+	if errors.As(err, &boundsErr) {
+		response := dto.ErrorResponse{
+			Error:   "coordinates_out_of_bounds",
+			Message: boundsErr.Error(),
+		}
+		if h.config.GeometryErrorDetails {
+			response.Details = dto.FromCoordinateBoundsError(boundsErr)
+		}
+		c.JSON(http.StatusBadRequest, response)
+		return true
+	}
+
+	// Handle validation errors
+	var validationErr *domainerrors.ValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: validationErr.Error(),
+		})
+		return true
+	}
+
+	// Handle rejected photo URLs
+	if errors.Is(err, domainerrors.ErrInvalidPhotoURLs) {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_photo_urls",
+			Message: err.Error(),
+		})
+		return true
+	}
+
+	// Handle strict centroid check failures
+	if errors.Is(err, domainerrors.ErrSubDistrictNotFound) {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "subdistrict_not_found",
+			Message: err.Error(),
+		})
+		return true
+	}
+	if errors.Is(err, domainerrors.ErrLocationNotInBoundary) {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "location_mismatch",
+			Message: err.Error(),
+		})
+		return true
+	}
+
+	return false
+}
+
+// prefersMinimalReturn reports whether the request carries a Prefer: return=minimal preference (RFC 7240)
+func prefersMinimalReturn(c *gin.Context) bool {
+	for _, header := range c.Request.Header.Values("Prefer") {
+		for _, preference := range strings.Split(header, ",") {
+			if strings.EqualFold(strings.ReplaceAll(strings.TrimSpace(preference), " ", ""), "return=minimal") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // GetReport godoc
 // @Summary Get a specific damaged road report
-// @Description Retrieve detailed information about a specific damaged road report
+// @Description Retrieve detailed information about a specific damaged road report, including the distance from
+// @Description its path to the subdistrict centroid when the centroid is known
 // @Tags Damaged Roads
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Report ID" format(uuid)
+// @Param srid query int false "CRS of the returned path, e.g. 3857 for Web Mercator; must be allowlisted" default(4326)
 // @Success 200 {object} dto.DamagedRoadResponse "Report details"
+// @Failure 400 {object} dto.ErrorResponse "Invalid report ID or unsupported srid"
 // @Failure 401 {object} dto.ErrorResponse "Unauthorized"
 // @Failure 404 {object} dto.ErrorResponse "Report not found"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
@@ -134,8 +253,13 @@ func (h *ReportHandler) GetReport(c *gin.Context) {
 		return
 	}
 
+	srid, ok := h.bindSRID(c)
+	if !ok {
+		return
+	}
+
 	// Get the report
-	road, err := h.reportService.GetReport(c.Request.Context(), id)
+	road, err := h.reportService.GetReport(c.Request.Context(), id, srid)
 	if err != nil {
 		if errors.Is(err, domainerrors.ErrReportNotFound) {
 			c.JSON(http.StatusNotFound, dto.ErrorResponse{
@@ -154,9 +278,60 @@ func (h *ReportHandler) GetReport(c *gin.Context) {
 
 	// Return report
 	response := dto.FromDamagedRoad(road)
+	if c.GetString("userRole") == entities.RoleAdmin {
+		response.WithAuthorEmail(road)
+	}
 	c.JSON(http.StatusOK, response)
 }
 
+// GetStatusHistory godoc
+// @Summary Get the status history of a report
+// @Description Get every status transition of a report, oldest first, with who made it and when
+// @Tags Damaged Roads
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID" format(uuid)
+// @Success 200 {object} dto.StatusHistoryResponse "Status timeline"
+// @Failure 400 {object} dto.ErrorResponse "Invalid report ID"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 404 {object} dto.ErrorResponse "Report not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /damaged-roads/{id}/history [get]
+func (h *ReportHandler) GetStatusHistory(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid report ID format",
+		})
+		return
+	}
+
+	changes, err := h.reportService.GetStatusHistory(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrReportNotFound) {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{
+				Error:   "not_found",
+				Message: "Report not found",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve status history",
+		})
+		return
+	}
+
+	responses := make([]dto.StatusChangeResponse, len(changes))
+	for i, change := range changes {
+		responses[i] = dto.FromStatusChange(change)
+	}
+
+	c.JSON(http.StatusOK, dto.StatusHistoryResponse{Data: responses})
+}
+
 // ListReports godoc
 // @Summary List damaged road reports
 // @Description Get paginated list of damaged road reports with optional filters
@@ -165,46 +340,65 @@ func (h *ReportHandler) GetReport(c *gin.Context) {
 // @Security BearerAuth
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20) maximum(100)
+// @Param cursor query string false "Opaque pagination.next_cursor of the previous page; replaces page and requires sorting by created_at"
 // @Param status query string false "Filter by status"
 // @Param subdistrict_code query string false "Filter by subdistrict code"
+// @Param category_id query string false "Filter by category id (see GET /categories)"
+// @Param q query string false "Case-insensitive text to find in the title or description" maxlength(100)
+// @Param sla_breached query bool false "Filter by whether the report exceeded the SLA of its current status"
+// @Param created_from query string false "Only reports created at or after this time (RFC3339)" format(date-time)
+// @Param created_to query string false "Only reports created at or before this time (RFC3339)" format(date-time)
+// @Param sort query string false "Sort field (created_at, updated_at, title) with optional direction, e.g. title:asc" default(created_at:desc)
+// @Param srid query int false "CRS of the returned paths, e.g. 3857 for Web Mercator; must be allowlisted" default(4326)
+// @Param include_unfiltered_total query bool false "Also return pagination.unfiltered_total, the report count ignoring filters"
+// @Param include_deleted query bool false "Admins only: also list soft-deleted reports, marked with deleted_at"
+// @Param stream query bool false "Admins only: stream every matching report as a bare JSON array of dto.DamagedRoadResponse, ignoring pagination"
 // @Success 200 {object} dto.DamagedRoadListResponse "List of reports"
+// @Failure 400 {object} dto.ErrorResponse "Invalid sla_breached, date range, sort, srid or cursor"
 // @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 403 {object} dto.ErrorResponse "Streaming or deleted reports requested without a privileged role"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
 // @Router /damaged-roads [get]
 func (h *ReportHandler) ListReports(c *gin.Context) {
-	// Parse pagination parameters
-	page := 1
-	if pageParam := c.Query("page"); pageParam != "" {
-		if _, err := fmt.Sscanf(pageParam, "%d", &page); err != nil || page < 1 {
-			page = 1
-		}
-	}
-
-	limit := 20
-	if limitParam := c.Query("limit"); limitParam != "" {
-		if _, err := fmt.Sscanf(limitParam, "%d", &limit); err != nil || limit < 1 || limit > 100 {
-			limit = 20
-		}
-	}
-
+	page, limit := pageParams(c)
 	offset := (page - 1) * limit
 
 	// Build filters
-	filters := entities.NewDamagedRoadFilters()
+	filters, ok := h.bindListFilters(c)
+	if !ok {
+		return
+	}
 	filters.Limit = limit
 	filters.Offset = offset
 
-	// Status filter
-	if statusParam := c.Query("status"); statusParam != "" {
-		status := entities.Status(statusParam)
-		if status.IsValid() {
-			filters.Status = &status
+	// Keyset pagination for infinite scroll; stable while new reports arrive
+	if cursorParam := c.Query("cursor"); cursorParam != "" {
+		cursor, err := dto.DecodeReportCursor(cursorParam)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: "cursor is invalid; pass pagination.next_cursor from the previous page",
+			})
+			return
+		}
+		if filters.Sort.Field != entities.ReportSortCreatedAt {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: "cursor pagination requires sorting by created_at",
+			})
+			return
 		}
+		filters.Cursor = cursor
+		page, offset = 0, 0
+		filters.Offset = 0
 	}
 
-	// Subdistrict code filter
-	if subdistrictParam := c.Query("subdistrict_code"); subdistrictParam != "" {
-		filters.SubDistrictCode = &subdistrictParam
+	// Stream the full result set for privileged callers
+	if streamParam := c.Query("stream"); streamParam != "" {
+		if stream, err := strconv.ParseBool(streamParam); err == nil && stream {
+			h.streamReports(c, filters)
+			return
+		}
 	}
 
 	// Get reports
@@ -218,108 +412,637 @@ func (h *ReportHandler) ListReports(c *gin.Context) {
 	}
 
 	// Convert to DTOs
+	isAdmin := c.GetString("userRole") == entities.RoleAdmin
 	responses := make([]dto.DamagedRoadResponse, len(roads))
 	for i, road := range roads {
 		responses[i] = dto.FromDamagedRoad(road)
+		if isAdmin {
+			responses[i].WithAuthorEmail(road)
+		}
+	}
+
+	pagination := dto.PaginationMeta{
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+		Page:   page,
+	}
+	if filters.Sort.Field == entities.ReportSortCreatedAt && len(roads) == limit {
+		pagination.NextCursor = dto.EncodeReportCursor(roads[len(roads)-1])
+	}
+
+	// Unfiltered total lets clients show "0 of N match your filters"
+	if includeParam := c.Query("include_unfiltered_total"); includeParam != "" {
+		if include, err := strconv.ParseBool(includeParam); err == nil && include {
+			unfilteredTotal := total
+			if filters.IsFiltered() {
+				unfilteredTotal, err = h.reportService.CountReports(c.Request.Context())
+				if err != nil {
+					c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+						Error:   "internal_error",
+						Message: "Failed to retrieve reports",
+					})
+					return
+				}
+			}
+			pagination.UnfilteredTotal = &unfilteredTotal
+		}
 	}
 
 	// Return paginated response
 	c.JSON(http.StatusOK, dto.DamagedRoadListResponse{
-		Data: responses,
-		Pagination: dto.PaginationMeta{
-			Total:  total,
-			Limit:  limit,
-			Offset: offset,
-			Page:   page,
-		},
+		Data:       responses,
+		Pagination: pagination,
 	})
 }
 
-// UpdateReportStatus godoc
-// @Summary Update report status
-// @Description Update the status of a damaged road report (for administrators/verificators)
+// ListMyReports godoc
+// @Summary List my damaged road reports
+// @Description Get the authenticated user's own reports, newest first, for a "my reports" screen
 // @Tags Damaged Roads
-// @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param id path string true "Report ID" format(uuid)
-// @Param request body dto.UpdateStatusRequest true "Update status request"
-// @Success 200 {object} dto.DamagedRoadResponse "Status updated successfully"
-// @Failure 400 {object} dto.ErrorResponse "Invalid status transition"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20) maximum(100)
+// @Success 200 {object} dto.DamagedRoadListResponse "The user's reports"
 // @Failure 401 {object} dto.ErrorResponse "Unauthorized"
-// @Failure 403 {object} dto.ErrorResponse "Forbidden"
-// @Failure 404 {object} dto.ErrorResponse "Report not found"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
-// @Router /damaged-roads/{id}/status [patch]
-func (h *ReportHandler) UpdateReportStatus(c *gin.Context) {
-	// Get user ID from context
-	userID, exists := c.Get("userID")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "unauthorized",
-			Message: "User authentication required",
-		})
+// @Router /me/damaged-roads [get]
+func (h *ReportHandler) ListMyReports(c *gin.Context) {
+	authorID, ok := requesterIDFromContext(c)
+	if !ok {
 		return
 	}
 
-	requesterID, ok := userID.(uuid.UUID)
-	if !ok {
+	page, limit := pageParams(c)
+	offset := (page - 1) * limit
+
+	roads, total, err := h.reportService.ListReportsByAuthor(c.Request.Context(), authorID, limit, offset)
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
 			Error:   "internal_error",
-			Message: "Invalid user ID format",
+			Message: "Failed to retrieve reports",
 		})
 		return
 	}
 
-	// Parse report ID
-	idParam := c.Param("id")
-	id, err := uuid.Parse(idParam)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "invalid_id",
-			Message: "Invalid report ID format",
-		})
-		return
+	responses := make([]dto.DamagedRoadResponse, len(roads))
+	for i, road := range roads {
+		responses[i] = dto.FromDamagedRoad(road)
 	}
 
-	// Bind and validate request
-	var req dto.UpdateStatusRequest
-	if !middleware.BindAndValidate(c, &req) {
-		return
+	c.JSON(http.StatusOK, dto.DamagedRoadListResponse{
+		Data: responses,
+		Pagination: dto.PaginationMeta{
+			Total:  total,
+			Limit:  limit,
+			Offset: offset,
+			Page:   page,
+		},
+	})
+}
+
+// pageParams parses the page and limit query parameters shared by the paginated list handlers,
+// falling back to page 1 and 20 items for missing or out of range values
+func pageParams(c *gin.Context) (page, limit int) {
+	page = 1
+	if pageParam := c.Query("page"); pageParam != "" {
+		if _, err := fmt.Sscanf(pageParam, "%d", &page); err != nil || page < 1 {
+			page = 1
+		}
 	}
 
-	// Validate status
-	newStatus := entities.Status(req.Status)
-	if !newStatus.IsValid() {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "invalid_status",
-			Message: "Invalid status value",
-		})
-		return
+	limit = 20
+	if limitParam := c.Query("limit"); limitParam != "" {
+		if _, err := fmt.Sscanf(limitParam, "%d", &limit); err != nil || limit < 1 || limit > 100 {
+			limit = 20
+		}
 	}
 
-	// Update status
-	road, err := h.reportService.UpdateReportStatus(c.Request.Context(), id, newStatus, requesterID)
-	if err != nil {
-		if errors.Is(err, domainerrors.ErrReportNotFound) {
-			c.JSON(http.StatusNotFound, dto.ErrorResponse{
-				Error:   "not_found",
-				Message: "Report not found",
-			})
-			return
+	return page, limit
+}
+
+// bindListFilters parses the report list filters shared by ListReports and ExportReports.
+// On invalid input it writes a 400 response and returns false.
+func (h *ReportHandler) bindListFilters(c *gin.Context) (*entities.DamagedRoadFilters, bool) {
+	filters := entities.NewDamagedRoadFilters()
+
+	// Status filter
+	if statusParam := c.Query("status"); statusParam != "" {
+		status := entities.Status(statusParam)
+		if status.IsValid() {
+			filters.Status = &status
 		}
+	}
 
-		var validationErr *domainerrors.ValidationError
-		if errors.As(err, &validationErr) {
+	// Subdistrict code filter
+	if subdistrictParam := c.Query("subdistrict_code"); subdistrictParam != "" {
+		filters.SubDistrictCode = &subdistrictParam
+	}
+
+	// Category filter
+	if categoryParam := c.Query("category_id"); categoryParam != "" {
+		filters.CategoryID = &categoryParam
+	}
+
+	// Free-text search in title and description
+	if query := strings.TrimSpace(c.Query("q")); query != "" {
+		if len([]rune(query)) > entities.MaxSearchQueryLength {
 			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-				Error:   "invalid_transition",
-				Message: validationErr.Error(),
+				Error:   "validation_error",
+				Message: fmt.Sprintf("q cannot exceed %d characters", entities.MaxSearchQueryLength),
 			})
-			return
+			return nil, false
 		}
+		filters.Query = &query
+	}
 
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "internal_error",
+	// SLA breach filter
+	// A mistyped SLA flag is rejected: ignoring it would list every report as if it matched
+	if slaParam := c.Query("sla_breached"); slaParam != "" {
+		breached, err := strconv.ParseBool(slaParam)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: "sla_breached must be true or false",
+			})
+			return nil, false
+		}
+		filters.SLABreached = &breached
+	}
+
+	// Creation date range filter; like sla_breached, bad values are rejected
+	// rather than ignored so a typo cannot silently widen the range
+	for _, param := range []struct {
+		name   string
+		target **time.Time
+	}{
+		{"created_from", &filters.CreatedFrom},
+		{"created_to", &filters.CreatedTo},
+	} {
+		value := c.Query(param.name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: fmt.Sprintf("%s must be an RFC3339 timestamp, e.g. 2025-10-20T00:00:00Z", param.name),
+			})
+			return nil, false
+		}
+		*param.target = &parsed
+	}
+	if filters.CreatedFrom != nil && filters.CreatedTo != nil && filters.CreatedFrom.After(*filters.CreatedTo) {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: "created_from must not be after created_to",
+		})
+		return nil, false
+	}
+
+	// Sort order; unknown fields are rejected like the date range
+	if sortParam := c.Query("sort"); sortParam != "" {
+		sort, err := entities.ParseReportSort(sortParam)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+			return nil, false
+		}
+		filters.Sort = sort
+	}
+
+	// Soft-deleted reports are only visible to admins, e.g. to audit or recover them
+	if includeParam := c.Query("include_deleted"); includeParam != "" {
+		if include, err := strconv.ParseBool(includeParam); err == nil && include {
+			if c.GetString("userRole") != entities.RoleAdmin {
+				c.JSON(http.StatusForbidden, dto.ErrorResponse{
+					Error:   "forbidden",
+					Message: "Listing deleted reports is restricted to administrators",
+				})
+				return nil, false
+			}
+			filters.IncludeDeleted = true
+		}
+	}
+
+	srid, ok := h.bindSRID(c)
+	if !ok {
+		return nil, false
+	}
+	filters.SRID = srid
+
+	return filters, true
+}
+
+// bindSRID reads the optional srid query parameter, rejecting CRSs outside the allowlist.
+// Returns WGS84 when the parameter is absent
+func (h *ReportHandler) bindSRID(c *gin.Context) (int, bool) {
+	param := c.Query("srid")
+	if param == "" {
+		return entities.SRIDWGS84, true
+	}
+
+	allowed := h.outputSRIDs()
+	srid, err := strconv.Atoi(param)
+	if err != nil || !slices.Contains(allowed, srid) {
+		supported := make([]string, len(allowed))
+		for i, allowedSRID := range allowed {
+			supported[i] = strconv.Itoa(allowedSRID)
+		}
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: "srid must be one of " + strings.Join(supported, ", "),
+		})
+		return 0, false
+	}
+	return srid, true
+}
+
+// outputSRIDs is the srid allowlist, always including WGS84
+func (h *ReportHandler) outputSRIDs() []int {
+	if slices.Contains(h.config.OutputSRIDs, entities.SRIDWGS84) {
+		return h.config.OutputSRIDs
+	}
+	return append([]int{entities.SRIDWGS84}, h.config.OutputSRIDs...)
+}
+
+// NearbyReports godoc
+// @Summary List damaged road reports near a point
+// @Description Get reports whose path comes within the radius of a point, closest first, with the distance to each
+// @Tags Damaged Roads
+// @Produce json
+// @Security BearerAuth
+// @Param lat query number true "Latitude of the search center"
+// @Param lng query number true "Longitude of the search center"
+// @Param radius query number false "Search radius in meters" default(1000) maximum(50000)
+// @Param limit query int false "Maximum number of reports" default(20) maximum(100)
+// @Success 200 {object} dto.NearbyDamagedRoadsResponse "Reports ordered by distance"
+// @Failure 400 {object} dto.ErrorResponse "Invalid coordinates or radius"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /damaged-roads/nearby [get]
+func (h *ReportHandler) NearbyReports(c *gin.Context) {
+	lat, latErr := strconv.ParseFloat(c.Query("lat"), 64)
+	lng, lngErr := strconv.ParseFloat(c.Query("lng"), 64)
+	if latErr != nil || lngErr != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: "lat and lng query parameters are required and must be numbers",
+		})
+		return
+	}
+
+	radius := entities.DefaultNearbyRadiusMeters
+	if radiusParam := c.Query("radius"); radiusParam != "" {
+		var err error
+		if radius, err = strconv.ParseFloat(radiusParam, 64); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: "radius must be a number of meters",
+			})
+			return
+		}
+	}
+
+	limit := 20
+	if limitParam := c.Query("limit"); limitParam != "" {
+		if _, err := fmt.Sscanf(limitParam, "%d", &limit); err != nil || limit < 1 || limit > 100 {
+			limit = 20
+		}
+	}
+
+	center := entities.Point{Lat: lat, Lng: lng}
+	roads, err := h.reportService.FindNearbyReports(c.Request.Context(), center, radius, limit)
+	if err != nil {
+		var validationErr *domainerrors.ValidationError
+		if errors.As(err, &validationErr) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: validationErr.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve reports",
+		})
+		return
+	}
+
+	responses := make([]dto.DamagedRoadResponse, len(roads))
+	for i, road := range roads {
+		responses[i] = dto.FromDamagedRoad(road)
+	}
+
+	c.JSON(http.StatusOK, dto.NearbyDamagedRoadsResponse{
+		Data:         responses,
+		RadiusMeters: radius,
+	})
+}
+
+// MapReports godoc
+// @Summary List damaged road reports inside a map viewport
+// @Description Get the reports whose path intersects the bounding box, newest first. Results are capped; truncated is true when more reports match
+// @Tags Damaged Roads
+// @Produce json
+// @Security BearerAuth
+// @Param min_lat query number true "Southern edge latitude"
+// @Param min_lng query number true "Western edge longitude"
+// @Param max_lat query number true "Northern edge latitude"
+// @Param max_lng query number true "Eastern edge longitude"
+// @Success 200 {object} dto.DamagedRoadMapResponse "Reports in the viewport"
+// @Failure 400 {object} dto.ErrorResponse "Invalid or out of bounds bounding box"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /damaged-roads/map [get]
+func (h *ReportHandler) MapReports(c *gin.Context) {
+	var box entities.BoundingBox
+	for _, param := range []struct {
+		name  string
+		value *float64
+	}{
+		{"min_lat", &box.MinLat},
+		{"min_lng", &box.MinLng},
+		{"max_lat", &box.MaxLat},
+		{"max_lng", &box.MaxLng},
+	} {
+		value, err := strconv.ParseFloat(c.Query(param.name), 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: fmt.Sprintf("%s query parameter is required and must be a number", param.name),
+			})
+			return
+		}
+		*param.value = value
+	}
+
+	roads, truncated, err := h.reportService.FindReportsInBoundingBox(c.Request.Context(), box)
+	if err != nil {
+		var validationErr *domainerrors.ValidationError
+		if errors.As(err, &validationErr) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: validationErr.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve reports",
+		})
+		return
+	}
+
+	responses := make([]dto.DamagedRoadResponse, len(roads))
+	for i, road := range roads {
+		responses[i] = dto.FromDamagedRoad(road)
+	}
+
+	c.JSON(http.StatusOK, dto.DamagedRoadMapResponse{
+		Data:      responses,
+		Truncated: truncated,
+		Limit:     h.config.MapResultLimit,
+	})
+}
+
+// streamReports writes every report matching the filters as a JSON array, row by row.
+// Requires the caller's role to have been resolved into the context as "userRole".
+func (h *ReportHandler) streamReports(c *gin.Context, filters *entities.DamagedRoadFilters) {
+	if c.GetString("userRole") != entities.RoleAdmin {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "forbidden",
+			Message: "Streaming reports is restricted to administrators",
+		})
+		return
+	}
+
+	// The full result set can take longer than the request timeout to send
+	middleware.ReleaseRequestTimeout(c)
+
+	stream := newJSONArrayStream(c.Writer)
+	err := h.reportService.StreamReports(c.Request.Context(), filters, func(road *entities.DamagedRoad) error {
+		response := dto.FromDamagedRoad(road)
+		response.WithAuthorEmail(road)
+		return stream.Write(response)
+	})
+	if err != nil {
+		if !stream.Started() {
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to retrieve reports",
+			})
+		}
+		// Once streaming has begun the array is left unterminated so clients detect truncation
+		return
+	}
+
+	_ = stream.Close()
+}
+
+// ExportReports godoc
+// @Summary Export damaged road reports as GeoJSON or CSV
+// @Description Stream every report matching the filters as a GeoJSON FeatureCollection, one Feature per report
+// @Description with the path as its geometry. Accepts the same filters as the report list, without pagination.
+// @Description With format=csv the reports are downloaded as damaged-roads.csv instead, one row per report with the
+// @Description columns id, title, subdistrict_code, status, author_id, created_at, first_lat, first_lng, photo_count;
+// @Description first_lat and first_lng are always WGS84. Text cells that a spreadsheet would read as a formula are prefixed with '.
+// @Description If the export fails after the download has begun, the connection is dropped so a partial file is never mistaken for a complete one.
+// @Tags Damaged Roads
+// @Produce application/geo+json
+// @Produce text/csv
+// @Security BearerAuth
+// @Param format query string false "Export format" Enums(geojson, csv) default(geojson)
+// @Param status query string false "Filter by status"
+// @Param subdistrict_code query string false "Filter by subdistrict code"
+// @Param category_id query string false "Filter by category id (see GET /categories)"
+// @Param q query string false "Case-insensitive text to find in the title or description" maxlength(100)
+// @Param sla_breached query bool false "Filter by whether the report exceeded the SLA of its current status"
+// @Param created_from query string false "Only reports created at or after this time (RFC3339)" format(date-time)
+// @Param created_to query string false "Only reports created at or before this time (RFC3339)" format(date-time)
+// @Param sort query string false "Sort field (created_at, updated_at, title) with optional direction, e.g. title:asc" default(created_at:desc)
+// @Param srid query int false "CRS of the returned paths, e.g. 3857 for Web Mercator; must be allowlisted" default(4326)
+// @Param include_deleted query bool false "Admins only: also export soft-deleted reports"
+// @Success 200 {object} dto.GeoJSONFeatureCollection "GeoJSON FeatureCollection"
+// @Failure 400 {object} dto.ErrorResponse "Unsupported format or invalid filters"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 403 {object} dto.ErrorResponse "Deleted reports requested without a privileged role"
+// @Failure 429 {object} dto.ErrorResponse "Too many exports"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /damaged-roads/export [get]
+func (h *ReportHandler) ExportReports(c *gin.Context) {
+	format := c.DefaultQuery("format", "geojson")
+	if format != "geojson" && format != "csv" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: "format must be geojson or csv",
+		})
+		return
+	}
+
+	filters, ok := h.bindListFilters(c)
+	if !ok {
+		return
+	}
+
+	if format == "csv" {
+		h.exportReportsCSV(c, filters)
+		return
+	}
+
+	stream := newJSONDocumentStream(c.Writer, "application/geo+json", `{"type":"FeatureCollection","features":[`, "]}")
+	err := h.reportService.StreamReports(c.Request.Context(), filters, func(road *entities.DamagedRoad) error {
+		return stream.Write(dto.ToGeoJSONFeature(road))
+	})
+	if err != nil {
+		if !stream.Started() {
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to export reports",
+			})
+		}
+		// Once streaming has begun the document is left unterminated so clients detect truncation
+		return
+	}
+
+	_ = stream.Close()
+}
+
+// exportReportsCSV streams the reports matching filters as a CSV download, one row per report
+func (h *ReportHandler) exportReportsCSV(c *gin.Context, filters *entities.DamagedRoadFilters) {
+	stream := newCSVStream(c.Writer, "damaged-roads.csv", dto.ReportCSVHeader)
+	err := h.reportService.StreamReports(c.Request.Context(), filters, func(road *entities.DamagedRoad) error {
+		return stream.Write(dto.ToReportCSVRecord(road))
+	})
+	if err != nil {
+		if !stream.Started() {
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to export reports",
+			})
+			return
+		}
+		// A CSV cut short still parses as a complete file, so drop the connection instead of
+		// ending the response cleanly; the client then sees the download fail
+		logger.ErrorContext(c.Request.Context(), "Report CSV export failed mid-stream", map[string]interface{}{
+			"error": err.Error(),
+		})
+		panic(http.ErrAbortHandler)
+	}
+
+	_ = stream.Close()
+}
+
+// UpdateReportStatus godoc
+// @Summary Update report status
+// @Description Update the status of a damaged road report. Only verificators and admins may change a status; other users get 403.
+// @Description Proof-of-repair photos may be attached in resolution_photo_urls when resolving, and are required when the server enforces it.
+// @Description A reason is recorded in the status history and is required for the statuses the server is configured to enforce it for.
+// @Tags Damaged Roads
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID" format(uuid)
+// @Param request body dto.UpdateStatusRequest true "Update status request"
+// @Success 200 {object} dto.DamagedRoadResponse "Status updated successfully"
+// @Failure 400 {object} dto.ErrorResponse "Invalid status transition or missing reason"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden"
+// @Failure 404 {object} dto.ErrorResponse "Report not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /damaged-roads/{id}/status [patch]
+func (h *ReportHandler) UpdateReportStatus(c *gin.Context) {
+	// Get user ID from context
+	requesterID, ok := requesterIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	// Parse report ID
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid report ID format",
+		})
+		return
+	}
+
+	// Bind and validate request
+	var req dto.UpdateStatusRequest
+	if !middleware.BindAndValidate(c, &req) {
+		return
+	}
+
+	// Validate status
+	newStatus := entities.Status(req.Status)
+	if !newStatus.IsValid() {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_status",
+			Message: "Invalid status value",
+		})
+		return
+	}
+
+	// Update status
+	road, err := h.reportService.UpdateReportStatus(c.Request.Context(), id, newStatus, req.Reason, req.ResolutionPhotoURLs, requesterID)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrReportNotFound) {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{
+				Error:   "not_found",
+				Message: "Report not found",
+			})
+			return
+		}
+
+		if errors.Is(err, domainerrors.ErrUnauthorizedAccess) {
+			c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "Only verificators and admins can change a report's status",
+			})
+			return
+		}
+
+		if errors.Is(err, domainerrors.ErrResolutionPhotosRequired) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "resolution_photos_required",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		if errors.Is(err, domainerrors.ErrStatusReasonRequired) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "reason_required",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		if errors.Is(err, domainerrors.ErrInvalidPhotoURLs) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid_photo_urls",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		var validationErr *domainerrors.ValidationError
+		if errors.As(err, &validationErr) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid_transition",
+				Message: validationErr.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
 			Message: "Failed to update status",
 		})
 		return
@@ -329,3 +1052,277 @@ func (h *ReportHandler) UpdateReportStatus(c *gin.Context) {
 	response := dto.FromDamagedRoad(road)
 	c.JSON(http.StatusOK, response)
 }
+
+// DeleteReport godoc
+// @Summary Delete a damaged road report
+// @Description Delete a damaged road report. Only the report's author may delete it. The report is soft-deleted: it disappears from every listing but is kept for auditing.
+// @Tags Damaged Roads
+// @Security BearerAuth
+// @Param id path string true "Report ID" format(uuid)
+// @Success 204 "Report deleted"
+// @Failure 400 {object} dto.ErrorResponse "Invalid report ID"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden"
+// @Failure 404 {object} dto.ErrorResponse "Report not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /damaged-roads/{id} [delete]
+func (h *ReportHandler) DeleteReport(c *gin.Context) {
+	// Get user ID from context
+	requesterID, ok := requesterIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	// Parse report ID
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid report ID format",
+		})
+		return
+	}
+
+	if err := h.reportService.DeleteReport(c.Request.Context(), id, requesterID); err != nil {
+		if errors.Is(err, domainerrors.ErrReportNotFound) {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{
+				Error:   "not_found",
+				Message: "Report not found",
+			})
+			return
+		}
+
+		if errors.Is(err, domainerrors.ErrUnauthorizedAccess) {
+			c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "You are not allowed to delete this report",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to delete report",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// requesterIDFromContext reads the authenticated user's ID set by the auth middleware.
+// The middleware stores it as a string, but a uuid.UUID is accepted as well.
+// On failure an error response has already been written.
+func requesterIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User authentication required",
+		})
+		return uuid.Nil, false
+	}
+
+	switch v := userID.(type) {
+	case uuid.UUID:
+		return v, true
+	case string:
+		id, err := uuid.Parse(v)
+		if err == nil {
+			return id, true
+		}
+	}
+
+	c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		Error:   "internal_error",
+		Message: "Invalid user ID format",
+	})
+	return uuid.Nil, false
+}
+
+// UpdateReportPath godoc
+// @Summary Correct the path of a damaged road report
+// @Description Replace only the path of a report, leaving title, description and photos untouched.
+// @Description Only the author may edit, and only while the report is still submitted and within the edit window after creation. The path is validated as on create.
+// @Tags Damaged Roads
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID" format(uuid)
+// @Param request body dto.UpdateDamagedRoadPathRequest true "Update path request"
+// @Success 200 {object} dto.DamagedRoadResponse "Path updated successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - validation errors"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden"
+// @Failure 404 {object} dto.ErrorResponse "Report not found"
+// @Failure 409 {object} dto.ErrorResponse "Report is no longer editable or its edit window has passed"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /damaged-roads/{id}/path [patch]
+func (h *ReportHandler) UpdateReportPath(c *gin.Context) {
+	// Get user ID from context
+	requesterID, ok := requesterIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	// Parse report ID
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid report ID format",
+		})
+		return
+	}
+
+	// Bind and validate request
+	var req dto.UpdateDamagedRoadPathRequest
+	if !middleware.BindAndValidate(c, &req) {
+		return
+	}
+
+	road, err := h.reportService.UpdateReportPath(c.Request.Context(), id, requesterID, req.ToEntity())
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrReportNotFound) {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{
+				Error:   "not_found",
+				Message: "Report not found",
+			})
+			return
+		}
+
+		if errors.Is(err, domainerrors.ErrUnauthorizedAccess) {
+			c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "You are not allowed to edit this report",
+			})
+			return
+		}
+
+		if errors.Is(err, domainerrors.ErrReportNotEditable) {
+			c.JSON(http.StatusConflict, dto.ErrorResponse{
+				Error:   "report_not_editable",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		if errors.Is(err, domainerrors.ErrEditWindowExpired) {
+			c.JSON(http.StatusConflict, dto.ErrorResponse{
+				Error:   "edit_window_expired",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		if h.respondContentError(c, err) {
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to update report path",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDamagedRoad(road))
+}
+
+// UpdateReport godoc
+// @Summary Edit a damaged road report
+// @Description Replace the title, description, photos and path of a report. Only the author may edit,
+// @Description and only while the report is still submitted and within the edit window after creation. Photos and path are validated as on create.
+// @Tags Damaged Roads
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID" format(uuid)
+// @Param request body dto.UpdateDamagedRoadRequest true "Update damaged road request"
+// @Success 200 {object} dto.DamagedRoadResponse "Report updated successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - validation errors"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden"
+// @Failure 404 {object} dto.ErrorResponse "Report not found"
+// @Failure 409 {object} dto.ErrorResponse "Report is no longer editable or its edit window has passed"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /damaged-roads/{id} [put]
+func (h *ReportHandler) UpdateReport(c *gin.Context) {
+	// Get user ID from context
+	requesterID, ok := requesterIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	// Parse report ID
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid report ID format",
+		})
+		return
+	}
+
+	// Bind and validate request
+	var req dto.UpdateDamagedRoadRequest
+	if !middleware.BindAndValidate(c, &req) {
+		return
+	}
+
+	title, points, description, err := req.ToEntity()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	road, err := h.reportService.UpdateReport(c.Request.Context(), id, requesterID, title, description, req.PhotoURLs, points)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrReportNotFound) {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{
+				Error:   "not_found",
+				Message: "Report not found",
+			})
+			return
+		}
+
+		if errors.Is(err, domainerrors.ErrUnauthorizedAccess) {
+			c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "You are not allowed to edit this report",
+			})
+			return
+		}
+
+		if errors.Is(err, domainerrors.ErrReportNotEditable) {
+			c.JSON(http.StatusConflict, dto.ErrorResponse{
+				Error:   "report_not_editable",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		if errors.Is(err, domainerrors.ErrEditWindowExpired) {
+			c.JSON(http.StatusConflict, dto.ErrorResponse{
+				Error:   "edit_window_expired",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		if h.respondContentError(c, err) {
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to update report",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDamagedRoad(road))
+}
@@ -0,0 +1,613 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/middleware"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	domainerrors "github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+)
+
+// stubReportService streams the given reports and then fails with streamErr. Status updates
+// are counted and answered with statusErr
+type stubReportService struct {
+	usecases.ReportService
+	reports       []*entities.DamagedRoad
+	streamErr     error
+	statusErr     error
+	statusUpdates int
+	counts        int
+}
+
+func (s *stubReportService) UpdateReportStatus(ctx context.Context, id uuid.UUID, newStatus entities.Status, reason string, resolutionPhotoURLs []string, requesterID uuid.UUID) (*entities.DamagedRoad, error) {
+	s.statusUpdates++
+	if s.statusErr != nil {
+		return nil, s.statusErr
+	}
+	return &entities.DamagedRoad{ID: id, AuthorID: uuid.New(), Status: newStatus}, nil
+}
+
+// stubUserService serves a single user for role lookups
+type stubUserService struct {
+	usecases.UserService
+	user *entities.User
+}
+
+func (s *stubUserService) GetUserByID(ctx context.Context, userID string) (*entities.User, error) {
+	return s.user, nil
+}
+
+func (s *stubReportService) GetReport(ctx context.Context, id uuid.UUID, srid int) (*entities.DamagedRoad, error) {
+	for _, road := range s.reports {
+		if road.ID == id {
+			return road, nil
+		}
+	}
+	return nil, domainerrors.ErrReportNotFound
+}
+
+func (s *stubReportService) ListReportsByAuthor(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]*entities.DamagedRoad, int, error) {
+	var own []*entities.DamagedRoad
+	for _, road := range s.reports {
+		if road.AuthorID == authorID {
+			own = append(own, road)
+		}
+	}
+	total := len(own)
+	own = own[min(offset, total):min(offset+limit, total)]
+	return own, total, nil
+}
+
+func (s *stubReportService) ListReports(ctx context.Context, filters *entities.DamagedRoadFilters) ([]*entities.DamagedRoad, int, error) {
+	return s.reports[:min(filters.Limit, len(s.reports))], len(s.reports), nil
+}
+
+// CountReports counts the reports that are not deleted
+func (s *stubReportService) CountReports(ctx context.Context) (int, error) {
+	s.counts++
+	count := 0
+	for _, road := range s.reports {
+		if road.DeletedAt == nil {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *stubReportService) StreamReports(ctx context.Context, filters *entities.DamagedRoadFilters, fn func(*entities.DamagedRoad) error) error {
+	for _, road := range s.reports {
+		if err := fn(road); err != nil {
+			return err
+		}
+	}
+	return s.streamErr
+}
+
+// runCSVExport runs exportReportsCSV against service and returns the recorder
+func runCSVExport(service usecases.ReportService) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodGet, "/damaged-roads/export?format=csv", nil)
+
+	NewReportHandler(service, ReportHandlerConfig{}).exportReportsCSV(c, &entities.DamagedRoadFilters{})
+	return recorder
+}
+
+func TestExportReportsCSVFailsBeforeStreaming(t *testing.T) {
+	recorder := runCSVExport(&stubReportService{streamErr: stderrors.New("database unavailable")})
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500 when nothing was streamed yet", recorder.Code)
+	}
+}
+
+func TestExportReportsCSVAbortsMidStream(t *testing.T) {
+	service := &stubReportService{
+		reports:   []*entities.DamagedRoad{{ID: uuid.New(), Title: "Jalan rusak"}},
+		streamErr: stderrors.New("connection lost"),
+	}
+
+	defer func() {
+		if err := recover(); err != http.ErrAbortHandler {
+			t.Errorf("recovered %v, want http.ErrAbortHandler so the connection is dropped", err)
+		}
+	}()
+	runCSVExport(service)
+	t.Error("export returned normally after a mid-stream failure, want the connection aborted")
+}
+
+// patchStatus sends a status update as a user with the given role through the route's middleware
+func patchStatus(service *stubReportService, role string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	user := &entities.User{ID: uuid.New(), Role: role}
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("userID", user.ID.String())
+		c.Next()
+	})
+	router.PATCH("/damaged-roads/:id/status",
+		middleware.RequireRole(&stubUserService{user: user}, entities.RoleVerificator, entities.RoleAdmin),
+		NewReportHandler(service, ReportHandlerConfig{}).UpdateReportStatus)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPatch, "/damaged-roads/"+uuid.NewString()+"/status", strings.NewReader(`{"status":"under_verification"}`))
+	request.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestUpdateReportStatusForbiddenForCitizens(t *testing.T) {
+	service := &stubReportService{}
+	if recorder := patchStatus(service, entities.RoleUser); recorder.Code != http.StatusForbidden {
+		t.Errorf("citizen status = %d, want 403", recorder.Code)
+	}
+	if service.statusUpdates != 0 {
+		t.Errorf("citizen request reached the service %d times, want 0", service.statusUpdates)
+	}
+
+	for _, role := range []string{entities.RoleVerificator, entities.RoleAdmin} {
+		if recorder := patchStatus(&stubReportService{}, role); recorder.Code != http.StatusOK {
+			t.Errorf("%s status = %d, want 200", role, recorder.Code)
+		}
+	}
+}
+
+func TestUpdateReportStatusMapsServiceRefusalToForbidden(t *testing.T) {
+	// The role may change between the route check and the service's own check
+	service := &stubReportService{statusErr: domainerrors.ErrUnauthorizedAccess}
+	if recorder := patchStatus(service, entities.RoleVerificator); recorder.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want 403 when the service refuses the requester", recorder.Code)
+	}
+}
+
+// bindFilters runs bindListFilters on a list request with the given query string, as a user
+// with the given role. Filters are nil when the request was rejected
+func bindFilters(query, role string) (*entities.DamagedRoadFilters, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodGet, "/damaged-roads?"+query, nil)
+	if role != "" {
+		c.Set("userRole", role)
+	}
+
+	filters, ok := NewReportHandler(&stubReportService{}, ReportHandlerConfig{}).bindListFilters(c)
+	if !ok {
+		return nil, recorder
+	}
+	return filters, recorder
+}
+
+func TestBindListFiltersCreatedRange(t *testing.T) {
+	filters, _ := bindFilters("created_from=2025-10-13T00:00:00Z&created_to=2025-10-20T00:00:00%2B07:00", "")
+	if filters == nil {
+		t.Fatal("bindListFilters() rejected a valid range")
+	}
+	if want := time.Date(2025, 10, 13, 0, 0, 0, 0, time.UTC); filters.CreatedFrom == nil || !filters.CreatedFrom.Equal(want) {
+		t.Errorf("CreatedFrom = %v, want %s", filters.CreatedFrom, want)
+	}
+	if want := time.Date(2025, 10, 19, 17, 0, 0, 0, time.UTC); filters.CreatedTo == nil || !filters.CreatedTo.Equal(want) {
+		t.Errorf("CreatedTo = %v, want %s", filters.CreatedTo, want)
+	}
+
+	for _, query := range []string{
+		"created_from=2025-10-13",
+		"created_to=yesterday",
+		"created_from=2025-10-20T00:00:00Z&created_to=2025-10-13T00:00:00Z",
+	} {
+		if filters, recorder := bindFilters(query, ""); filters != nil || recorder.Code != http.StatusBadRequest {
+			t.Errorf("bindListFilters(%s) status = %d, want 400", query, recorder.Code)
+		}
+	}
+}
+
+func TestBindListFiltersSort(t *testing.T) {
+	filters, _ := bindFilters("", "")
+	if filters.Sort != entities.DefaultReportSort {
+		t.Errorf("default sort = %+v, want created_at desc", filters.Sort)
+	}
+
+	filters, _ = bindFilters("sort=title:asc", "")
+	if filters == nil || filters.Sort != (entities.ReportSort{Field: entities.ReportSortTitle}) {
+		t.Errorf("sort=title:asc gave %+v, want title ascending", filters)
+	}
+
+	for _, query := range []string{"sort=author_id", "sort=created_at:up"} {
+		if filters, recorder := bindFilters(query, ""); filters != nil || recorder.Code != http.StatusBadRequest {
+			t.Errorf("bindListFilters(%s) status = %d, want 400", query, recorder.Code)
+		}
+	}
+}
+
+func TestBindListFiltersSearchQuery(t *testing.T) {
+	filters, _ := bindFilters("q=+SDN+01+", "")
+	if filters == nil || filters.Query == nil || *filters.Query != "SDN 01" {
+		t.Errorf("q filter = %v, want the trimmed term", filters)
+	}
+
+	filters, _ = bindFilters("q=+++", "")
+	if filters == nil || filters.Query != nil {
+		t.Error("a blank q should not filter")
+	}
+
+	if filters, recorder := bindFilters("q="+strings.Repeat("a", entities.MaxSearchQueryLength+1), ""); filters != nil || recorder.Code != http.StatusBadRequest {
+		t.Errorf("overlong q status = %d, want 400", recorder.Code)
+	}
+}
+
+// getReport runs GetReport for id as a user with the given role and decodes the response
+func getReport(t *testing.T, service *stubReportService, id uuid.UUID, role string) dto.DamagedRoadResponse {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/damaged-roads/:id", func(c *gin.Context) {
+		c.Set("userRole", role)
+		NewReportHandler(service, ReportHandlerConfig{}).GetReport(c)
+	})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/damaged-roads/"+id.String(), nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("GetReport status = %d, want 200", recorder.Code)
+	}
+	var response dto.DamagedRoadResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response error = %v", err)
+	}
+	return response
+}
+
+func TestGetReportShowsAuthorEmailOnlyToAdmins(t *testing.T) {
+	report := &entities.DamagedRoad{
+		ID:     uuid.New(),
+		Title:  "Jalan berlubang",
+		Author: &entities.ReportAuthor{Name: "Budi Santoso", Role: entities.RoleUser, Email: "budi@example.com"},
+	}
+	service := &stubReportService{reports: []*entities.DamagedRoad{report}}
+
+	for _, role := range []string{entities.RoleUser, entities.RoleVerificator} {
+		response := getReport(t, service, report.ID, role)
+		if response.Author == nil || response.Author.Name != "Budi Santoso" {
+			t.Errorf("%s sees author %+v, want the name", role, response.Author)
+		} else if response.Author.Email != nil {
+			t.Errorf("%s sees the author's email", role)
+		}
+	}
+
+	response := getReport(t, service, report.ID, entities.RoleAdmin)
+	if response.Author == nil || response.Author.Email == nil || *response.Author.Email != "budi@example.com" {
+		t.Errorf("admin sees author %+v, want the email included", response.Author)
+	}
+}
+
+func TestGetReportWithoutAuthorAccount(t *testing.T) {
+	report := &entities.DamagedRoad{ID: uuid.New(), Title: "Jalan berlubang"}
+	service := &stubReportService{reports: []*entities.DamagedRoad{report}}
+
+	if response := getReport(t, service, report.ID, entities.RoleAdmin); response.Author != nil {
+		t.Errorf("author = %+v, want null for a deleted account", response.Author)
+	}
+}
+
+func TestExportReportsGeoJSON(t *testing.T) {
+	path, err := entities.NewGeometry([][]float64{{112.7521, -7.2575}, {112.7530, -7.2580}})
+	if err != nil {
+		t.Fatalf("NewGeometry() error = %v", err)
+	}
+	reports := []*entities.DamagedRoad{
+		{ID: uuid.New(), Title: "Jalan berlubang", Status: entities.StatusSubmitted, SubDistrictCode: "35.78.01.1001", Path: *path},
+		{ID: uuid.New(), Title: "Aspal retak", Status: entities.StatusVerified, SubDistrictCode: "35.78.02.1002", Path: *path},
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/damaged-roads/export", NewReportHandler(&stubReportService{reports: reports}, ReportHandlerConfig{}).ExportReports)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/damaged-roads/export", nil))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", recorder.Code)
+	}
+	if contentType := recorder.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "application/geo+json") {
+		t.Errorf("Content-Type = %q, want application/geo+json", contentType)
+	}
+
+	var collection struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Type     string `json:"type"`
+			Geometry struct {
+				Type        string      `json:"type"`
+				Coordinates [][]float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties dto.GeoJSONFeatureProperties `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &collection); err != nil {
+		t.Fatalf("export is not valid JSON: %v\n%s", err, recorder.Body.String())
+	}
+	if collection.Type != "FeatureCollection" || len(collection.Features) != len(reports) {
+		t.Fatalf("got %s with %d features, want a FeatureCollection of %d", collection.Type, len(collection.Features), len(reports))
+	}
+	for i, feature := range collection.Features {
+		want := dto.GeoJSONFeatureProperties{
+			ID:              reports[i].ID.String(),
+			Title:           reports[i].Title.String(),
+			Status:          reports[i].Status.String(),
+			SubDistrictCode: reports[i].SubDistrictCode.String(),
+		}
+		if feature.Type != "Feature" || feature.Properties != want {
+			t.Errorf("feature %d = %s %+v, want Feature %+v", i, feature.Type, feature.Properties, want)
+		}
+		if feature.Geometry.Type != "LineString" || len(feature.Geometry.Coordinates) != 2 || feature.Geometry.Coordinates[0][0] != 112.7521 {
+			t.Errorf("feature %d geometry = %+v, want the report path", i, feature.Geometry)
+		}
+	}
+}
+
+func TestExportReportsEmptyGeoJSON(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/damaged-roads/export", NewReportHandler(&stubReportService{}, ReportHandlerConfig{}).ExportReports)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/damaged-roads/export?format=geojson", nil))
+
+	if recorder.Code != http.StatusOK || recorder.Body.String() != `{"type":"FeatureCollection","features":[]}` {
+		t.Errorf("empty export = %d %s, want an empty FeatureCollection", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/damaged-roads/export?format=kml", nil))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("format=kml status = %d, want 400", recorder.Code)
+	}
+}
+
+func TestBindListFiltersCategory(t *testing.T) {
+	filters, _ := bindFilters("category_id=pothole", "")
+	if filters == nil || filters.CategoryID == nil || *filters.CategoryID != "pothole" {
+		t.Errorf("category_id filter = %v, want pothole", filters)
+	}
+
+	filters, _ = bindFilters("", "")
+	if filters == nil || filters.CategoryID != nil {
+		t.Error("no category_id should not filter")
+	}
+}
+
+func TestBindListFiltersSLABreached(t *testing.T) {
+	tests := []struct {
+		query    string
+		filtered bool
+		want     bool
+	}{
+		{query: "sla_breached=true", filtered: true, want: true},
+		{query: "sla_breached=0", filtered: true, want: false},
+		{query: ""},
+	}
+	for _, tt := range tests {
+		filters, recorder := bindFilters(tt.query, "")
+		if filters == nil {
+			t.Errorf("%q status = %d, want accepted", tt.query, recorder.Code)
+			continue
+		}
+		if (filters.SLABreached != nil) != tt.filtered || (tt.filtered && *filters.SLABreached != tt.want) {
+			t.Errorf("%q did not filter on sla_breached=%v", tt.query, tt.want)
+		}
+	}
+
+	for _, query := range []string{"sla_breached=yes", "sla_breached=ture"} {
+		if filters, recorder := bindFilters(query, ""); filters != nil || recorder.Code != http.StatusBadRequest {
+			t.Errorf("%q status = %d, want 400 rather than listing unfiltered", query, recorder.Code)
+		}
+	}
+}
+
+func TestBindSRID(t *testing.T) {
+	tests := []struct {
+		query    string
+		config   []int
+		wantSRID int
+		wantCode int
+	}{
+		{query: "", wantSRID: entities.SRIDWGS84},
+		{query: "srid=4326", wantSRID: entities.SRIDWGS84},
+		{query: "srid=3857", config: []int{3857}, wantSRID: 3857},
+		{query: "srid=3857", wantCode: http.StatusBadRequest},
+		{query: "srid=32749", config: []int{3857}, wantCode: http.StatusBadRequest},
+		{query: "srid=mercator", config: []int{3857}, wantCode: http.StatusBadRequest},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, tt := range tests {
+		recorder := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(recorder)
+		c.Request = httptest.NewRequest(http.MethodGet, "/damaged-roads?"+tt.query, nil)
+
+		srid, ok := NewReportHandler(&stubReportService{}, ReportHandlerConfig{OutputSRIDs: tt.config}).bindSRID(c)
+		if tt.wantCode != 0 {
+			if ok || recorder.Code != tt.wantCode {
+				t.Errorf("bindSRID(%q) with allowlist %v = %d ok %v, want status %d", tt.query, tt.config, srid, ok, tt.wantCode)
+			}
+			continue
+		}
+		if !ok || srid != tt.wantSRID {
+			t.Errorf("bindSRID(%q) with allowlist %v = %d ok %v, want %d", tt.query, tt.config, srid, ok, tt.wantSRID)
+		}
+	}
+}
+
+func TestListMyReportsPaginatesOwnReports(t *testing.T) {
+	userID, otherID := uuid.New(), uuid.New()
+	var reports []*entities.DamagedRoad
+	for i := 0; i < 5; i++ {
+		reports = append(reports, &entities.DamagedRoad{ID: uuid.New(), AuthorID: userID, Title: "Jalan berlubang"})
+	}
+	reports = append(reports, &entities.DamagedRoad{ID: uuid.New(), AuthorID: otherID, Title: "Bukan milik saya"})
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/me/damaged-roads", func(c *gin.Context) {
+		c.Set("userID", userID.String())
+		c.Next()
+	}, NewReportHandler(&stubReportService{reports: reports}, ReportHandlerConfig{}).ListMyReports)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/me/damaged-roads?page=2&limit=2", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", recorder.Code)
+	}
+
+	var response dto.DamagedRoadListResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response error = %v", err)
+	}
+	if want := (dto.PaginationMeta{Total: 5, Limit: 2, Offset: 2, Page: 2}); response.Pagination != want {
+		t.Errorf("pagination = %+v, want %+v", response.Pagination, want)
+	}
+	if len(response.Data) != 2 || response.Data[0].ID != reports[2].ID.String() || response.Data[1].ID != reports[3].ID.String() {
+		t.Errorf("page 2 holds %d reports, want the user's third and fourth", len(response.Data))
+	}
+}
+
+func TestListMyReportsRequiresUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/me/damaged-roads", NewReportHandler(&stubReportService{}, ReportHandlerConfig{}).ListMyReports)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/me/damaged-roads", nil))
+	if recorder.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want 401 without an authenticated user", recorder.Code)
+	}
+}
+
+// listReports runs ListReports with the given query string and returns the recorder
+func listReports(service *stubReportService, query string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/damaged-roads", NewReportHandler(service, ReportHandlerConfig{}).ListReports)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/damaged-roads?"+query, nil))
+	return recorder
+}
+
+func TestListReportsCursorPagination(t *testing.T) {
+	createdAt := time.Date(2025, 10, 20, 10, 0, 0, 0, time.UTC)
+	service := &stubReportService{}
+	for i := 0; i < 3; i++ {
+		service.reports = append(service.reports, &entities.DamagedRoad{ID: uuid.New(), CreatedAt: createdAt.Add(-time.Duration(i) * time.Minute)})
+	}
+
+	recorder := listReports(service, "limit=2")
+	var response dto.DamagedRoadListResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response error = %v", err)
+	}
+	if want := dto.EncodeReportCursor(service.reports[1]); response.Pagination.NextCursor != want {
+		t.Fatalf("next_cursor = %q, want one pointing past the last report of the page", response.Pagination.NextCursor)
+	}
+
+	recorder = listReports(service, "limit=2&page=5&cursor="+response.Pagination.NextCursor)
+	response = dto.DamagedRoadListResponse{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response error = %v", err)
+	}
+	if recorder.Code != http.StatusOK || response.Pagination.Page != 0 || response.Pagination.Offset != 0 {
+		t.Errorf("cursor page = %d %+v, want 200 with page and offset ignored", recorder.Code, response.Pagination)
+	}
+
+	for _, query := range []string{"cursor=garbage", "sort=title:asc&cursor=" + dto.EncodeReportCursor(service.reports[0])} {
+		if recorder := listReports(service, query); recorder.Code != http.StatusBadRequest {
+			t.Errorf("ListReports(%s) status = %d, want 400", query, recorder.Code)
+		}
+	}
+}
+
+func TestBindListFiltersIncludeDeletedOnlyForAdmins(t *testing.T) {
+	for _, role := range []string{entities.RoleUser, entities.RoleVerificator} {
+		if filters, recorder := bindFilters("include_deleted=true", role); filters != nil || recorder.Code != http.StatusForbidden {
+			t.Errorf("include_deleted as %s status = %d, want 403", role, recorder.Code)
+		}
+	}
+
+	filters, _ := bindFilters("include_deleted=true", entities.RoleAdmin)
+	if filters == nil || !filters.IncludeDeleted {
+		t.Error("include_deleted as admin was not applied")
+	}
+
+	filters, _ = bindFilters("include_deleted=false", entities.RoleUser)
+	if filters == nil || filters.IncludeDeleted {
+		t.Error("include_deleted=false should not need a role or include deleted reports")
+	}
+}
+
+func TestGetReportDistanceToCentroidOmittedWhenUnknown(t *testing.T) {
+	distance := 110.3
+	known := &entities.DamagedRoad{ID: uuid.New(), DistanceToCentroidMeters: &distance}
+	unknown := &entities.DamagedRoad{ID: uuid.New()}
+	service := &stubReportService{reports: []*entities.DamagedRoad{known, unknown}}
+
+	if response := getReport(t, service, known.ID, entities.RoleUser); response.DistanceToCentroidMeters == nil || *response.DistanceToCentroidMeters != distance {
+		t.Errorf("distance_to_centroid_meters = %v, want %v", response.DistanceToCentroidMeters, distance)
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/damaged-roads/:id", NewReportHandler(service, ReportHandlerConfig{}).GetReport)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/damaged-roads/"+unknown.ID.String(), nil))
+	if strings.Contains(recorder.Body.String(), "distance_to_centroid_meters") {
+		t.Errorf("response %s includes distance_to_centroid_meters without centroid data", recorder.Body.String())
+	}
+}
+
+func TestListReportsUnfilteredTotalAgreesWithCount(t *testing.T) {
+	deletedAt := time.Now()
+	service := &stubReportService{reports: []*entities.DamagedRoad{
+		{ID: uuid.New()}, {ID: uuid.New()}, {ID: uuid.New(), DeletedAt: &deletedAt},
+	}}
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/damaged-roads", func(c *gin.Context) {
+		c.Set("userRole", entities.RoleAdmin)
+	}, NewReportHandler(service, ReportHandlerConfig{}).ListReports)
+
+	tests := []struct {
+		query      string
+		wantTotal  int
+		wantCounts int
+	}{
+		// The stub lists every report, as the repository does when deleted ones are included
+		{"include_unfiltered_total=true&include_deleted=true", 2, 1},
+		{"include_unfiltered_total=true&status=submitted", 2, 1},
+		{"include_unfiltered_total=true", 3, 0},
+	}
+	for _, tt := range tests {
+		service.counts = 0
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/damaged-roads?"+tt.query, nil))
+
+		var response dto.DamagedRoadListResponse
+		if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+			t.Fatalf("%s: decode response error = %v", tt.query, err)
+		}
+		if response.Pagination.UnfilteredTotal == nil {
+			t.Fatalf("%s: unfiltered_total missing", tt.query)
+		}
+		if got := *response.Pagination.UnfilteredTotal; got != tt.wantTotal || service.counts != tt.wantCounts {
+			t.Errorf("%s: unfiltered_total = %d after %d counts, want %d after %d", tt.query, got, service.counts, tt.wantTotal, tt.wantCounts)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/middleware"
+	domainerrors "github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+)
+
+// ReportNoteHandler handles HTTP requests for verifier notes on damaged road reports
+type ReportNoteHandler struct {
+	noteService usecases.ReportNoteService
+}
+
+// NewReportNoteHandler creates a new report note handler
+func NewReportNoteHandler(noteService usecases.ReportNoteService) *ReportNoteHandler {
+	return &ReportNoteHandler{
+		noteService: noteService,
+	}
+}
+
+// AddNote godoc
+// @Summary Add a note to a report
+// @Description Verificators and admins can leave working notes on a report. Notes are internal (hidden from the public and the reporter) unless internal is set to false
+// @Tags Damaged Roads
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID" format(uuid)
+// @Param request body dto.CreateReportNoteRequest true "Note"
+// @Success 201 {object} dto.ReportNoteResponse "Note added"
+// @Failure 400 {object} dto.ErrorResponse "Invalid request"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 403 {object} dto.ErrorResponse "Not a verificator or admin"
+// @Failure 404 {object} dto.ErrorResponse "Report not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /damaged-roads/{id}/notes [post]
+func (h *ReportNoteHandler) AddNote(c *gin.Context) {
+	authorID, ok := requesterIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	reportID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid report ID format",
+		})
+		return
+	}
+
+	var req dto.CreateReportNoteRequest
+	if !middleware.BindAndValidate(c, &req) {
+		return
+	}
+
+	note, err := h.noteService.AddNote(c.Request.Context(), reportID, authorID, req.Body, req.IsInternal())
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.FromReportNote(note))
+}
+
+// ListNotes godoc
+// @Summary List the notes of a report
+// @Description Get the notes left on a report, oldest first. Internal notes are only included for verificators and admins
+// @Tags Damaged Roads
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID" format(uuid)
+// @Success 200 {object} dto.ReportNoteListResponse "Notes"
+// @Failure 400 {object} dto.ErrorResponse "Invalid report ID"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 404 {object} dto.ErrorResponse "Report not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /damaged-roads/{id}/notes [get]
+func (h *ReportNoteHandler) ListNotes(c *gin.Context) {
+	reportID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid report ID format",
+		})
+		return
+	}
+
+	// Role resolved by ResolveRole; an unknown role only sees public notes
+	notes, err := h.noteService.ListNotes(c.Request.Context(), reportID, c.GetString("userRole"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	responses := make([]dto.ReportNoteResponse, len(notes))
+	for i, note := range notes {
+		responses[i] = dto.FromReportNote(note)
+	}
+
+	c.JSON(http.StatusOK, dto.ReportNoteListResponse{Data: responses})
+}
+
+// respondError maps report note service errors to HTTP responses
+func (h *ReportNoteHandler) respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainerrors.ErrReportNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "not_found",
+			Message: "Report not found",
+		})
+	case errors.Is(err, domainerrors.ErrInvalidNoteBody):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to process report notes",
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+// Array members of the export document, in the order they are written
+const (
+	exportSectionReports = iota
+	exportSectionAuthEvents
+)
+
+// exportSections maps each section to its JSON member name
+var exportSections = []string{"reports", "auth_events"}
+
+// userExportStream writes a dto.UserExportResponse to the response section by section,
+// implementing usecases.UserDataSink. Like jsonArrayStream nothing is sent until the
+// profile arrives, so lookup failures can still be answered with a regular error.
+type userExportStream struct {
+	writer  gin.ResponseWriter
+	section int // index into exportSections of the open array, -1 before the first
+	count   int
+	started bool
+}
+
+// newUserExportStream creates an export stream writing to the given response writer
+func newUserExportStream(writer gin.ResponseWriter) *userExportStream {
+	return &userExportStream{writer: writer, section: -1}
+}
+
+// Started reports whether any bytes of the response have been sent
+func (s *userExportStream) Started() bool {
+	return s.started
+}
+
+// WriteProfile sends the headers, the export timestamp and the profile
+func (s *userExportStream) WriteProfile(user *entities.User) error {
+	profile, err := json.Marshal(dto.FromUser(user))
+	if err != nil {
+		return err
+	}
+	exportedAt, err := json.Marshal(dto.FormatTimestamp(time.Now().UTC()))
+	if err != nil {
+		return err
+	}
+
+	s.started = true
+	s.writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	s.writer.Header().Set("Content-Disposition", `attachment; filename="jalanrusak-export.json"`)
+	s.writer.WriteHeader(http.StatusOK)
+	if _, err := s.writer.WriteString(`{"exported_at":` + string(exportedAt) + `,"profile":`); err != nil {
+		return err
+	}
+	if _, err := s.writer.Write(profile); err != nil {
+		return err
+	}
+	s.writer.Flush()
+	return nil
+}
+
+// WriteReport appends a report to the reports array
+func (s *userExportStream) WriteReport(road *entities.DamagedRoad) error {
+	return s.writeItem(exportSectionReports, dto.FromDamagedRoad(road))
+}
+
+// WriteAuthEvent appends an event to the auth_events array
+func (s *userExportStream) WriteAuthEvent(event *entities.AuthEventLog) error {
+	return s.writeItem(exportSectionAuthEvents, dto.FromAuthEventLogExport(event))
+}
+
+// Close terminates the document, writing empty arrays for sections that had no items
+func (s *userExportStream) Close() error {
+	if err := s.enterSection(exportSectionAuthEvents); err != nil {
+		return err
+	}
+	if _, err := s.writer.WriteString("]}"); err != nil {
+		return err
+	}
+	s.writer.Flush()
+	return nil
+}
+
+// writeItem encodes a single element of the given array section
+func (s *userExportStream) writeItem(section int, item interface{}) error {
+	encoded, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	if err := s.enterSection(section); err != nil {
+		return err
+	}
+	if s.count > 0 {
+		if _, err := s.writer.WriteString(","); err != nil {
+			return err
+		}
+	}
+	if _, err := s.writer.Write(encoded); err != nil {
+		return err
+	}
+
+	s.count++
+	if s.count%streamFlushInterval == 0 {
+		s.writer.Flush()
+	}
+	return nil
+}
+
+// enterSection closes the current array and opens every section up to and including the
+// given one, so skipped sections still appear as empty arrays
+func (s *userExportStream) enterSection(section int) error {
+	for s.section < section {
+		prefix := ","
+		if s.section >= 0 {
+			prefix = "],"
+		}
+		s.section++
+		if _, err := s.writer.WriteString(prefix + `"` + exportSections[s.section] + `":[`); err != nil {
+			return err
+		}
+		s.count = 0
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/middleware"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+)
+
+// UserHandler handles HTTP requests scoped to the authenticated user
+type UserHandler struct {
+	userService        usecases.UserService
+	activityService    usecases.ActivityService
+	exportService      usecases.DataExportService
+	preferencesService usecases.UserPreferencesService
+}
+
+// NewUserHandler creates a new UserHandler
+func NewUserHandler(
+	userService usecases.UserService,
+	activityService usecases.ActivityService,
+	exportService usecases.DataExportService,
+	preferencesService usecases.UserPreferencesService,
+) *UserHandler {
+	return &UserHandler{
+		userService:        userService,
+		activityService:    activityService,
+		exportService:      exportService,
+		preferencesService: preferencesService,
+	}
+}
+
+// GetProfile godoc
+// @Summary Get my profile
+// @Description Get the authenticated user's profile. The role is always read from the database, so a role
+// @Description changed after login shows here immediately, while the role claim of already issued access tokens only changes on refresh
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.UserInfo "User profile"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 404 {object} dto.ErrorResponse "User not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /users/me [get]
+func (h *UserHandler) GetProfile(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User authentication required",
+		})
+		return
+	}
+
+	// Read the user, and with it the role, from the database rather than the token claims
+	user, err := h.userService.GetUserByID(c.Request.Context(), userID.(string))
+	if err != nil {
+		if err == errors.ErrUserNotFound {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{
+				Error:   "user_not_found",
+				Message: "User not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve user profile",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromUser(user))
+}
+
+// GetActivity godoc
+// @Summary Get my activity timeline
+// @Description Get the authenticated user's auth events and report submissions merged into a single timeline, newest first
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20) maximum(100)
+// @Success 200 {object} dto.ActivityListResponse "Activity timeline"
+// @Failure 400 {object} dto.ErrorResponse "Page ends beyond the first 1000 items"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /users/me/activity [get]
+func (h *UserHandler) GetActivity(c *gin.Context) {
+	uid, ok := requesterIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	page, limit := pageParams(c)
+	offset := (page - 1) * limit
+
+	// Get activity
+	items, hasMore, err := h.activityService.GetUserActivity(c.Request.Context(), uid, limit, offset)
+	if err == errors.ErrPageTooDeep {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "page_too_deep",
+			Message: fmt.Sprintf("Activity can only be paged through its newest %d items", usecases.MaxActivityItems),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve activity",
+		})
+		return
+	}
+
+	// Convert to DTOs
+	responses := make([]dto.ActivityItemResponse, len(items))
+	for i, item := range items {
+		responses[i] = dto.FromActivityItem(item)
+	}
+
+	c.JSON(http.StatusOK, dto.ActivityListResponse{
+		Data: responses,
+		Pagination: dto.ActivityPaginationMeta{
+			Limit:   limit,
+			Offset:  offset,
+			Page:    page,
+			HasMore: hasMore,
+		},
+	})
+}
+
+// ExportData godoc
+// @Summary Export my data
+// @Description Download a JSON bundle of the authenticated user's profile, reports and auth event history for data portability.
+// @Description The bundle is streamed; a truncated document (missing the closing brace) means the export failed midway.
+// @Description Limited to a few requests per hour per user.
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.UserExportResponse "User data export"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 404 {object} dto.ErrorResponse "User not found"
+// @Failure 429 {object} dto.ErrorResponse "Too many export requests"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /users/me/export [get]
+func (h *UserHandler) ExportData(c *gin.Context) {
+	uid, ok := requesterIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	stream := newUserExportStream(c.Writer)
+	if err := h.exportService.ExportUserData(c.Request.Context(), uid, stream); err != nil {
+		if stream.Started() {
+			// Once streaming has begun the document is left unterminated so clients detect truncation
+			return
+		}
+		if err == errors.ErrUserNotFound {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{
+				Error:   "user_not_found",
+				Message: "User not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to export user data",
+		})
+		return
+	}
+
+	_ = stream.Close()
+}
+
+// GetPreferences godoc
+// @Summary Get my notification preferences
+// @Description Get the authenticated user's notification preferences. Notifications are opt-out, so users who never changed them get the defaults
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.UserPreferencesResponse "Notification preferences"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /users/me/preferences [get]
+func (h *UserHandler) GetPreferences(c *gin.Context) {
+	userID, ok := requesterIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	preferences, err := h.preferencesService.GetPreferences(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve preferences",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromUserPreferences(preferences))
+}
+
+// UpdatePreferences godoc
+// @Summary Update my notification preferences
+// @Description Change the authenticated user's notification preferences. Omitted fields keep their current value.
+// @Description email_on_status_change controls the email sent when someone else changes the status of one of the user's reports
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.UpdateUserPreferencesRequest true "Preferences to change"
+// @Success 200 {object} dto.UserPreferencesResponse "Updated notification preferences"
+// @Failure 400 {object} dto.ErrorResponse "Invalid request"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /users/me/preferences [patch]
+func (h *UserHandler) UpdatePreferences(c *gin.Context) {
+	userID, ok := requesterIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateUserPreferencesRequest
+	if !middleware.BindAndValidate(c, &req) {
+		return
+	}
+
+	preferences, err := h.preferencesService.UpdatePreferences(c.Request.Context(), userID, req.ToEntity())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to update preferences",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromUserPreferences(preferences))
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+func TestGetProfileReturnsRoleFromDatabase(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	// The user was promoted after the access token, and so the role claim, was issued
+	user := &entities.User{ID: uuid.New(), Name: "Budi Santoso", Email: "budi@example.com", Role: entities.RoleVerificator}
+	router := gin.New()
+	router.GET("/users/me", func(c *gin.Context) {
+		c.Set("userID", user.ID.String())
+		c.Set("userRole", entities.RoleUser)
+		c.Next()
+	}, NewUserHandler(&stubUserService{user: user}, nil, nil, nil).GetProfile)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/users/me", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", recorder.Code)
+	}
+
+	var profile dto.UserInfo
+	if err := json.Unmarshal(recorder.Body.Bytes(), &profile); err != nil {
+		t.Fatalf("decode response error = %v", err)
+	}
+	if profile.Role != entities.RoleVerificator {
+		t.Errorf("role = %q, want %q from the database rather than the token", profile.Role, entities.RoleVerificator)
+	}
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -13,15 +14,25 @@ import (
 
 // ValidationHandler handles location validation endpoints
 type ValidationHandler struct {
-	geometryService usecases.GeometryService
-	photoValidator  external.PhotoValidator
+	geometryService      usecases.GeometryService
+	photoValidator       external.PhotoValidator
+	centroidRadiusMeters float64
 }
 
-// NewValidationHandler creates a new ValidationHandler
-func NewValidationHandler(geometryService usecases.GeometryService, photoValidator external.PhotoValidator) *ValidationHandler {
+// NewValidationHandler creates a new ValidationHandler. centroidRadiusMeters is the FR-006 radius
+// used by report creation; zero uses entities.DefaultCentroidRadiusMeters
+func NewValidationHandler(
+	geometryService usecases.GeometryService,
+	photoValidator external.PhotoValidator,
+	centroidRadiusMeters float64,
+) *ValidationHandler {
+	if centroidRadiusMeters <= 0 {
+		centroidRadiusMeters = entities.DefaultCentroidRadiusMeters
+	}
 	return &ValidationHandler{
-		geometryService: geometryService,
-		photoValidator:  photoValidator,
+		geometryService:      geometryService,
+		photoValidator:       photoValidator,
+		centroidRadiusMeters: centroidRadiusMeters,
 	}
 }
 
@@ -86,8 +97,16 @@ func (h *ValidationHandler) ValidateLocation(c *gin.Context) {
 	response.WithinBoundaries = true
 
 	// Get subdistrict centroid
-	centroid, err := h.geometryService.GetSubDistrictCentroid(subdistrictCode)
+	centroid, err := h.geometryService.GetSubDistrictCentroid(c.Request.Context(), subdistrictCode)
 	if err != nil {
+		// Boundary dataset not seeded yet: national bounds are the only check available
+		if !h.geometryService.IsBoundaryDataAvailable(c.Request.Context()) {
+			response.Degraded = true
+			response.Message = "Boundary dataset not yet available; only national boundaries were checked"
+			c.JSON(http.StatusOK, response)
+			return
+		}
+
 		response.Valid = false
 		response.Message = "Subdistrict code not found in boundary dataset"
 		response.SubDistrictExists = false
@@ -108,10 +127,10 @@ func (h *ValidationHandler) ValidateLocation(c *gin.Context) {
 	}
 	response.MinDistanceToCenter = minDistance
 
-	// Check if at least one coordinate is within 200 meters of centroid
-	if err := h.geometryService.ValidateCoordinatesNearCentroid(points, subdistrictCode, 200.0); err != nil {
+	// Check if at least one coordinate is within the configured radius of the centroid
+	if err := h.geometryService.ValidateCoordinatesNearCentroid(c.Request.Context(), points, subdistrictCode, h.centroidRadiusMeters); err != nil {
 		response.Valid = false
-		response.Message = "No coordinate within 200 meters of subdistrict centroid"
+		response.Message = fmt.Sprintf("No coordinate within %.0f meters of subdistrict centroid", h.centroidRadiusMeters)
 		response.NearCentroid = false
 		c.JSON(http.StatusOK, response)
 		return
@@ -121,6 +140,47 @@ func (h *ValidationHandler) ValidateLocation(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// SubDistrictsExist checks a batch of subdistrict codes in one request
+// @Summary Check which subdistrict codes exist
+// @Description Bulk existence check against the boundary dataset, e.g. to initialize a subdistrict dropdown. Codes with an invalid format are reported as missing
+// @Tags validation
+// @Accept json
+// @Produce json
+// @Param request body dto.SubDistrictsExistRequest true "Codes to check"
+// @Success 200 {object} dto.SubDistrictsExistResponse "Existence per code"
+// @Failure 400 {object} dto.ErrorResponse "Invalid request"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /subdistricts/exists [post]
+func (h *ValidationHandler) SubDistrictsExist(c *gin.Context) {
+	var req dto.SubDistrictsExistRequest
+	if !middleware.BindAndValidate(c, &req) {
+		return
+	}
+
+	results, err := h.geometryService.CheckSubDistrictsExist(c.Request.Context(), req.Codes)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to check subdistrict codes",
+		})
+		return
+	}
+
+	response := dto.SubDistrictsExistResponse{Results: results}
+
+	// Every code missing may just mean the boundary dataset has not been seeded yet
+	anyExists := false
+	for _, exists := range results {
+		anyExists = anyExists || exists
+	}
+	if !anyExists {
+		response.Degraded = !h.geometryService.IsBoundaryDataAvailable(c.Request.Context())
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 // ValidatePhotos validates photo URLs with SSRF protection
 // @Summary Validate photo URLs
 // @Description Pre-submission validation to check if photo URLs are accessible, have valid image content types, and pass SSRF protection checks
@@ -139,7 +199,7 @@ func (h *ValidationHandler) ValidatePhotos(c *gin.Context) {
 	}
 
 	// Validate photo URLs using PhotoValidator
-	validationResults := h.photoValidator.ValidateURLs(req.PhotoURLs)
+	validationResults := h.photoValidator.ValidateURLs(c.Request.Context(), req.PhotoURLs)
 
 	// Convert external.PhotoValidationResult to dto.PhotoValidationResult
 	dtoResults := make([]dto.PhotoValidationResult, len(validationResults))
@@ -151,6 +211,7 @@ func (h *ValidationHandler) ValidatePhotos(c *gin.Context) {
 			Error:       result.Error,
 			ContentType: result.ContentType,
 			SizeBytes:   result.SizeBytes,
+			Warning:     result.Warning,
 		}
 		if !result.Valid {
 			allValid = false
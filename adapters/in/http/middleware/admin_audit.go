@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+)
+
+// Context keys handlers use to describe the audited action
+const (
+	AuditActionKey = "auditAction"
+	AuditTargetKey = "auditTarget"
+)
+
+// SetAuditAction names the action and affected resource recorded for the current admin request.
+// Requests that never call it are recorded under their route, e.g. "POST /api/v1/admin/flags".
+func SetAuditAction(c *gin.Context, action, target string) {
+	c.Set(AuditActionKey, action)
+	c.Set(AuditTargetKey, target)
+}
+
+// AdminAuditMiddleware records every mutating request (anything but GET, HEAD and OPTIONS)
+// in the admin audit log once the handler has completed, whatever its outcome.
+// Must run after RequireRole so that only requests from admins are recorded.
+func AdminAuditMiddleware(auditService usecases.AdminAuditService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		c.Next()
+
+		adminID, err := uuid.Parse(c.GetString("userID"))
+		if err != nil {
+			return
+		}
+
+		action := c.GetString(AuditActionKey)
+		if action == "" {
+			action = c.Request.Method + " " + c.FullPath()
+		}
+
+		entry := entities.NewAdminAuditEntry(
+			adminID,
+			action,
+			c.GetString(AuditTargetKey),
+			c.Request.Method,
+			c.Request.URL.Path,
+			c.Writer.Status(),
+			c.ClientIP(),
+			c.Request.UserAgent(),
+		)
+
+		// Record even if the request deadline has passed; failures are logged by the service
+		_ = auditService.RecordAction(context.WithoutCancel(c.Request.Context()), entry)
+	}
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+// fakeAdminAuditService keeps recorded entries in memory
+type fakeAdminAuditService struct {
+	mu      sync.Mutex
+	entries []*entities.AdminAuditEntry
+}
+
+func (s *fakeAdminAuditService) RecordAction(ctx context.Context, entry *entities.AdminAuditEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+// newAuditedAdminRouter mounts two admin routes behind AdminAuditMiddleware, authenticated as adminID
+func newAuditedAdminRouter(adminID uuid.UUID, auditService *fakeAdminAuditService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	admin := router.Group("/api/v1/admin")
+	admin.Use(func(c *gin.Context) {
+		c.Set("userID", adminID.String())
+	}, AdminAuditMiddleware(auditService))
+	admin.GET("/flags", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	admin.POST("/maintenance/cleanup-tokens", func(c *gin.Context) {
+		SetAuditAction(c, entities.AdminActionCleanupTokens, "")
+		c.Status(http.StatusOK)
+	})
+	admin.PATCH("/users/:id/role", func(c *gin.Context) {
+		c.Status(http.StatusBadRequest)
+	})
+	return router
+}
+
+func TestAdminAuditMiddlewareRecordsMutations(t *testing.T) {
+	adminID := uuid.New()
+	auditService := &fakeAdminAuditService{}
+	router := newAuditedAdminRouter(adminID, auditService)
+
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/admin/maintenance/cleanup-tokens", nil)
+	request.Header.Set("User-Agent", "admin-console")
+	router.ServeHTTP(httptest.NewRecorder(), request)
+
+	if len(auditService.entries) != 1 {
+		t.Fatalf("recorded %d audit entries, want 1", len(auditService.entries))
+	}
+	entry := auditService.entries[0]
+	if entry.AdminID != adminID {
+		t.Errorf("AdminID = %s, want %s", entry.AdminID, adminID)
+	}
+	if entry.Action != entities.AdminActionCleanupTokens {
+		t.Errorf("Action = %q, want %q", entry.Action, entities.AdminActionCleanupTokens)
+	}
+	if entry.Method != http.MethodPost || entry.Path != "/api/v1/admin/maintenance/cleanup-tokens" {
+		t.Errorf("recorded %s %s, want the request's method and path", entry.Method, entry.Path)
+	}
+	if entry.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", entry.StatusCode, http.StatusOK)
+	}
+	if entry.UserAgent != "admin-console" {
+		t.Errorf("UserAgent = %q, want admin-console", entry.UserAgent)
+	}
+}
+
+func TestAdminAuditMiddlewareRecordsFailedMutationsUnderTheRoute(t *testing.T) {
+	auditService := &fakeAdminAuditService{}
+	router := newAuditedAdminRouter(uuid.New(), auditService)
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPatch, "/api/v1/admin/users/42/role", nil))
+
+	if len(auditService.entries) != 1 {
+		t.Fatalf("recorded %d audit entries, want 1", len(auditService.entries))
+	}
+	entry := auditService.entries[0]
+	if entry.Action != "PATCH /api/v1/admin/users/:id/role" {
+		t.Errorf("Action = %q, want the route", entry.Action)
+	}
+	if entry.StatusCode != http.StatusBadRequest {
+		t.Errorf("StatusCode = %d, want %d", entry.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestAdminAuditMiddlewareSkipsReads(t *testing.T) {
+	auditService := &fakeAdminAuditService{}
+	router := newAuditedAdminRouter(uuid.New(), auditService)
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/admin/flags", nil))
+
+	if len(auditService.entries) != 0 {
+		t.Errorf("recorded %d audit entries for a GET, want none", len(auditService.entries))
+	}
+}
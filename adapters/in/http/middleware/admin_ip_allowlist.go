@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// ParseCIDRList parses CIDR blocks, accepting bare IPs as single-host blocks
+func ParseCIDRList(entries []string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address: %s", entry)
+			}
+			if ip.To4() != nil {
+				entry += "/32"
+			} else {
+				entry += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR block %s: %w", entry, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// AdminIPAllowlistMiddleware rejects requests whose client IP is outside the allowed networks with 403.
+// An empty list applies no IP restriction. The client IP comes from X-Forwarded-For only when
+// the request arrives through a proxy the router trusts (gin.Engine.SetTrustedProxies)
+func AdminIPAllowlistMiddleware(allowed []*net.IPNet) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if len(allowed) == 0 {
+			c.Next()
+			return
+		}
+
+		clientIP := net.ParseIP(c.ClientIP())
+		if clientIP != nil {
+			for _, network := range allowed {
+				if network.Contains(clientIP) {
+					c.Next()
+					return
+				}
+			}
+		}
+
+		logger.WarnContext(c.Request.Context(), "Admin request blocked by IP allowlist", map[string]interface{}{
+			"client_ip": c.ClientIP(),
+			"path":      c.Request.URL.Path,
+		})
+
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "forbidden",
+			Message: "Access from this network is not allowed",
+		})
+		c.Abort()
+	}
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// allowlistStatus sends a request from remoteAddr, with X-Forwarded-For when forwardedFor is
+// set, through the allowlist on a router trusting trustedProxies
+func allowlistStatus(t *testing.T, trustedProxies []string, remoteAddr, forwardedFor string) int {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	allowed, err := ParseCIDRList([]string{"10.8.0.0/16", "203.0.113.10"})
+	if err != nil {
+		t.Fatalf("ParseCIDRList() error = %v", err)
+	}
+
+	router := gin.New()
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		t.Fatalf("SetTrustedProxies() error = %v", err)
+	}
+	router.Use(AdminIPAllowlistMiddleware(allowed))
+	router.GET("/admin/flags", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/admin/flags", nil)
+	request.RemoteAddr = remoteAddr
+	if forwardedFor != "" {
+		request.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder.Code
+}
+
+func TestAdminIPAllowlistMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		remoteAddr     string
+		forwardedFor   string
+		want           int
+	}{
+		{name: "allowed network", remoteAddr: "10.8.3.4:51000", want: http.StatusOK},
+		{name: "allowed single IP", remoteAddr: "203.0.113.10:51000", want: http.StatusOK},
+		{name: "denied IP", remoteAddr: "198.51.100.7:51000", want: http.StatusForbidden},
+		{
+			name:         "denied IP with spoofed forwarded header",
+			remoteAddr:   "198.51.100.7:51000",
+			forwardedFor: "203.0.113.10",
+			want:         http.StatusForbidden,
+		},
+		{
+			name:           "forwarded header from a trusted proxy",
+			trustedProxies: []string{"192.0.2.1"},
+			remoteAddr:     "192.0.2.1:51000",
+			forwardedFor:   "203.0.113.10",
+			want:           http.StatusOK,
+		},
+		{
+			name:           "spoofed header relayed by a trusted proxy",
+			trustedProxies: []string{"192.0.2.1"},
+			remoteAddr:     "192.0.2.1:51000",
+			forwardedFor:   "203.0.113.10, 198.51.100.7",
+			want:           http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allowlistStatus(t, tt.trustedProxies, tt.remoteAddr, tt.forwardedFor); got != tt.want {
+				t.Errorf("status = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
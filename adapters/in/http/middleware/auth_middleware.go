@@ -6,38 +6,60 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
 	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
 )
 
-// AuthMiddleware creates a middleware for JWT authentication
-func AuthMiddleware(authService usecases.AuthService) gin.HandlerFunc {
+// Cookie names used when tokens are delivered as cookies
+const (
+	AccessTokenCookie  = "access_token"
+	RefreshTokenCookie = "refresh_token"
+)
+
+// AuthMiddleware creates a middleware for JWT authentication.
+// The access token is read from the Authorization header; with cookieAuth enabled requests
+// without the header may present it in the access token cookie instead.
+func AuthMiddleware(authService usecases.AuthService, cookieAuth bool) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Get Authorization header
 		authHeader := c.GetHeader("Authorization")
+		var accessToken string
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-				Error:   "missing_token",
-				Message: "Authorization header is required",
-			})
-			c.Abort()
-			return
+			if cookieAuth {
+				accessToken, _ = c.Cookie(AccessTokenCookie)
+			}
+			if accessToken == "" {
+				c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+					Error:   "missing_token",
+					Message: "Authorization header is required",
+				})
+				c.Abort()
+				return
+			}
+		} else {
+			// Check Bearer token format
+			parts := strings.Split(authHeader, " ")
+			if len(parts) != 2 || parts[0] != "Bearer" {
+				c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+					Error:   "invalid_token_format",
+					Message: "Authorization header must be in format: Bearer <token>",
+				})
+				c.Abort()
+				return
+			}
+			accessToken = parts[1]
 		}
 
-		// Check Bearer token format
-		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
+		// Verify access token
+		userID, role, err := authService.VerifyAccessToken(c.Request.Context(), accessToken)
+		if err == errors.ErrTokenRevoked {
 			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-				Error:   "invalid_token_format",
-				Message: "Authorization header must be in format: Bearer <token>",
+				Error:   "token_revoked",
+				Message: "Access token has been revoked",
 			})
 			c.Abort()
 			return
 		}
-
-		accessToken := parts[1]
-
-		// Verify access token
-		userID, err := authService.VerifyAccessToken(c.Request.Context(), accessToken)
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
 				Error:   "invalid_token",
@@ -47,10 +69,36 @@ func AuthMiddleware(authService usecases.AuthService) gin.HandlerFunc {
 			return
 		}
 
-		// Set user ID in context for handlers to use
+		// Set user ID, role and the raw token in context for handlers to use
 		c.Set("userID", userID)
+		c.Set("accessToken", accessToken)
+		if role != "" {
+			c.Set("userRole", role)
+		}
 
 		// Continue to next handler
 		c.Next()
 	}
 }
+
+// OptionalAuthMiddleware authenticates the request like AuthMiddleware when an Authorization
+// header (or, with cookieAuth, an access token cookie) is present, and lets anonymous requests through otherwise
+func OptionalAuthMiddleware(authService usecases.AuthService, cookieAuth bool) gin.HandlerFunc {
+	authenticate := AuthMiddleware(authService, cookieAuth)
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" && !hasAccessTokenCookie(c, cookieAuth) {
+			c.Next()
+			return
+		}
+		authenticate(c)
+	}
+}
+
+// hasAccessTokenCookie reports whether cookie auth is enabled and the request carries the access token cookie
+func hasAccessTokenCookie(c *gin.Context, cookieAuth bool) bool {
+	if !cookieAuth {
+		return false
+	}
+	token, err := c.Cookie(AccessTokenCookie)
+	return err == nil && token != ""
+}
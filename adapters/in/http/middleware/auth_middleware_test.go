@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+)
+
+// stubTokenVerifier accepts only the access token "valid-token"
+type stubTokenVerifier struct {
+	usecases.AuthService
+}
+
+func (s *stubTokenVerifier) VerifyAccessToken(ctx context.Context, accessToken string) (string, string, error) {
+	if accessToken != "valid-token" {
+		return "", "", errors.ErrInvalidToken
+	}
+	return "11111111-1111-1111-1111-111111111111", "user", nil
+}
+
+func TestAuthMiddlewareTokenSources(t *testing.T) {
+	tests := []struct {
+		name       string
+		cookieAuth bool
+		header     string
+		cookie     string
+		wantCode   int
+	}{
+		{name: "bearer header", header: "Bearer valid-token", wantCode: http.StatusOK},
+		{name: "cookie with cookie auth", cookieAuth: true, cookie: "valid-token", wantCode: http.StatusOK},
+		{name: "cookie without cookie auth", cookie: "valid-token", wantCode: http.StatusUnauthorized},
+		{name: "invalid cookie", cookieAuth: true, cookie: "forged-token", wantCode: http.StatusUnauthorized},
+		{name: "header wins over cookie", cookieAuth: true, header: "Bearer forged-token", cookie: "valid-token", wantCode: http.StatusUnauthorized},
+		{name: "neither", cookieAuth: true, wantCode: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			var userID string
+			router := gin.New()
+			router.Use(AuthMiddleware(&stubTokenVerifier{}, tt.cookieAuth))
+			router.GET("/users/me", func(c *gin.Context) {
+				userID = c.GetString("userID")
+				c.Status(http.StatusOK)
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/users/me", nil)
+			if tt.header != "" {
+				request.Header.Set("Authorization", tt.header)
+			}
+			if tt.cookie != "" {
+				request.AddCookie(&http.Cookie{Name: AccessTokenCookie, Value: tt.cookie})
+			}
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			if recorder.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", recorder.Code, tt.wantCode)
+			}
+			if tt.wantCode == http.StatusOK && userID == "" {
+				t.Error("handler ran without the authenticated user ID")
+			}
+		})
+	}
+}
@@ -7,13 +7,16 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// AllowedOrigins are the frontend origins allowed to call the API from a browser
+var AllowedOrigins = []string{"http://xyz:3002", "https://jalanrusak.com"}
+
 // CORSMiddleware configures Cross-Origin Resource Sharing (CORS) for the API
 func CORSMiddleware() gin.HandlerFunc {
 	config := cors.Config{
-		AllowOrigins:     []string{"http://xyz:3002", "https://jalanrusak.com"}, // Frontend origins
+		AllowOrigins:     AllowedOrigins,
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Request-ID"},
-		ExposeHeaders:    []string{"Content-Length", "X-Request-ID", "X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Request-ID", "X-Device-ID", "X-Request-Timeout", "Prefer"},
+		ExposeHeaders:    []string{"Content-Length", "Location", "Preference-Applied", "X-Request-ID", "X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}
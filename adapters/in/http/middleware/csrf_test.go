@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCSRFOriginMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(CSRFOriginMiddleware([]string{"https://jalanrusak.com"}))
+	router.Any("/api/v1/damaged-roads", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	tests := []struct {
+		name     string
+		method   string
+		cookie   string
+		header   map[string]string
+		wantCode int
+	}{
+		{name: "cookie post from allowed origin", method: http.MethodPost, cookie: AccessTokenCookie,
+			header: map[string]string{"Origin": "https://JalanRusak.com/"}, wantCode: http.StatusOK},
+		{name: "cookie post from foreign origin", method: http.MethodPost, cookie: AccessTokenCookie,
+			header: map[string]string{"Origin": "https://evil.example"}, wantCode: http.StatusForbidden},
+		{name: "cookie delete without origin", method: http.MethodDelete, cookie: AccessTokenCookie, wantCode: http.StatusForbidden},
+		{name: "opaque origin", method: http.MethodPost, cookie: AccessTokenCookie,
+			header: map[string]string{"Origin": "null"}, wantCode: http.StatusForbidden},
+		{name: "referer of allowed origin", method: http.MethodPatch, cookie: AccessTokenCookie,
+			header: map[string]string{"Referer": "https://jalanrusak.com/laporan/1"}, wantCode: http.StatusOK},
+		{name: "referer of lookalike host", method: http.MethodPatch, cookie: AccessTokenCookie,
+			header: map[string]string{"Referer": "https://jalanrusak.com.evil.example/"}, wantCode: http.StatusForbidden},
+		{name: "refresh cookie from foreign origin", method: http.MethodPost, cookie: RefreshTokenCookie,
+			header: map[string]string{"Origin": "https://evil.example"}, wantCode: http.StatusForbidden},
+		{name: "cookie get from foreign origin", method: http.MethodGet, cookie: AccessTokenCookie,
+			header: map[string]string{"Origin": "https://evil.example"}, wantCode: http.StatusOK},
+		{name: "bearer post from foreign origin", method: http.MethodPost, cookie: AccessTokenCookie,
+			header: map[string]string{"Origin": "https://evil.example", "Authorization": "Bearer token"}, wantCode: http.StatusOK},
+		{name: "post without cookies", method: http.MethodPost, wantCode: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/v1/damaged-roads", nil)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: tt.cookie, Value: "token"})
+			}
+			for name, value := range tt.header {
+				req.Header.Set(name, value)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// EnvelopeResponse is the uniform wrapper applied to JSON responses when envelope mode is enabled
+type EnvelopeResponse struct {
+	Data  interface{}  `json:"data"`
+	Error interface{}  `json:"error"`
+	Meta  EnvelopeMeta `json:"meta"`
+}
+
+// EnvelopeMeta carries request metadata in an enveloped response
+type EnvelopeMeta struct {
+	RequestID string `json:"request_id,omitempty"`
+	Status    int    `json:"status"`
+}
+
+// envelopeWriter buffers JSON bodies so they can be wrapped after the handler completes
+type envelopeWriter struct {
+	gin.ResponseWriter
+	body      *bytes.Buffer
+	streaming bool
+}
+
+func (w *envelopeWriter) Write(data []byte) (int, error) {
+	if !w.buffering() {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.body.Write(data)
+}
+
+func (w *envelopeWriter) WriteString(s string) (int, error) {
+	if !w.buffering() {
+		return w.ResponseWriter.WriteString(s)
+	}
+	return w.body.WriteString(s)
+}
+
+// Flush switches the writer to pass-through: a handler that flushes is streaming its
+// body, which cannot be wrapped without buffering it whole
+func (w *envelopeWriter) Flush() {
+	w.streaming = true
+	if w.body.Len() > 0 {
+		_, _ = w.ResponseWriter.Write(w.body.Bytes())
+		w.body.Reset()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// buffering reports whether the response is JSON and should be held for wrapping
+func (w *envelopeWriter) buffering() bool {
+	return !w.streaming && strings.HasPrefix(w.Header().Get("Content-Type"), "application/json")
+}
+
+// ResponseEnvelopeMiddleware wraps every JSON response in a {data, error, meta} envelope.
+// Successful bodies go under "data", error bodies (status >= 400) under "error".
+// Non-JSON responses such as Swagger UI or streams are passed through untouched.
+func ResponseEnvelopeMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		writer := &envelopeWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+
+		c.Next()
+
+		c.Writer = writer.ResponseWriter
+		if writer.body.Len() == 0 {
+			return
+		}
+
+		if !json.Valid(writer.body.Bytes()) {
+			// Not valid JSON after all; send the original bytes
+			_, _ = c.Writer.Write(writer.body.Bytes())
+			return
+		}
+		// Embedded as is, so number formatting such as fixed coordinate precision survives
+		payload := json.RawMessage(writer.body.Bytes())
+
+		status := c.Writer.Status()
+		envelope := EnvelopeResponse{
+			Meta: EnvelopeMeta{
+				RequestID: c.GetString(string(logger.RequestIDKey)),
+				Status:    status,
+			},
+		}
+		if status >= http.StatusBadRequest {
+			envelope.Error = payload
+		} else {
+			envelope.Data = payload
+		}
+
+		wrapped, err := json.Marshal(envelope)
+		if err != nil {
+			_, _ = c.Writer.Write(writer.body.Bytes())
+			return
+		}
+		_, _ = c.Writer.Write(wrapped)
+	}
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+)
+
+// RequireGeometry creates a middleware for routes that need the PostGIS geometry backend.
+// When the backend is disabled (GEOMETRY_BACKEND=none) those routes answer 503 instead of
+// failing on the first spatial query.
+func RequireGeometry(enabled bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !enabled {
+			c.JSON(http.StatusServiceUnavailable, dto.ErrorResponse{
+				Error:   "geometry_unavailable",
+				Message: "Geospatial features are disabled on this server",
+			})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequireGeometry(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		wantCode int
+	}{
+		{name: "postgis backend", enabled: true, wantCode: http.StatusOK},
+		{name: "GEOMETRY_BACKEND=none", enabled: false, wantCode: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/damaged-roads", RequireGeometry(tt.enabled), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/damaged-roads", nil))
+			if recorder.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", recorder.Code, tt.wantCode)
+			}
+			if !tt.enabled && !strings.Contains(recorder.Body.String(), "geometry_unavailable") {
+				t.Errorf("body = %s, want the geometry_unavailable error", recorder.Body.String())
+			}
+		})
+	}
+}
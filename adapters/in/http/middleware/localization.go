@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/pkg/i18n"
+)
+
+// LocaleKey is the context key holding the locale negotiated for the request
+const LocaleKey = "locale"
+
+// localizingWriter holds back JSON error bodies so their message can be translated
+// once the handler completes. Successful responses are written straight through.
+type localizingWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *localizingWriter) Write(data []byte) (int, error) {
+	if !w.buffering() {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.body.Write(data)
+}
+
+func (w *localizingWriter) WriteString(s string) (int, error) {
+	if !w.buffering() {
+		return w.ResponseWriter.WriteString(s)
+	}
+	return w.body.WriteString(s)
+}
+
+// buffering reports whether the response is a JSON error that may need translating
+func (w *localizingWriter) buffering() bool {
+	return w.Status() >= http.StatusBadRequest &&
+		strings.HasPrefix(w.Header().Get("Content-Type"), "application/json")
+}
+
+// LocalizationMiddleware negotiates the response locale from the Accept-Language header,
+// falling back to defaultLocale, and replaces ErrorResponse.Message with the catalog
+// translation of ErrorResponse.Error when one exists. Must be registered after the
+// envelope middleware so it sees the bare error body.
+func LocalizationMiddleware(defaultLocale string) gin.HandlerFunc {
+	if !i18n.IsSupported(defaultLocale) {
+		defaultLocale = i18n.LocaleEnglish
+	}
+
+	return func(c *gin.Context) {
+		locale := i18n.Negotiate(c.GetHeader("Accept-Language"), defaultLocale)
+		c.Set(LocaleKey, locale)
+		c.Writer.Header().Add("Vary", "Accept-Language")
+
+		if locale == i18n.LocaleEnglish {
+			c.Next()
+			return
+		}
+
+		writer := &localizingWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+
+		c.Next()
+
+		c.Writer = writer.ResponseWriter
+		if writer.body.Len() == 0 {
+			return
+		}
+
+		_, _ = c.Writer.Write(localizeErrorBody(c, locale, writer.body.Bytes()))
+	}
+}
+
+// localizeErrorBody translates the message of an ErrorResponse-shaped body, returning the
+// original bytes when the body is not one or the code has no translation
+func localizeErrorBody(c *gin.Context, locale string, body []byte) []byte {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return body
+	}
+
+	code, _ := payload["error"].(string)
+	message, ok := i18n.Message(locale, code)
+	if !ok {
+		return body
+	}
+	payload["message"] = message
+
+	localized, err := json.Marshal(payload)
+	if err != nil {
+		return body
+	}
+	c.Header("Content-Language", locale)
+	return localized
+}
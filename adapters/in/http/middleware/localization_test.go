@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestLocalizationMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const (
+		englishNotFound    = "Report not found"
+		indonesianNotFound = "Data tidak ditemukan"
+	)
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		defaultLocale  string
+		code           string
+		message        string
+		wantMessage    string
+		wantLanguage   string
+	}{
+		{"indonesian", "id", "en", "not_found", englishNotFound, indonesianNotFound, "id"},
+		{"indonesian region with english fallback", "id-ID,id;q=0.9,en;q=0.8", "en", "not_found", englishNotFound, indonesianNotFound, "id"},
+		{"legacy indonesian code", "in", "en", "not_found", englishNotFound, indonesianNotFound, "id"},
+		{"english preferred", "en;q=1,id;q=0.5", "en", "not_found", englishNotFound, englishNotFound, ""},
+		{"unsupported language falls back to default", "fr", "id", "not_found", englishNotFound, indonesianNotFound, "id"},
+		{"no header uses default", "", "en", "not_found", englishNotFound, englishNotFound, ""},
+		{"detailed message kept", "id", "en", "validation_error", "title is required", "title is required", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(LocalizationMiddleware(tt.defaultLocale))
+			router.GET("/reports/:id", func(c *gin.Context) {
+				c.JSON(http.StatusNotFound, gin.H{"error": tt.code, "message": tt.message})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/reports/1", nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			var body struct {
+				Error   string `json:"error"`
+				Message string `json:"message"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("response %q is not JSON: %v", w.Body.String(), err)
+			}
+			if w.Code != http.StatusNotFound || body.Error != tt.code {
+				t.Errorf("response = %d %q, want the status and error code unchanged", w.Code, body.Error)
+			}
+			if body.Message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", body.Message, tt.wantMessage)
+			}
+			if got := w.Header().Get("Content-Language"); got != tt.wantLanguage {
+				t.Errorf("Content-Language = %q, want %q", got, tt.wantLanguage)
+			}
+			if w.Header().Get("Vary") != "Accept-Language" {
+				t.Errorf("Vary = %q, want Accept-Language", w.Header().Get("Vary"))
+			}
+		})
+	}
+}
+
+func TestLocalizationMiddlewarePassesSuccessThrough(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(LocalizationMiddleware("en"))
+	router.GET("/reports", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"error": "not_found", "message": "a field that only looks like an error"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/reports", nil)
+	req.Header.Set("Accept-Language", "id")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if want := `{"error":"not_found","message":"a field that only looks like an error"}`; w.Body.String() != want {
+		t.Errorf("body = %s, want %s", w.Body.String(), want)
+	}
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -17,8 +18,9 @@ func RequestIDMiddleware() gin.HandlerFunc {
 			requestID = uuid.New().String()
 		}
 
-		// Store in context
+		// Store in context, and in the request context so service logs carry it too
 		c.Set(string(logger.RequestIDKey), requestID)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), logger.RequestIDKey, requestID))
 
 		// Add to response headers
 		c.Header("X-Request-ID", requestID)
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Normalize canonicalizes request fields according to their normalize tag, e.g.
+// `normalize:"trim,lower"`, so whitespace and case variants of the same input are
+// validated and stored alike. Supported steps are trim, lower and upper, applied in order.
+// It handles string, *string and []string fields and descends into nested structs,
+// struct pointers and slices of structs. obj must be a pointer to a struct
+func Normalize(obj interface{}) {
+	normalizeValue(reflect.ValueOf(obj))
+}
+
+// normalizeValue walks v looking for structs whose fields carry normalize tags
+func normalizeValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			normalizeValue(v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			normalizeValue(v.Index(i))
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+			if !field.CanSet() {
+				continue
+			}
+			if tag, ok := t.Field(i).Tag.Lookup("normalize"); ok {
+				normalizeStrings(field, strings.Split(tag, ","))
+				continue
+			}
+			normalizeValue(field)
+		}
+	}
+}
+
+// normalizeStrings applies the normalize steps to a string, *string or []string field
+func normalizeStrings(field reflect.Value, steps []string) {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(applyNormalization(field.String(), steps))
+	case reflect.Ptr:
+		if !field.IsNil() && field.Elem().Kind() == reflect.String {
+			field.Elem().SetString(applyNormalization(field.Elem().String(), steps))
+		}
+	case reflect.Slice:
+		if field.Type().Elem().Kind() == reflect.String {
+			for i := 0; i < field.Len(); i++ {
+				field.Index(i).SetString(applyNormalization(field.Index(i).String(), steps))
+			}
+		}
+	}
+}
+
+// applyNormalization runs the steps on value; unknown steps are ignored
+func applyNormalization(value string, steps []string) string {
+	for _, step := range steps {
+		switch strings.TrimSpace(step) {
+		case "trim":
+			value = strings.TrimSpace(value)
+		case "lower":
+			value = strings.ToLower(value)
+		case "upper":
+			value = strings.ToUpper(value)
+		}
+	}
+	return value
+}
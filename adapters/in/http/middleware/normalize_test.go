@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+)
+
+// bindJSON runs BindAndValidate on body into obj and reports whether it was accepted
+func bindJSON(t *testing.T, body string, obj interface{}) bool {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	return BindAndValidate(c, obj)
+}
+
+func TestBindAndValidateNormalizesEmailAcrossEndpoints(t *testing.T) {
+	for _, variant := range []string{"budi@example.com", "  Budi@Example.COM ", "\tBUDI@EXAMPLE.COM\n"} {
+		quoted := `"` + strings.NewReplacer("\t", `\t`, "\n", `\n`).Replace(variant) + `"`
+
+		var login dto.LoginRequest
+		var registration dto.RegistrationRequest
+		var reset dto.PasswordResetRequestRequest
+		for name, bound := range map[string]struct {
+			ok    bool
+			email *string
+		}{
+			"login":          {bindJSON(t, `{"email":`+quoted+`,"password":"secret"}`, &login), &login.Email},
+			"registration":   {bindJSON(t, `{"name":" Budi ","email":`+quoted+`,"password":"password123"}`, &registration), &registration.Email},
+			"password reset": {bindJSON(t, `{"email":`+quoted+`}`, &reset), &reset.Email},
+		} {
+			if !bound.ok {
+				t.Errorf("%s rejected email %q", name, variant)
+				continue
+			}
+			if *bound.email != "budi@example.com" {
+				t.Errorf("%s normalized %q to %q, want budi@example.com", name, variant, *bound.email)
+			}
+		}
+		if registration.Name != "Budi" {
+			t.Errorf("registration name = %q, want it trimmed", registration.Name)
+		}
+	}
+}
+
+func TestBindAndValidateNormalizesSubdistrictCodeAcrossEndpoints(t *testing.T) {
+	const code = " 35.78.01.1001  "
+
+	var location dto.ValidateLocationRequest
+	if !bindJSON(t, `{"subdistrict_code":"`+code+`","path_points":[{"lat":-7.2575,"lng":112.7521}]}`, &location) {
+		t.Fatal("validate location request rejected")
+	}
+	var exist dto.SubDistrictsExistRequest
+	if !bindJSON(t, `{"codes":["`+code+`","35.78.01.1002 "]}`, &exist) {
+		t.Fatal("subdistricts exist request rejected")
+	}
+	var create dto.CreateDamagedRoadRequest
+	body := `{"title":"  Jalan berlubang ","subdistrict_code":"` + code + `","category_id":" pothole ",` +
+		`"path_points":[{"lat":-7.2575,"lng":112.7521}],"photo_urls":["https://photos.example.com/1.jpg"]}`
+	if !bindJSON(t, body, &create) {
+		t.Fatal("create report request rejected")
+	}
+
+	for name, got := range map[string]string{
+		"validate location":  location.SubDistrictCode,
+		"subdistricts exist": exist.Codes[0],
+		"create report":      create.SubDistrictCode,
+	} {
+		if got != "35.78.01.1001" {
+			t.Errorf("%s subdistrict code = %q, want it trimmed", name, got)
+		}
+	}
+	if create.Title != "Jalan berlubang" || create.CategoryID == nil || *create.CategoryID != "pothole" {
+		t.Errorf("create report title %q category %v, want both trimmed", create.Title, create.CategoryID)
+	}
+}
+
+func TestBindAndValidateNormalizesBeforeValidating(t *testing.T) {
+	// "  ab  " only passes min=3 before trimming; the trimmed title must be validated
+	var create dto.CreateDamagedRoadRequest
+	body := `{"title":"  ab  ","subdistrict_code":"35.78.01.1001",` +
+		`"path_points":[{"lat":-7.2575,"lng":112.7521}],"photo_urls":["https://photos.example.com/1.jpg"]}`
+	if bindJSON(t, body, &create) {
+		t.Error("a title of two characters padded with spaces passed min=3")
+	}
+
+	var status dto.UpdateStatusRequest
+	if !bindJSON(t, `{"status":" Under_Verification "}`, &status) || status.Status != "under_verification" {
+		t.Errorf("status = %q, want under_verification", status.Status)
+	}
+}
+
+func TestNormalizeNestedFields(t *testing.T) {
+	type inner struct {
+		Code string `normalize:"trim,upper"`
+	}
+	type request struct {
+		Name     *string  `normalize:"trim"`
+		Tags     []string `normalize:"lower"`
+		Inner    inner
+		Items    []inner
+		Optional *inner
+		Raw      string
+	}
+	name := "  Budi  "
+	req := &request{
+		Name:     &name,
+		Tags:     []string{"Pothole", "CRACKING"},
+		Inner:    inner{Code: " ab "},
+		Items:    []inner{{Code: "cd "}},
+		Optional: &inner{Code: " ef"},
+		Raw:      "  untouched ",
+	}
+
+	Normalize(req)
+
+	if *req.Name != "Budi" || req.Tags[0] != "pothole" || req.Tags[1] != "cracking" {
+		t.Errorf("name %q tags %q, want trimmed and lowercased", *req.Name, req.Tags)
+	}
+	if req.Inner.Code != "AB" || req.Items[0].Code != "CD" || req.Optional.Code != "EF" {
+		t.Errorf("nested codes %q %q %q, want AB CD EF", req.Inner.Code, req.Items[0].Code, req.Optional.Code)
+	}
+	if req.Raw != "  untouched " {
+		t.Errorf("untagged field = %q, want it left alone", req.Raw)
+	}
+}
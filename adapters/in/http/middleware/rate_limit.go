@@ -13,7 +13,14 @@ import (
 
 // RateLimitMiddleware creates a rate limiting middleware with the specified rate
 // Rate format: "requests-per-period" (e.g., "10-M" = 10 per minute, "100-H" = 100 per hour)
-func RateLimitMiddleware(rate limiter.Rate) gin.HandlerFunc {
+// Requests to exemptPaths (exact match, e.g. "/health") are never limited nor counted, so
+// monitoring probes cannot be throttled into reporting the service as down
+func RateLimitMiddleware(rate limiter.Rate, exemptPaths ...string) gin.HandlerFunc {
+	exempt := make(map[string]bool, len(exemptPaths))
+	for _, path := range exemptPaths {
+		exempt[path] = true
+	}
+
 	// Create in-memory store
 	store := memory.NewStore()
 
@@ -25,6 +32,11 @@ func RateLimitMiddleware(rate limiter.Rate) gin.HandlerFunc {
 
 	// Wrap with custom error handling
 	return func(c *gin.Context) {
+		if exempt[c.Request.URL.Path] {
+			c.Next()
+			return
+		}
+
 		// Get limiter context
 		limiterCtx, err := instance.Get(c.Request.Context(), c.ClientIP())
 		if err != nil {
@@ -55,3 +67,43 @@ func RateLimitMiddleware(rate limiter.Rate) gin.HandlerFunc {
 		middleware(c)
 	}
 }
+
+// UserRateLimitMiddleware limits requests per authenticated user rather than per IP, for
+// expensive endpoints that a single account should not be able to hammer from many addresses.
+// Must run after AuthMiddleware; requests without a user ID fall back to the client IP
+func UserRateLimitMiddleware(rate limiter.Rate) gin.HandlerFunc {
+	instance := limiter.New(memory.NewStore(), rate)
+
+	return func(c *gin.Context) {
+		key := c.GetString("userID")
+		if key == "" {
+			key = c.ClientIP()
+		}
+
+		limiterCtx, err := instance.Get(c.Request.Context(), key)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Rate limiter error",
+				"message": "Failed to check rate limit",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", fmt.Sprintf("%d", limiterCtx.Limit))
+		c.Header("X-RateLimit-Remaining", fmt.Sprintf("%d", limiterCtx.Remaining))
+		c.Header("X-RateLimit-Reset", fmt.Sprintf("%d", limiterCtx.Reset))
+
+		if limiterCtx.Reached {
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"error":               "Rate limit exceeded",
+				"message":             "Too many requests. Please try again later.",
+				"retry_after_seconds": limiterCtx.Reset,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
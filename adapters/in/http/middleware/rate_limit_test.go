@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ulule/limiter/v3"
+)
+
+func newRateLimitedRouter(limit int64) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RateLimitMiddleware(limiter.Rate{Period: time.Minute, Limit: limit}, "/health"))
+	router.GET("/health", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.GET("/api/v1/damaged-roads", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return router
+}
+
+func TestRateLimitMiddlewareNeverThrottlesHealthProbes(t *testing.T) {
+	router := newRateLimitedRouter(5)
+
+	for i := 0; i < 200; i++ {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/health", nil))
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("health probe %d answered %d, want 200", i+1, recorder.Code)
+		}
+	}
+
+	// The probes did not count against the client's budget either
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/damaged-roads", nil))
+	if recorder.Code != http.StatusOK {
+		t.Errorf("first API request after the probes answered %d, want 200", recorder.Code)
+	}
+}
+
+func TestRateLimitMiddlewareThrottlesOtherPaths(t *testing.T) {
+	router := newRateLimitedRouter(5)
+
+	throttled := false
+	for i := 0; i < 20 && !throttled; i++ {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/damaged-roads", nil))
+		throttled = recorder.Code == http.StatusTooManyRequests
+	}
+	if !throttled {
+		t.Error("20 rapid API requests were never answered 429, want the limit enforced")
+	}
+}
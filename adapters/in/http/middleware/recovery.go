@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// RecoveryMiddleware turns a panicking handler into a 500 response, like gin.Recovery, but
+// lets http.ErrAbortHandler through to net/http. Streaming handlers panic with it when they
+// fail after the response has begun: net/http then drops the connection, so the client sees
+// a truncated download instead of a body that ends cleanly
+func RecoveryMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			err := recover()
+			if err == nil {
+				return
+			}
+			if err == http.ErrAbortHandler {
+				panic(err)
+			}
+
+			logger.ErrorContext(c.Request.Context(), "Panic recovered", map[string]interface{}{
+				"method": c.Request.Method,
+				"path":   c.Request.URL.Path,
+				"error":  fmt.Sprint(err),
+				"stack":  string(debug.Stack()),
+			})
+			c.AbortWithStatus(http.StatusInternalServerError)
+		}()
+
+		c.Next()
+	}
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newRecoveryRouter(handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RecoveryMiddleware())
+	router.GET("/panic", handler)
+	return router
+}
+
+func TestRecoveryMiddlewareRespondsWith500(t *testing.T) {
+	router := newRecoveryRouter(func(c *gin.Context) {
+		panic("boom")
+	})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/panic", nil))
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500", recorder.Code)
+	}
+}
+
+func TestRecoveryMiddlewarePassesAbortHandlerThrough(t *testing.T) {
+	router := newRecoveryRouter(func(c *gin.Context) {
+		c.String(http.StatusOK, "partial")
+		panic(http.ErrAbortHandler)
+	})
+
+	defer func() {
+		if err := recover(); err != http.ErrAbortHandler {
+			t.Errorf("recovered %v, want http.ErrAbortHandler to reach net/http", err)
+		}
+	}()
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/panic", nil))
+	t.Error("ServeHTTP returned normally, want the abort to propagate")
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+)
+
+// RequireRole creates a middleware that only lets through users holding one of the given roles.
+// Must run after AuthMiddleware. The role comes from the access token; tokens issued before
+// roles were embedded fall back to a user lookup. The resolved role is stored in the context as "userRole".
+func RequireRole(userService usecases.UserService, roles ...string) gin.HandlerFunc {
+	allowed := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		allowed[role] = true
+	}
+
+	return func(c *gin.Context) {
+		userID, exists := c.Get("userID")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+				Error:   "unauthorized",
+				Message: "User authentication required",
+			})
+			c.Abort()
+			return
+		}
+
+		role := c.GetString("userRole")
+		if role == "" {
+			// Resolve the current role from the user record
+			user, err := userService.GetUserByID(c.Request.Context(), userID.(string))
+			if err != nil {
+				c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+					Error:   "unauthorized",
+					Message: "User not found",
+				})
+				c.Abort()
+				return
+			}
+			role = user.Role
+		}
+
+		if !allowed[role] {
+			c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "Insufficient permissions for this resource",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Set("userRole", role)
+		c.Next()
+	}
+}
+
+// ResolveRole creates a middleware that looks up the authenticated user's role and stores it
+// in the context as "userRole" without restricting access, for handlers that only gate
+// some behavior on the role. Must run after AuthMiddleware; a role already taken from the
+// access token is kept without a lookup.
+func ResolveRole(userService usecases.UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("userRole") != "" {
+			c.Next()
+			return
+		}
+		if userID, exists := c.Get("userID"); exists {
+			if user, err := userService.GetUserByID(c.Request.Context(), userID.(string)); err == nil {
+				c.Set("userRole", user.Role)
+			}
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requestContextKey holds the request context from before the deadline was applied
+const requestContextKey = "requestContextWithoutTimeout"
+
+// RequestTimeoutHeader lets clients ask for a shorter deadline than the server default,
+// as a Go duration ("2s", "750ms") or a plain number of milliseconds
+const RequestTimeoutHeader = "X-Request-Timeout"
+
+// RequestTimeoutMiddleware bounds every request's context by maxTimeout, or by the shorter
+// deadline a client requests via X-Request-Timeout. Values above maxTimeout are clamped to it;
+// malformed and non-positive values are ignored. A non-positive maxTimeout disables the middleware. Long-lived
+// streams are listed in exemptPaths and only end when the client disconnects; handlers that
+// only sometimes stream call ReleaseRequestTimeout instead.
+func RequestTimeoutMiddleware(maxTimeout time.Duration, exemptPaths ...string) gin.HandlerFunc {
+	exempt := make(map[string]bool, len(exemptPaths))
+	for _, path := range exemptPaths {
+		exempt[path] = true
+	}
+
+	return func(c *gin.Context) {
+		if maxTimeout <= 0 || exempt[c.Request.URL.Path] {
+			c.Next()
+			return
+		}
+
+		timeout := requestTimeout(c.GetHeader(RequestTimeoutHeader), maxTimeout)
+		c.Set(requestContextKey, c.Request.Context())
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// ReleaseRequestTimeout lifts the deadline set by RequestTimeoutMiddleware for the rest of the
+// request, for handlers that go on to stream an unbounded response. The request is still
+// cancelled when the client disconnects
+func ReleaseRequestTimeout(c *gin.Context) {
+	if value, ok := c.Get(requestContextKey); ok {
+		if ctx, ok := value.(context.Context); ok {
+			c.Request = c.Request.WithContext(ctx)
+		}
+	}
+}
+
+// requestTimeout returns the client-requested timeout when it is valid and below the cap
+func requestTimeout(header string, maxTimeout time.Duration) time.Duration {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return maxTimeout
+	}
+
+	requested, err := time.ParseDuration(header)
+	if err != nil {
+		millis, convErr := strconv.ParseInt(header, 10, 64)
+		if convErr != nil {
+			return maxTimeout
+		}
+		requested = time.Duration(millis) * time.Millisecond
+	}
+
+	if requested <= 0 || requested > maxTimeout {
+		return maxTimeout
+	}
+	return requested
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// deadlineRecorder runs a request through the timeout middleware and reports whether the
+// handler's context had a deadline
+func deadlineRecorder(t *testing.T, path string, handler gin.HandlerFunc) bool {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	var hasDeadline bool
+	router := gin.New()
+	router.Use(RequestTimeoutMiddleware(30*time.Second, "/api/v1/damaged-roads/export"))
+	router.GET(path, func(c *gin.Context) {
+		if handler != nil {
+			handler(c)
+		}
+		_, hasDeadline = c.Request.Context().Deadline()
+		c.Status(http.StatusOK)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	return hasDeadline
+}
+
+func TestRequestTimeoutMiddlewareSetsDeadline(t *testing.T) {
+	if !deadlineRecorder(t, "/api/v1/damaged-roads", nil) {
+		t.Error("expected a deadline on a regular request")
+	}
+}
+
+func TestRequestTimeoutMiddlewareSkipsExemptPaths(t *testing.T) {
+	if deadlineRecorder(t, "/api/v1/damaged-roads/export", nil) {
+		t.Error("expected no deadline on an exempt path")
+	}
+}
+
+func TestReleaseRequestTimeout(t *testing.T) {
+	if deadlineRecorder(t, "/api/v1/damaged-roads", ReleaseRequestTimeout) {
+		t.Error("expected no deadline after ReleaseRequestTimeout")
+	}
+}
+
+func TestRequestTimeoutHeader(t *testing.T) {
+	const maxTimeout = 30 * time.Second
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+	}{
+		{name: "absent", header: "", want: maxTimeout},
+		{name: "shorter duration", header: "2s", want: 2 * time.Second},
+		{name: "shorter milliseconds", header: "750", want: 750 * time.Millisecond},
+		{name: "surrounding spaces", header: " 500ms ", want: 500 * time.Millisecond},
+		{name: "equal to the maximum", header: "30s", want: maxTimeout},
+		{name: "above the maximum is clamped", header: "5m", want: maxTimeout},
+		{name: "milliseconds above the maximum are clamped", header: "60000", want: maxTimeout},
+		{name: "zero is ignored", header: "0", want: maxTimeout},
+		{name: "negative is ignored", header: "-2s", want: maxTimeout},
+		{name: "garbage is ignored", header: "soon", want: maxTimeout},
+		{name: "unknown unit is ignored", header: "2 days", want: maxTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			var remaining time.Duration
+			router := gin.New()
+			router.Use(RequestTimeoutMiddleware(maxTimeout))
+			router.GET("/api/v1/damaged-roads", func(c *gin.Context) {
+				deadline, _ := c.Request.Context().Deadline()
+				remaining = time.Until(deadline)
+				c.Status(http.StatusOK)
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/api/v1/damaged-roads", nil)
+			if tt.header != "" {
+				request.Header.Set(RequestTimeoutHeader, tt.header)
+			}
+			router.ServeHTTP(httptest.NewRecorder(), request)
+
+			// The handler runs a moment after the deadline is set
+			if remaining > tt.want || remaining < tt.want-time.Second {
+				t.Errorf("deadline in %s, want about %s", remaining, tt.want)
+			}
+		})
+	}
+}
@@ -1,14 +1,65 @@
 package middleware
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"reflect"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
 )
 
 var validate = validator.New()
 
+func init() {
+	// The standalone validator reads the same binding tags as Gin and reports fields by
+	// their JSON names
+	validate.SetTagName("binding")
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			return field.Name
+		}
+		return name
+	})
+
+	// Register custom validators on both the standalone validator and Gin's binding engine
+	registerCustomValidators(validate)
+	if engine, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		registerCustomValidators(engine)
+	}
+}
+
+// registerCustomValidators registers project-specific validation tags
+func registerCustomValidators(v *validator.Validate) {
+	_ = v.RegisterValidation("photo_count", validatePhotoCount)
+	_ = v.RegisterValidation("national_lat", validateNationalLat)
+	_ = v.RegisterValidation("national_lng", validateNationalLng)
+}
+
+// validatePhotoCount enforces the report photo bounds defined by the DamagedRoad entity
+func validatePhotoCount(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.Slice && field.Kind() != reflect.Array {
+		return false
+	}
+	return entities.ValidatePhotoCount(field.Len()) == nil
+}
+
+// validateNationalLat enforces the latitude bounds of the Point entity, which the boundary mode may widen
+func validateNationalLat(fl validator.FieldLevel) bool {
+	return entities.WithinNationalLat(fl.Field().Float())
+}
+
+// validateNationalLng enforces the longitude bounds of the Point entity, which the boundary mode may widen
+func validateNationalLng(fl validator.FieldLevel) bool {
+	return entities.WithinNationalLng(fl.Field().Float())
+}
+
 // ValidationError represents a validation error response
 type ValidationError struct {
 	Field   string `json:"field"`
@@ -56,14 +107,21 @@ func msgForTag(tag string, param string) string {
 		return "Must be less than or equal to " + param
 	case "url":
 		return "Invalid URL format"
+	case "photo_count":
+		return fmt.Sprintf("Must have between %d and %d photo URLs", entities.MinPhotoURLs, entities.MaxPhotoURLs)
+	case "national_lat":
+		return fmt.Sprintf("Latitude must be within Indonesian boundaries (%g to %g)", entities.NationalMinLat, entities.NationalMaxLat)
+	case "national_lng":
+		return fmt.Sprintf("Longitude must be within Indonesian boundaries (%g to %g)", entities.NationalMinLng, entities.NationalMaxLng)
 	default:
 		return "Invalid value"
 	}
 }
 
-// BindAndValidate binds JSON request and validates it
+// BindAndValidate binds JSON request, normalizes it per its normalize tags and validates it.
+// Validation runs after normalization, so e.g. an email with surrounding spaces is accepted
 func BindAndValidate(c *gin.Context, obj interface{}) bool {
-	if err := c.ShouldBindJSON(obj); err != nil {
+	if err := decodeJSON(c.Request, obj); err != nil {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Error:   "invalid_request",
 			Message: "Invalid request body",
@@ -71,6 +129,8 @@ func BindAndValidate(c *gin.Context, obj interface{}) bool {
 		return false
 	}
 
+	Normalize(obj)
+
 	if validationErrors := ValidateStruct(obj); len(validationErrors) > 0 {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Error:   "validation_error",
@@ -82,3 +142,18 @@ func BindAndValidate(c *gin.Context, obj interface{}) bool {
 
 	return true
 }
+
+// decodeJSON decodes the request body like Gin's JSON binding, without validating it
+func decodeJSON(req *http.Request, obj interface{}) error {
+	if req == nil || req.Body == nil {
+		return fmt.Errorf("missing request body")
+	}
+	decoder := json.NewDecoder(req.Body)
+	if binding.EnableDecoderUseNumber {
+		decoder.UseNumber()
+	}
+	if binding.EnableDecoderDisallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+	return decoder.Decode(obj)
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+// offshoreLocationRequest has one point inside Indonesia and one about 22km south of its bounds
+func offshoreLocationRequest() *dto.ValidateLocationRequest {
+	return &dto.ValidateLocationRequest{
+		SubDistrictCode: "35.10.02.2005",
+		PathPoints: []dto.PointDTO{
+			{Lat: -8.2, Lng: 114.3},
+			{Lat: -11.2, Lng: 114.3},
+		},
+	}
+}
+
+func TestPointBindingRejectsOffshorePointsInAllMode(t *testing.T) {
+	errs := ValidateStruct(offshoreLocationRequest())
+	if len(errs) != 1 || errs[0].Field != "lat" {
+		t.Fatalf("ValidateStruct() = %+v, want a single lat error", errs)
+	}
+}
+
+func TestPointBindingAcceptsBufferedPointsInAnyMode(t *testing.T) {
+	entities.SetBoundaryBuffer(30000)
+	t.Cleanup(func() { entities.SetBoundaryBuffer(0) })
+
+	if errs := ValidateStruct(offshoreLocationRequest()); len(errs) != 0 {
+		t.Errorf("ValidateStruct() = %+v, want a point within the buffer accepted", errs)
+	}
+
+	request := offshoreLocationRequest()
+	request.PathPoints[1].Lat = -11.5 // about 56km out, past the buffer
+	if errs := ValidateStruct(request); len(errs) != 1 {
+		t.Errorf("ValidateStruct() = %+v, want the point beyond the buffer rejected", errs)
+	}
+}
@@ -1,12 +1,40 @@
 package routes
 
 import (
+	"net"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	"github.com/nicklaros/jalanrusak-be/adapters/in/http/handlers"
 	"github.com/nicklaros/jalanrusak-be/adapters/in/http/middleware"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
 	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"github.com/ulule/limiter/v3"
+)
+
+// exportRate caps data exports per user; each one reads the user's full history
+var exportRate = limiter.Rate{
+	Period: 1 * time.Hour,
+	Limit:  3,
+}
+
+// reportExportRate caps GeoJSON report exports per user; each one streams every matching report
+var reportExportRate = limiter.Rate{
+	Period: 1 * time.Hour,
+	Limit:  20,
+}
+
+// Streaming endpoints, relative to /api/v1. Their responses can outlast the request timeout,
+// so they are exempt from it
+const (
+	// ReportEventsPath is the server-sent events stream of report status changes; it stays open
+	ReportEventsPath = "/damaged-roads/events"
+	// ReportExportPath streams every report matching the filters as GeoJSON or CSV
+	ReportExportPath = "/damaged-roads/export"
+	// UserExportPath streams the authenticated user's data bundle
+	UserExportPath = "/users/me/export"
 )
 
 // SetupRoutes configures all HTTP routes
@@ -16,9 +44,20 @@ func SetupRoutes(
 	authHandler *handlers.AuthHandler,
 	passwordHandler *handlers.PasswordHandler,
 	reportHandler *handlers.ReportHandler,
+	reportNoteHandler *handlers.ReportNoteHandler,
+	reportEventsHandler *handlers.ReportEventsHandler,
+	categoryHandler *handlers.CategoryHandler,
 	validationHandler *handlers.ValidationHandler,
+	userHandler *handlers.UserHandler,
+	adminHandler *handlers.AdminHandler,
 	healthHandler *handlers.HealthHandler,
 	authService usecases.AuthService,
+	userService usecases.UserService,
+	adminAuditService usecases.AdminAuditService,
+	adminAllowedNetworks []*net.IPNet,
+	publicReadMode bool,
+	geometryEnabled bool,
+	cookieAuth bool,
 ) {
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -40,22 +79,89 @@ func SetupRoutes(
 			auth.POST("/password/reset-confirm", passwordHandler.ResetPassword)
 		}
 
+		// Report reads are public in public read mode; a bearer token is still honored when sent
+		if publicReadMode {
+			public := apiV1.Group("")
+			public.Use(middleware.OptionalAuthMiddleware(authService, cookieAuth), middleware.RequireGeometry(geometryEnabled))
+			{
+				public.GET("/damaged-roads", middleware.ResolveRole(userService), reportHandler.ListReports)
+				public.GET("/damaged-roads/nearby", reportHandler.NearbyReports)
+				public.GET("/damaged-roads/map", reportHandler.MapReports)
+				public.GET("/damaged-roads/:id", middleware.ResolveRole(userService), reportHandler.GetReport)
+				public.GET("/damaged-roads/:id/history", reportHandler.GetStatusHistory)
+				public.GET("/categories", categoryHandler.ListCategories)
+			}
+		}
+
 		// Protected routes (require authentication)
 		protected := apiV1.Group("")
-		protected.Use(middleware.AuthMiddleware(authService))
+		protected.Use(middleware.AuthMiddleware(authService, cookieAuth))
 		{
 			protected.POST("/auth/logout", authHandler.Logout)
+			protected.POST("/auth/logout-all", authHandler.LogoutAll)
 			protected.POST("/auth/password/change", passwordHandler.ChangePassword)
+			protected.GET("/auth/sessions", authHandler.ListSessions)
+			protected.DELETE("/auth/sessions/:id", authHandler.RevokeSession)
+			protected.DELETE("/auth/sessions/device/:deviceId", authHandler.RevokeDeviceSessions)
+
+			protected.GET("/users/me", userHandler.GetProfile)
+			protected.GET("/users/me/preferences", userHandler.GetPreferences)
+			protected.PATCH("/users/me/preferences", userHandler.UpdatePreferences)
 
-			// Validation endpoints
-			protected.POST("/validate-location", validationHandler.ValidateLocation)
 			protected.POST("/validate-photos", validationHandler.ValidatePhotos)
+			protected.POST("/subdistricts/exists", validationHandler.SubDistrictsExist)
+			if !publicReadMode {
+				protected.GET("/categories", categoryHandler.ListCategories)
+			}
+
+			// Routes reading or writing report geometry, unavailable without PostGIS
+			geo := protected.Group("")
+			geo.Use(middleware.RequireGeometry(geometryEnabled))
+			{
+				// Current user routes (include the user's reports)
+				geo.GET("/users/me/activity", userHandler.GetActivity)
+				geo.GET(UserExportPath, middleware.UserRateLimitMiddleware(exportRate), userHandler.ExportData)
+				geo.GET("/me/damaged-roads", reportHandler.ListMyReports)
+
+				// Validation endpoints
+				geo.POST("/validate-location", validationHandler.ValidateLocation)
+
+				// Damaged road report routes
+				geo.POST("/damaged-roads", reportHandler.CreateReport)
+				geo.GET(ReportExportPath, middleware.UserRateLimitMiddleware(reportExportRate), reportHandler.ExportReports)
+				geo.GET(ReportEventsPath, middleware.ResolveRole(userService), reportEventsHandler.StreamEvents)
+				if !publicReadMode {
+					geo.GET("/damaged-roads", middleware.ResolveRole(userService), reportHandler.ListReports)
+					geo.GET("/damaged-roads/nearby", reportHandler.NearbyReports)
+					geo.GET("/damaged-roads/map", reportHandler.MapReports)
+					geo.GET("/damaged-roads/:id", middleware.ResolveRole(userService), reportHandler.GetReport)
+					geo.GET("/damaged-roads/:id/history", reportHandler.GetStatusHistory)
+				}
+				geo.PATCH("/damaged-roads/:id/status",
+					middleware.RequireRole(userService, entities.RoleVerificator, entities.RoleAdmin),
+					reportHandler.UpdateReportStatus)
+				geo.PUT("/damaged-roads/:id", reportHandler.UpdateReport)
+				geo.PATCH("/damaged-roads/:id/path", reportHandler.UpdateReportPath)
+				geo.DELETE("/damaged-roads/:id", reportHandler.DeleteReport)
+
+				// Verifier notes; internal notes are only listed for staff
+				geo.GET("/damaged-roads/:id/notes", middleware.ResolveRole(userService), reportNoteHandler.ListNotes)
+				geo.POST("/damaged-roads/:id/notes",
+					middleware.RequireRole(userService, entities.RoleVerificator, entities.RoleAdmin),
+					reportNoteHandler.AddNote)
+			}
 
-			// Damaged road report routes
-			protected.POST("/damaged-roads", reportHandler.CreateReport)
-			protected.GET("/damaged-roads", reportHandler.ListReports)
-			protected.GET("/damaged-roads/:id", reportHandler.GetReport)
-			protected.PATCH("/damaged-roads/:id/status", reportHandler.UpdateReportStatus)
+			// Admin routes (require admin role)
+			admin := protected.Group("/admin")
+			admin.Use(middleware.AdminIPAllowlistMiddleware(adminAllowedNetworks))
+			admin.Use(middleware.RequireRole(userService, entities.RoleAdmin))
+			if adminAuditService != nil {
+				admin.Use(middleware.AdminAuditMiddleware(adminAuditService))
+			}
+			{
+				admin.GET("/flags", adminHandler.GetFeatureFlags)
+				admin.POST("/maintenance/cleanup-tokens", adminHandler.CleanupTokens)
+			}
 		}
 	}
 }
@@ -61,3 +61,17 @@ func (s *ConsoleEmailService) SendPasswordChangedEmail(ctx context.Context, to,
 	fmt.Println("========================================")
 	return nil
 }
+
+// SendReportStatusChangedEmail prints the report status change notification to console
+func (s *ConsoleEmailService) SendReportStatusChangedEmail(ctx context.Context, to, name, reportTitle, status, reportID string) error {
+	fmt.Println("========================================")
+	fmt.Println("📧 REPORT STATUS CHANGED EMAIL (Console)")
+	fmt.Println("========================================")
+	fmt.Printf("To: %s <%s>\n", name, to)
+	fmt.Println("Subject: Your Report Status Was Updated")
+	fmt.Println("----------------------------------------")
+	fmt.Printf("Hi %s,\n\n", name)
+	fmt.Printf("Your report \"%s\" (%s) is now %s.\n", reportTitle, reportID, status)
+	fmt.Println("========================================")
+	return nil
+}
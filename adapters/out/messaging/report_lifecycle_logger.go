@@ -0,0 +1,55 @@
+package messaging
+
+import (
+	"context"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// reportLifecycleMessage is the fixed message of lifecycle log lines; pipelines select them
+// by it and tell events apart by the "event" field
+const reportLifecycleMessage = "Report lifecycle event"
+
+// SubscribeReportLifecycleLogger logs every report lifecycle event (created, status changed,
+// deleted) at INFO level with stable fields: event, report_id, actor_id and subdistrict_code.
+// The request ID is taken from the publishing context
+func SubscribeReportLifecycleLogger(bus external.EventBus) {
+	bus.Subscribe(entities.EventReportCreated, logReportLifecycleEvent)
+	bus.Subscribe(entities.EventReportStatusChanged, logReportLifecycleEvent)
+	bus.Subscribe(entities.EventReportDeleted, logReportLifecycleEvent)
+}
+
+// logReportLifecycleEvent writes the lifecycle log line for a single event
+func logReportLifecycleEvent(ctx context.Context, event entities.DomainEvent) error {
+	fields := map[string]interface{}{
+		"event": event.EventName(),
+	}
+
+	switch e := event.(type) {
+	case entities.ReportCreatedEvent:
+		addReportFields(fields, e.Report)
+		fields["actor_id"] = e.Report.AuthorID.String()
+		fields["status"] = e.Report.Status.String()
+	case entities.ReportStatusChangedEvent:
+		addReportFields(fields, e.Report)
+		fields["actor_id"] = e.Change.ChangedBy.String()
+		fields["from_status"] = e.Change.FromStatus.String()
+		fields["to_status"] = e.Change.ToStatus.String()
+	case entities.ReportDeletedEvent:
+		addReportFields(fields, e.Report)
+		fields["actor_id"] = e.DeletedBy.String()
+	default:
+		return nil
+	}
+
+	logger.InfoContext(ctx, reportLifecycleMessage, fields)
+	return nil
+}
+
+// addReportFields adds the fields shared by every lifecycle event
+func addReportFields(fields map[string]interface{}, report *entities.DamagedRoad) {
+	fields["report_id"] = report.ID.String()
+	fields["subdistrict_code"] = report.SubDistrictCode.String()
+}
@@ -0,0 +1,227 @@
+package messaging
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/pkg/email"
+)
+
+// Default SMTP timeouts, short enough that a request sending mail cannot hang on an unreachable server
+const (
+	defaultSMTPDialTimeout = 5 * time.Second
+	defaultSMTPSendTimeout = 15 * time.Second
+)
+
+// SMTPConfig holds the connection settings of the SMTP email service
+type SMTPConfig struct {
+	Host      string
+	Port      int
+	Username  string // empty disables authentication
+	Password  string
+	FromName  string
+	FromEmail string
+	// DialTimeout bounds establishing the TCP connection
+	DialTimeout time.Duration
+	// SendTimeout bounds the whole SMTP conversation, from greeting to QUIT
+	SendTimeout time.Duration
+}
+
+// SMTPEmailService implements EmailService by sending multipart text and HTML emails over SMTP.
+// The connection is always upgraded with STARTTLS; servers that do not offer it are refused
+// so credentials and reset tokens are never sent in clear text.
+type SMTPEmailService struct {
+	config    SMTPConfig
+	from      mail.Address
+	templates *email.Renderer
+}
+
+// NewSMTPEmailService creates a new SMTP-based email service rendering its emails with templates
+func NewSMTPEmailService(config SMTPConfig, templates *email.Renderer) external.EmailService {
+	if config.DialTimeout <= 0 {
+		config.DialTimeout = defaultSMTPDialTimeout
+	}
+	if config.SendTimeout <= 0 {
+		config.SendTimeout = defaultSMTPSendTimeout
+	}
+
+	return &SMTPEmailService{
+		config:    config,
+		from:      mail.Address{Name: config.FromName, Address: config.FromEmail},
+		templates: templates,
+	}
+}
+
+// SendPasswordResetEmail sends the password reset email with a link carrying the reset token
+func (s *SMTPEmailService) SendPasswordResetEmail(ctx context.Context, to, name, resetToken string) error {
+	message, err := s.templates.PasswordReset(name, resetToken)
+	if err != nil {
+		return err
+	}
+	return s.send(ctx, to, name, message)
+}
+
+// SendWelcomeEmail sends the welcome email to a newly registered user
+func (s *SMTPEmailService) SendWelcomeEmail(ctx context.Context, to, name string) error {
+	message, err := s.templates.Welcome(name)
+	if err != nil {
+		return err
+	}
+	return s.send(ctx, to, name, message)
+}
+
+// SendPasswordChangedEmail sends the password changed notification
+func (s *SMTPEmailService) SendPasswordChangedEmail(ctx context.Context, to, name string) error {
+	message, err := s.templates.PasswordChanged(name)
+	if err != nil {
+		return err
+	}
+	return s.send(ctx, to, name, message)
+}
+
+// SendReportStatusChangedEmail notifies a report's author that its status changed
+func (s *SMTPEmailService) SendReportStatusChangedEmail(ctx context.Context, to, name, reportTitle, status, reportID string) error {
+	message, err := s.templates.ReportStatusChanged(name, reportTitle, status, reportID)
+	if err != nil {
+		return err
+	}
+	return s.send(ctx, to, name, message)
+}
+
+// send delivers a multipart/alternative message with a plain-text and an HTML part
+func (s *SMTPEmailService) send(ctx context.Context, to, name string, content *email.Message) error {
+	recipient := mail.Address{Name: name, Address: to}
+	message, err := s.buildMessage(recipient, content)
+	if err != nil {
+		return fmt.Errorf("failed to build email: %w", err)
+	}
+
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+	dialer := &net.Dialer{Timeout: s.config.DialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server %s: %w", addr, err)
+	}
+
+	deadline := time.Now().Add(s.config.SendTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to set SMTP deadline: %w", err)
+	}
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); !ok {
+		return fmt.Errorf("SMTP server %s does not support STARTTLS", addr)
+	}
+	if err := client.StartTLS(&tls.Config{ServerName: s.config.Host}); err != nil {
+		return fmt.Errorf("SMTP STARTTLS failed: %w", err)
+	}
+
+	if s.config.Username != "" {
+		auth := smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+
+	if err := client.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("SMTP MAIL FROM failed: %w", err)
+	}
+	if err := client.Rcpt(recipient.Address); err != nil {
+		return fmt.Errorf("SMTP RCPT TO failed: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA failed: %w", err)
+	}
+	if _, err := writer.Write(message); err != nil {
+		writer.Close()
+		return fmt.Errorf("failed to write email body: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("SMTP server rejected the email: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// buildMessage renders the headers and the quoted-printable text and HTML alternatives
+func (s *SMTPEmailService) buildMessage(recipient mail.Address, content *email.Message) ([]byte, error) {
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+
+	for _, alternative := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", content.Text},
+		{"text/html; charset=utf-8", content.HTML},
+	} {
+		part, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {alternative.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		encoder := quotedprintable.NewWriter(part)
+		if _, err := encoder.Write([]byte(alternative.content)); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %s\r\n", s.from.String())
+	fmt.Fprintf(&message, "To: %s\r\n", recipient.String())
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", content.Subject))
+	fmt.Fprintf(&message, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&message, "Message-ID: %s\r\n", s.messageID())
+	message.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&message, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", parts.Boundary())
+	message.Write(body.Bytes())
+
+	return message.Bytes(), nil
+}
+
+// messageID generates a unique Message-ID in the sender's domain
+func (s *SMTPEmailService) messageID() string {
+	random := make([]byte, 16)
+	_, _ = rand.Read(random)
+
+	domain := s.config.Host
+	if at := strings.LastIndex(s.from.Address, "@"); at >= 0 {
+		domain = s.from.Address[at+1:]
+	}
+	return fmt.Sprintf("<%d.%s@%s>", time.Now().UnixNano(), hex.EncodeToString(random), domain)
+}
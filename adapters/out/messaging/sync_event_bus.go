@@ -0,0 +1,63 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// SyncEventBus implements EventBus by calling subscribers in-process, in registration order,
+// before Publish returns. A failing or panicking subscriber is logged and does not affect
+// the publisher or the remaining subscribers.
+type SyncEventBus struct {
+	mu       sync.RWMutex
+	handlers map[string][]external.EventHandler
+}
+
+// NewSyncEventBus creates a new synchronous in-process event bus
+func NewSyncEventBus() external.EventBus {
+	return &SyncEventBus{
+		handlers: make(map[string][]external.EventHandler),
+	}
+}
+
+// Subscribe registers a handler for events with the given name
+func (b *SyncEventBus) Subscribe(eventName string, handler external.EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventName] = append(b.handlers[eventName], handler)
+}
+
+// Publish delivers the event to every handler subscribed to its name
+func (b *SyncEventBus) Publish(ctx context.Context, event entities.DomainEvent) {
+	b.mu.RLock()
+	handlers := b.handlers[event.EventName()]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.dispatch(ctx, event, handler)
+	}
+}
+
+// dispatch runs a single handler, containing its errors and panics
+func (b *SyncEventBus) dispatch(ctx context.Context, event entities.DomainEvent, handler external.EventHandler) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.ErrorContext(ctx, "Event handler panicked", map[string]interface{}{
+				"event": event.EventName(),
+				"panic": fmt.Sprint(r),
+			})
+		}
+	}()
+
+	if err := handler(ctx, event); err != nil {
+		logger.WarnContext(ctx, "Event handler failed", map[string]interface{}{
+			"event": event.EventName(),
+			"error": err.Error(),
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package messaging
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+func TestSyncEventBusDeliversToSubscribers(t *testing.T) {
+	bus := NewSyncEventBus()
+	report := &entities.DamagedRoad{ID: uuid.New()}
+
+	var received []string
+	bus.Subscribe(entities.EventReportCreated, func(ctx context.Context, event entities.DomainEvent) error {
+		created, ok := event.(entities.ReportCreatedEvent)
+		if !ok || created.Report.ID != report.ID {
+			t.Errorf("subscriber got %#v, want the created report", event)
+		}
+		received = append(received, "first")
+		return nil
+	})
+	bus.Subscribe(entities.EventReportDeleted, func(ctx context.Context, event entities.DomainEvent) error {
+		received = append(received, "deleted")
+		return nil
+	})
+	bus.Subscribe(entities.EventReportCreated, func(ctx context.Context, event entities.DomainEvent) error {
+		received = append(received, "second")
+		return nil
+	})
+
+	bus.Publish(context.Background(), entities.NewReportCreatedEvent(report))
+
+	if len(received) != 2 || received[0] != "first" || received[1] != "second" {
+		t.Errorf("received = %v, want both report.created subscribers in registration order", received)
+	}
+}
+
+func TestSyncEventBusContainsFailingSubscribers(t *testing.T) {
+	bus := NewSyncEventBus()
+
+	bus.Subscribe(entities.EventReportCreated, func(ctx context.Context, event entities.DomainEvent) error {
+		return stderrors.New("webhook unreachable")
+	})
+	bus.Subscribe(entities.EventReportCreated, func(ctx context.Context, event entities.DomainEvent) error {
+		panic("notifier bug")
+	})
+	delivered := false
+	bus.Subscribe(entities.EventReportCreated, func(ctx context.Context, event entities.DomainEvent) error {
+		delivered = true
+		return nil
+	})
+
+	bus.Publish(context.Background(), entities.NewReportCreatedEvent(&entities.DamagedRoad{ID: uuid.New()}))
+
+	if !delivered {
+		t.Error("a subscriber after a failing and a panicking one was not called")
+	}
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+)
+
+// AdminAuditLogRepository implements the AdminAuditLogRepository interface using PostgreSQL
+type AdminAuditLogRepository struct {
+	db *sql.DB
+}
+
+// NewAdminAuditLogRepository creates a new PostgreSQL AdminAuditLogRepository
+func NewAdminAuditLogRepository(db *sql.DB) external.AdminAuditLogRepository {
+	return &AdminAuditLogRepository{
+		db: db,
+	}
+}
+
+// Create creates a new admin audit log entry
+func (r *AdminAuditLogRepository) Create(ctx context.Context, entry *entities.AdminAuditEntry) error {
+	query := `
+		INSERT INTO admin_audit_log (id, admin_id, action, target, method, path, status_code, ip_address, user_agent, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		entry.ID,
+		entry.AdminID,
+		entry.Action,
+		sql.NullString{String: entry.Target, Valid: entry.Target != ""},
+		entry.Method,
+		entry.Path,
+		entry.StatusCode,
+		entry.IPAddress,
+		entry.UserAgent,
+		entry.CreatedAt,
+	)
+	return err
+}
@@ -83,6 +83,62 @@ func (r *AuthEventLogRepository) FindByUserID(ctx context.Context, userID uuid.U
 	return logs, rows.Err()
 }
 
+// StreamByUserID iterates over all auth event logs for a user, oldest first, invoking fn
+// for each row as it is scanned
+func (r *AuthEventLogRepository) StreamByUserID(ctx context.Context, userID uuid.UUID, fn func(*entities.AuthEventLog) error) error {
+	query := `
+		SELECT id, user_id, event_type, ip_address, user_agent, success, created_at
+		FROM auth_event_logs
+		WHERE user_id = $1
+		ORDER BY created_at ASC
+	`
+
+	// The cursor stays open for the whole export, past the default statement timeout
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := liftStatementTimeout(ctx, tx); err != nil {
+		return err
+	}
+
+	rows, err := tx.QueryContext(ctx, query, userID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		log := &entities.AuthEventLog{}
+		var userIDNull sql.NullString
+
+		err := rows.Scan(
+			&log.ID,
+			&userIDNull,
+			&log.EventType,
+			&log.IPAddress,
+			&log.UserAgent,
+			&log.Success,
+			&log.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		if userIDNull.Valid {
+			uid, _ := uuid.Parse(userIDNull.String)
+			log.UserID = &uid
+		}
+
+		if err := fn(log); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
 // FindFailedLoginAttempts retrieves recent failed login attempts by IP address
 func (r *AuthEventLogRepository) FindFailedLoginAttempts(ctx context.Context, ipAddress string, limit int) ([]*entities.AuthEventLog, error) {
 	query := `
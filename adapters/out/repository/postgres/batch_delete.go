@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// execer runs statements; satisfied by *sql.DB, *sqlx.DB and their transactions
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// deleteExpiredInBatches deletes the rows of table whose expires_at has passed, at most batchSize
+// rows per statement, until none remain. Short statements keep row locks brief when a large backlog
+// has built up. A batchSize of 0 or less deletes everything in one statement.
+// table must be a constant table name, never user input
+func deleteExpiredInBatches(ctx context.Context, db execer, table string, batchSize int) (int64, error) {
+	if batchSize <= 0 {
+		result, err := db.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s WHERE expires_at < NOW()`, table))
+		if err != nil {
+			return 0, err
+		}
+		return result.RowsAffected()
+	}
+
+	query := fmt.Sprintf(`
+		DELETE FROM %[1]s
+		WHERE id IN (
+			SELECT id FROM %[1]s
+			WHERE expires_at < NOW()
+			LIMIT $1
+		)
+	`, table)
+
+	var total int64
+	for {
+		result, err := db.ExecContext(ctx, query, batchSize)
+		if err != nil {
+			return total, err
+		}
+		removed, err := result.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += removed
+		if removed < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// countingExecer simulates a table holding expired rows: each batched DELETE removes up to
+// its LIMIT argument, and a DELETE without one removes everything
+type countingExecer struct {
+	expired    int64
+	statements int
+}
+
+func (e *countingExecer) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e.statements++
+	removed := e.expired
+	if strings.Contains(query, "LIMIT $1") {
+		removed = min(removed, int64(args[0].(int)))
+	}
+	e.expired -= removed
+	return driverResult(removed), nil
+}
+
+// driverResult is an sql.Result reporting a fixed number of affected rows
+type driverResult int64
+
+func (r driverResult) LastInsertId() (int64, error) { return 0, nil }
+func (r driverResult) RowsAffected() (int64, error) { return int64(r), nil }
+
+func TestDeleteExpiredInBatches(t *testing.T) {
+	tests := []struct {
+		name           string
+		expired        int64
+		batchSize      int
+		wantStatements int
+	}{
+		{name: "backlog across batches", expired: 2500, batchSize: 1000, wantStatements: 3},
+		{name: "exact multiple needs an empty batch to finish", expired: 2000, batchSize: 1000, wantStatements: 3},
+		{name: "nothing expired", expired: 0, batchSize: 1000, wantStatements: 1},
+		{name: "batching off", expired: 2500, batchSize: 0, wantStatements: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &countingExecer{expired: tt.expired}
+			removed, err := deleteExpiredInBatches(context.Background(), db, "refresh_tokens", tt.batchSize)
+			if err != nil {
+				t.Fatalf("deleteExpiredInBatches() error = %v", err)
+			}
+			if removed != tt.expired || db.expired != 0 {
+				t.Errorf("removed %d, %d left, want all %d removed", removed, db.expired, tt.expired)
+			}
+			if db.statements != tt.wantStatements {
+				t.Errorf("ran %d statements, want %d", db.statements, tt.wantStatements)
+			}
+		})
+	}
+}
+
+func TestRefreshTokenDeleteExpiredAcrossBatches(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewRefreshTokenRepository(db.DB)
+	userID := insertTestUser(t, db)
+
+	// 25 expired tokens and one still valid, deleted in batches of 10
+	const expired = 25
+	for i := 0; i <= expired; i++ {
+		expiresAt := time.Now().Add(-time.Hour)
+		if i == expired {
+			expiresAt = time.Now().Add(time.Hour)
+		}
+		id := uuid.New()
+		_, err := db.Exec(`INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, family_id) VALUES ($1, $2, $3, $4, $1)`,
+			id, userID, "hash-"+id.String(), expiresAt)
+		if err != nil {
+			t.Fatalf("insert refresh token error = %v", err)
+		}
+	}
+
+	// Other tests may leave expired tokens behind, so at least ours are removed
+	removed, err := repo.DeleteExpired(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("DeleteExpired() error = %v", err)
+	}
+	if removed < expired {
+		t.Errorf("DeleteExpired() removed %d tokens, want at least %d", removed, expired)
+	}
+
+	var remaining int
+	if err := db.Get(&remaining, `SELECT COUNT(*) FROM refresh_tokens WHERE user_id = $1`, userID); err != nil {
+		t.Fatalf("count tokens error = %v", err)
+	}
+	if remaining != 1 {
+		t.Errorf("%d tokens left for the user, want only the valid one", remaining)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
 	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
 	"github.com/nicklaros/jalanrusak-be/core/ports/external"
@@ -22,9 +23,7 @@ func NewBoundaryRepository(db *sqlx.DB) external.BoundaryRepository {
 }
 
 // GetCentroid retrieves the geographic centroid for a given subdistrict code.
-func (r *boundaryRepository) GetCentroid(subDistrictCode entities.SubDistrictCode) (entities.Point, error) {
-	ctx := context.Background()
-
+func (r *boundaryRepository) GetCentroid(ctx context.Context, subDistrictCode entities.SubDistrictCode) (entities.Point, error) {
 	var result struct {
 		Lat float64 `db:"centroid_lat"`
 		Lng float64 `db:"centroid_lng"`
@@ -53,9 +52,7 @@ func (r *boundaryRepository) GetCentroid(subDistrictCode entities.SubDistrictCod
 }
 
 // CheckSubDistrictExists verifies if a subdistrict code exists in the official dataset.
-func (r *boundaryRepository) CheckSubDistrictExists(subDistrictCode entities.SubDistrictCode) (bool, error) {
-	ctx := context.Background()
-
+func (r *boundaryRepository) CheckSubDistrictExists(ctx context.Context, subDistrictCode entities.SubDistrictCode) (bool, error) {
 	var exists bool
 	query := `SELECT EXISTS(SELECT 1 FROM subdistrict_centroids WHERE subdistrict_code = $1)`
 
@@ -67,10 +64,30 @@ func (r *boundaryRepository) CheckSubDistrictExists(subDistrictCode entities.Sub
 	return exists, nil
 }
 
-// StoreCentroid stores centroid data for a subdistrict (for data seeding/updates).
-func (r *boundaryRepository) StoreCentroid(subDistrictCode entities.SubDistrictCode, centroid entities.Point) error {
-	ctx := context.Background()
+// FindExistingSubDistricts returns which of the given codes exist in the official dataset.
+func (r *boundaryRepository) FindExistingSubDistricts(ctx context.Context, subDistrictCodes []entities.SubDistrictCode) ([]entities.SubDistrictCode, error) {
+	codes := make(pq.StringArray, len(subDistrictCodes))
+	for i, code := range subDistrictCodes {
+		codes[i] = string(code)
+	}
+
+	var found []string
+	query := `SELECT subdistrict_code FROM subdistrict_centroids WHERE subdistrict_code = ANY($1)`
 
+	if err := r.db.SelectContext(ctx, &found, query, codes); err != nil {
+		return nil, fmt.Errorf("failed to check subdistrict existence: %w", err)
+	}
+
+	existing := make([]entities.SubDistrictCode, len(found))
+	for i, code := range found {
+		existing[i] = entities.SubDistrictCode(code)
+	}
+
+	return existing, nil
+}
+
+// StoreCentroid stores centroid data for a subdistrict (for data seeding/updates).
+func (r *boundaryRepository) StoreCentroid(ctx context.Context, subDistrictCode entities.SubDistrictCode, centroid entities.Point) error {
 	query := `
 		INSERT INTO subdistrict_centroids (subdistrict_code, centroid_lat, centroid_lng, name)
 		VALUES ($1, $2, $3, $4)
@@ -91,3 +108,15 @@ func (r *boundaryRepository) StoreCentroid(subDistrictCode entities.SubDistrictC
 
 	return nil
 }
+
+// HasBoundaryData reports whether the subdistrict_centroids table has been seeded.
+func (r *boundaryRepository) HasBoundaryData(ctx context.Context) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS(SELECT 1 FROM subdistrict_centroids)`
+
+	if err := r.db.GetContext(ctx, &exists, query); err != nil {
+		return false, fmt.Errorf("failed to check boundary dataset: %w", err)
+	}
+
+	return exists, nil
+}
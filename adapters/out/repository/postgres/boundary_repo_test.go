@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+func TestFindExistingSubDistrictsMixedCodes(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewBoundaryRepository(db)
+	ctx := context.Background()
+
+	// A code from the unused 99 regency, so seeded data is left alone
+	const seeded entities.SubDistrictCode = "99.99.01.0001"
+	if err := repo.StoreCentroid(ctx, seeded, entities.Point{Lat: -7.2575, Lng: 112.7521}); err != nil {
+		t.Fatalf("StoreCentroid() error = %v", err)
+	}
+	t.Cleanup(func() { db.Exec(`DELETE FROM subdistrict_centroids WHERE subdistrict_code = $1`, string(seeded)) })
+
+	existing, err := repo.FindExistingSubDistricts(ctx, []entities.SubDistrictCode{seeded, "99.99.02.0002"})
+	if err != nil {
+		t.Fatalf("FindExistingSubDistricts() error = %v", err)
+	}
+	if len(existing) != 1 || existing[0] != seeded {
+		t.Errorf("FindExistingSubDistricts() = %v, want only %s", existing, seeded)
+	}
+}
+
+func TestGetCentroidHonorsCancelledContext(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewBoundaryRepository(db)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := repo.GetCentroid(ctx, "35.78.01.1001"); !stderrors.Is(err, context.Canceled) {
+		t.Errorf("GetCentroid() error = %v, want context.Canceled", err)
+	}
+}
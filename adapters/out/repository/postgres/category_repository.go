@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+)
+
+// CategoryRepository implements the CategoryRepository interface using PostgreSQL
+type CategoryRepository struct {
+	db *sqlx.DB
+}
+
+// NewCategoryRepository creates a new PostgreSQL category repository
+func NewCategoryRepository(db *sqlx.DB) external.CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+// categoryRow represents the database row structure
+type categoryRow struct {
+	ID          string         `db:"id"`
+	Name        string         `db:"name"`
+	Description sql.NullString `db:"description"`
+	SortOrder   int            `db:"sort_order"`
+	CreatedAt   time.Time      `db:"created_at"`
+}
+
+// FindAll retrieves every category ordered by sort order, then name
+func (r *CategoryRepository) FindAll(ctx context.Context) ([]*entities.Category, error) {
+	query := `
+		SELECT id, name, description, sort_order, created_at
+		FROM report_categories
+		ORDER BY sort_order ASC, name ASC
+	`
+
+	var rows []categoryRow
+	if err := r.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, errors.NewDatabaseError("find categories", err)
+	}
+
+	categories := make([]*entities.Category, len(rows))
+	for i, row := range rows {
+		category := &entities.Category{
+			ID:        row.ID,
+			Name:      row.Name,
+			SortOrder: row.SortOrder,
+			CreatedAt: row.CreatedAt,
+		}
+		if row.Description.Valid {
+			description := row.Description.String
+			category.Description = &description
+		}
+		categories[i] = category
+	}
+	return categories, nil
+}
+
+// Exists checks whether a category with the given ID exists
+func (r *CategoryRepository) Exists(ctx context.Context, id string) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS(SELECT 1 FROM report_categories WHERE id = $1)`
+	if err := r.db.GetContext(ctx, &exists, query, id); err != nil {
+		return false, errors.NewDatabaseError("check category exists", err)
+	}
+	return exists, nil
+}
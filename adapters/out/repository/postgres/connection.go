@@ -1,7 +1,9 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -21,13 +23,27 @@ type ConnectionConfig struct {
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+	// StatementTimeout makes the server cancel any single statement running longer than this,
+	// so a runaway query cannot hang a worker even when its context has no deadline. 0 disables;
+	// values under a millisecond are rejected. Streaming reads lift it, see liftStatementTimeout
+	StatementTimeout time.Duration
+	// RequirePostGIS fails the connection when the PostGIS extension is unavailable.
+	// When false the failure is only logged, for servers running without geospatial features
+	RequirePostGIS bool
 }
 
+// ErrPostGISUnavailable is returned by NewConnection when PostGIS is required but missing
+var ErrPostGISUnavailable = errors.New("PostGIS extension is unavailable: install PostGIS on the database server " +
+	"(or grant CREATE on the database so the extension can be enabled), or set GEOMETRY_BACKEND=none " +
+	"to start without geospatial features")
+
 // NewConnection creates a new PostgreSQL connection pool with PostGIS support
 func NewConnection(config ConnectionConfig) (*sqlx.DB, error) {
-	// Build connection string
+	// Build connection string. The UTC session time zone makes timestamps read back in UTC
+	// regardless of the database server's zone, and NOW() defaults of columns without a time
+	// zone store UTC wall time
 	dsn := fmt.Sprintf(
-		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s timezone=UTC",
 		config.Host,
 		config.Port,
 		config.User,
@@ -36,6 +52,15 @@ func NewConnection(config ConnectionConfig) (*sqlx.DB, error) {
 		config.SSLMode,
 	)
 
+	// lib/pq forwards unknown parameters as run-time settings, applying this to every pooled connection
+	timeout, err := statementTimeoutMillis(config.StatementTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if timeout > 0 {
+		dsn += fmt.Sprintf(" statement_timeout=%d", timeout)
+	}
+
 	// Open database connection
 	db, err := sqlx.Connect("postgres", dsn)
 	if err != nil {
@@ -53,14 +78,49 @@ func NewConnection(config ConnectionConfig) (*sqlx.DB, error) {
 	}
 
 	// Enable PostGIS extension if not already enabled
-	if err := ensurePostGIS(db.DB); err != nil {
-		logger.Warn(fmt.Sprintf("PostGIS extension check failed (may already exist): %v", err))
+	if err := checkPostGIS(config.RequirePostGIS, ensurePostGIS(db.DB)); err != nil {
+		db.Close()
+		return nil, err
 	}
 
 	logger.Info("Database connection established successfully")
 	return db, nil
 }
 
+// checkPostGIS turns a failed PostGIS check into ErrPostGISUnavailable when PostGIS is required,
+// and into a warning when geospatial features are disabled
+func checkPostGIS(required bool, checkErr error) error {
+	if checkErr == nil {
+		return nil
+	}
+	if required {
+		return fmt.Errorf("%w (%v)", ErrPostGISUnavailable, checkErr)
+	}
+	logger.Warn(fmt.Sprintf("PostGIS extension check failed, geospatial features are disabled: %v", checkErr))
+	return nil
+}
+
+// statementTimeoutMillis converts a statement timeout to the milliseconds PostgreSQL expects.
+// A positive timeout under a millisecond would round down to 0, which turns the timeout off,
+// so it is rejected instead
+func statementTimeoutMillis(timeout time.Duration) (int64, error) {
+	if timeout < 0 {
+		return 0, fmt.Errorf("statement timeout cannot be negative: %s", timeout)
+	}
+	if timeout > 0 && timeout < time.Millisecond {
+		return 0, fmt.Errorf("statement timeout must be 0 or at least 1ms: %s", timeout)
+	}
+	return timeout.Milliseconds(), nil
+}
+
+// liftStatementTimeout turns the pool's statement_timeout off for the rest of tx. Streaming
+// reads (exports, admin stream=true listings) run as long as the client keeps reading, like
+// the request timeout they are exempt from; cancelling ctx still stops them when the client leaves
+func liftStatementTimeout(ctx context.Context, tx execer) error {
+	_, err := tx.ExecContext(ctx, "SET LOCAL statement_timeout = 0")
+	return err
+}
+
 // ensurePostGIS ensures PostGIS extension is enabled
 func ensurePostGIS(db *sql.DB) error {
 	// Try to create PostGIS extension (will fail silently if already exists)
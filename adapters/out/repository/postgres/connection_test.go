@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	stderrors "errors"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// queryCanceledCode is the SQLSTATE PostgreSQL reports for a statement cancelled by statement_timeout
+const queryCanceledCode = "57014"
+
+func TestStatementTimeoutMillis(t *testing.T) {
+	tests := []struct {
+		timeout time.Duration
+		want    int64
+		wantErr bool
+	}{
+		{timeout: 0, want: 0},
+		{timeout: 30 * time.Second, want: 30000},
+		{timeout: time.Millisecond, want: 1},
+		{timeout: 1500 * time.Microsecond, want: 1},
+		{timeout: 500 * time.Microsecond, wantErr: true}, // would round down to 0, disabling the timeout
+		{timeout: time.Nanosecond, wantErr: true},
+		{timeout: -time.Second, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := statementTimeoutMillis(tt.timeout)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("statementTimeoutMillis(%s) error = %v, wantErr %v", tt.timeout, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("statementTimeoutMillis(%s) = %d, want %d", tt.timeout, got, tt.want)
+		}
+	}
+}
+
+// connectTestDB connects to the database named by the TEST_DB_* variables with the given
+// statement timeout, skipping the test when TEST_DB_HOST is unset
+func connectTestDB(t *testing.T, statementTimeout time.Duration) *sqlx.DB {
+	t.Helper()
+	host := os.Getenv("TEST_DB_HOST")
+	if host == "" {
+		t.Skip("TEST_DB_HOST not set; skipping database test")
+	}
+	port, err := strconv.Atoi(os.Getenv("TEST_DB_PORT"))
+	if err != nil {
+		port = 5432
+	}
+	sslMode := os.Getenv("TEST_DB_SSLMODE")
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+
+	db, err := NewConnection(ConnectionConfig{
+		Host:             host,
+		Port:             port,
+		User:             os.Getenv("TEST_DB_USER"),
+		Password:         os.Getenv("TEST_DB_PASSWORD"),
+		DBName:           os.Getenv("TEST_DB_NAME"),
+		SSLMode:          sslMode,
+		MaxOpenConns:     2,
+		MaxIdleConns:     2,
+		StatementTimeout: statementTimeout,
+	})
+	if err != nil {
+		t.Fatalf("NewConnection() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestStatementTimeoutCancelsSlowQuery(t *testing.T) {
+	db := connectTestDB(t, 100*time.Millisecond)
+
+	// No deadline on the context: only the server-side timeout can stop the query
+	_, err := db.ExecContext(context.Background(), "SELECT pg_sleep(2)")
+
+	var pqErr *pq.Error
+	if !stderrors.As(err, &pqErr) || pqErr.Code != queryCanceledCode {
+		t.Fatalf("slow query error = %v, want it cancelled by statement_timeout", err)
+	}
+}
+
+func TestLiftStatementTimeoutLetsStreamingQueriesRun(t *testing.T) {
+	db := connectTestDB(t, 100*time.Millisecond)
+	ctx := context.Background()
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTxx() error = %v", err)
+	}
+	defer tx.Rollback()
+	if err := liftStatementTimeout(ctx, tx); err != nil {
+		t.Fatalf("liftStatementTimeout() error = %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, "SELECT pg_sleep(0.3)"); err != nil {
+		t.Errorf("query inside the lifted transaction error = %v, want it to outlast the pool timeout", err)
+	}
+	tx.Rollback()
+
+	// SET LOCAL ends with the transaction, so the pool's timeout applies again afterwards
+	if _, err := db.ExecContext(ctx, "SELECT pg_sleep(0.3)"); err == nil {
+		t.Error("query after the transaction ran past the pool's statement timeout")
+	}
+}
+
+func TestCheckPostGIS(t *testing.T) {
+	missing := stderrors.New(`function postgis_version() does not exist`)
+
+	if err := checkPostGIS(true, missing); !stderrors.Is(err, ErrPostGISUnavailable) {
+		t.Errorf("checkPostGIS(required, missing) = %v, want ErrPostGISUnavailable", err)
+	}
+	if err := checkPostGIS(false, missing); err != nil {
+		t.Errorf("checkPostGIS(GEOMETRY_BACKEND=none, missing) = %v, want the server to start", err)
+	}
+	if err := checkPostGIS(true, nil); err != nil {
+		t.Errorf("checkPostGIS(required, available) = %v, want nil", err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -24,18 +26,33 @@ func NewDamagedRoadRepository(db *sqlx.DB) external.DamagedRoadRepository {
 	return &DamagedRoadRepository{db: db}
 }
 
-// damagedRoadRow represents the database row structure
+// damagedRoadRow represents the database row structure.
+// The timestamp columns are NOT NULL, so they scan into time.Time: a NULL would fail the
+// scan instead of silently becoming the zero time
 type damagedRoadRow struct {
-	ID              uuid.UUID      `db:"id"`
-	Title           string         `db:"title"`
-	SubDistrictCode string         `db:"subdistrict_code"`
-	Path            string         `db:"path"` // PostGIS geometry as text
-	Description     sql.NullString `db:"description"`
-	PhotoURLs       pq.StringArray `db:"photo_urls"`
-	AuthorID        uuid.UUID      `db:"author_id"`
-	Status          string         `db:"status"`
-	CreatedAt       sql.NullTime   `db:"created_at"`
-	UpdatedAt       sql.NullTime   `db:"updated_at"`
+	ID                  uuid.UUID      `db:"id"`
+	Title               string         `db:"title"`
+	SubDistrictCode     string         `db:"subdistrict_code"`
+	Path                string         `db:"path"` // PostGIS geometry as text
+	Description         sql.NullString `db:"description"`
+	PhotoURLs           pq.StringArray `db:"photo_urls"`
+	ResolutionPhotoURLs pq.StringArray `db:"resolution_photo_urls"`
+	AuthorID            uuid.UUID      `db:"author_id"`
+	Status              string         `db:"status"`
+	CreatedAt           time.Time      `db:"created_at"`
+	UpdatedAt           time.Time      `db:"updated_at"`
+	StatusChangedAt     time.Time      `db:"status_changed_at"`
+	CategoryID          sql.NullString `db:"category_id"`
+	DeletedAt           sql.NullTime   `db:"deleted_at"`
+
+	// Author columns come from a LEFT JOIN and are NULL when the user row is gone
+	AuthorName  sql.NullString `db:"author_name"`
+	AuthorRole  sql.NullString `db:"author_role"`
+	AuthorEmail sql.NullString `db:"author_email"`
+
+	// Projected columns are only selected when a read asks for another CRS, see projectedPathColumns
+	ProjectedPath sql.NullString `db:"projected_path"`
+	ProjectedSRID sql.NullInt64  `db:"projected_srid"`
 }
 
 // toEntity converts a database row to an entity
@@ -74,15 +91,61 @@ func (row *damagedRoadRow) toEntity() (*entities.DamagedRoad, error) {
 		PhotoURLs:       row.PhotoURLs,
 		AuthorID:        row.AuthorID,
 		Status:          entities.Status(row.Status),
-		CreatedAt:       row.CreatedAt.Time,
-		UpdatedAt:       row.UpdatedAt.Time,
+		CreatedAt:       row.CreatedAt,
+		UpdatedAt:       row.UpdatedAt,
+		StatusChangedAt: row.StatusChangedAt,
+
+		ResolutionPhotoURLs: row.ResolutionPhotoURLs,
+	}
+
+	if row.CategoryID.Valid {
+		categoryID := row.CategoryID.String
+		road.CategoryID = &categoryID
+	}
+
+	if row.DeletedAt.Valid {
+		deletedAt := row.DeletedAt.Time
+		road.DeletedAt = &deletedAt
+	}
+
+	if row.AuthorName.Valid {
+		road.Author = &entities.ReportAuthor{
+			Name:  row.AuthorName.String,
+			Role:  row.AuthorRole.String,
+			Email: row.AuthorEmail.String,
+		}
+	}
+
+	if row.ProjectedPath.Valid {
+		var projected entities.Geometry
+		if err := json.Unmarshal([]byte(row.ProjectedPath.String), &projected); err != nil {
+			return nil, fmt.Errorf("failed to parse projected geometry: %w", err)
+		}
+		road.ProjectedPath = &projected
+		road.ProjectedSRID = int(row.ProjectedSRID.Int64)
 	}
 
 	return road, nil
 }
 
+// projectedPathColumns selects the path reprojected to srid with ST_Transform, or nothing when
+// srid is WGS84 (the stored CRS). srid is an int from the configured allowlist, never raw input
+func projectedPathColumns(srid int) string {
+	if srid == 0 || srid == entities.SRIDWGS84 {
+		return ""
+	}
+	return fmt.Sprintf(", ST_AsGeoJSON(ST_Transform(dr.path, %d)) AS projected_path, %d AS projected_srid", srid, srid)
+}
+
 // Create creates a new damaged road report
 func (r *DamagedRoadRepository) Create(ctx context.Context, road *entities.DamagedRoad) error {
+	return r.CreateAfterInterval(ctx, road, 0)
+}
+
+// CreateAfterInterval creates a report unless the author's previous one is more recent than
+// minInterval. The check and the insert share a transaction holding an advisory lock on the
+// author, so concurrent submissions by the same author are serialized
+func (r *DamagedRoadRepository) CreateAfterInterval(ctx context.Context, road *entities.DamagedRoad, minInterval time.Duration) error {
 	// Convert geometry to GeoJSON for PostGIS
 	geometryJSON, err := json.Marshal(road.Path)
 	if err != nil {
@@ -101,12 +164,18 @@ func (r *DamagedRoadRepository) Create(ctx context.Context, road *entities.Damag
 	}
 	defer tx.Rollback()
 
+	if minInterval > 0 {
+		if err := checkAuthorInterval(ctx, tx, road.AuthorID, minInterval); err != nil {
+			return err
+		}
+	}
+
 	// Insert the damaged road (without photo_urls column)
 	roadQuery := `
 		INSERT INTO damaged_roads (
-			id, title, subdistrict_code, path, description, author_id, status, created_at, updated_at
+			id, title, subdistrict_code, path, description, author_id, status, created_at, updated_at, status_changed_at, category_id
 		) VALUES (
-			$1, $2, $3, ST_GeomFromGeoJSON($4), $5, $6, $7, $8, $9
+			$1, $2, $3, ST_GeomFromGeoJSON($4), $5, $6, $7, $8, $9, $10, $11
 		)
 	`
 
@@ -120,6 +189,8 @@ func (r *DamagedRoadRepository) Create(ctx context.Context, road *entities.Damag
 		road.Status.String(),
 		road.CreatedAt,
 		road.UpdatedAt,
+		road.StatusChangedAt,
+		road.CategoryID,
 	)
 
 	if err != nil {
@@ -140,6 +211,20 @@ func (r *DamagedRoadRepository) Create(ctx context.Context, road *entities.Damag
 		}
 	}
 
+	// Record photos dropped during lenient validation so they can be audited later
+	if len(road.DroppedPhotos) > 0 {
+		droppedQuery := `
+			INSERT INTO damaged_road_photos (road_id, url, validation_status, validated_at, validation_error)
+			VALUES ($1, $2, 'invalid', NOW(), $3)
+		`
+		for _, dropped := range road.DroppedPhotos {
+			_, err = tx.ExecContext(ctx, droppedQuery, road.ID, dropped.URL, dropped.Reason)
+			if err != nil {
+				return errors.NewDatabaseError("insert dropped damaged road photo", err)
+			}
+		}
+	}
+
 	// Commit the transaction
 	if err = tx.Commit(); err != nil {
 		return errors.NewDatabaseError("commit transaction", err)
@@ -150,15 +235,23 @@ func (r *DamagedRoadRepository) Create(ctx context.Context, road *entities.Damag
 
 // FindByID retrieves a damaged road report by ID
 func (r *DamagedRoadRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.DamagedRoad, error) {
+	return r.FindByIDInSRID(ctx, id, entities.SRIDWGS84)
+}
+
+// FindByIDInSRID retrieves a damaged road report by ID with its path also reprojected to srid
+func (r *DamagedRoadRepository) FindByIDInSRID(ctx context.Context, id uuid.UUID, srid int) (*entities.DamagedRoad, error) {
 	query := `
 		SELECT 
-			id, title, subdistrict_code, 
-			ST_AsGeoJSON(path) as path,
-			description, 
-			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = $1) as photo_urls,
-			author_id, status, created_at, updated_at
-		FROM damaged_roads
-		WHERE id = $1
+			dr.id, dr.title, dr.subdistrict_code, 
+			ST_AsGeoJSON(dr.path) as path,
+			dr.description, 
+			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = $1 AND kind = 'evidence' AND validation_status <> 'invalid') as photo_urls,
+			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = $1 AND kind = 'resolution') as resolution_photo_urls,
+			dr.author_id, dr.status, dr.created_at, dr.updated_at, dr.status_changed_at, dr.category_id, dr.deleted_at,
+			u.name AS author_name, u.role AS author_role, u.email AS author_email` + projectedPathColumns(srid) + `
+		FROM damaged_roads dr
+		LEFT JOIN users u ON u.id = dr.author_id
+		WHERE dr.id = $1 AND dr.deleted_at IS NULL
 	`
 
 	var row damagedRoadRow
@@ -181,7 +274,7 @@ func (r *DamagedRoadRepository) FindByAuthor(
 ) ([]*entities.DamagedRoad, int, error) {
 	// Get total count
 	var total int
-	countQuery := `SELECT COUNT(*) FROM damaged_roads WHERE author_id = $1`
+	countQuery := `SELECT COUNT(*) FROM damaged_roads WHERE author_id = $1 AND deleted_at IS NULL`
 	if err := r.db.GetContext(ctx, &total, countQuery, authorID); err != nil {
 		return nil, 0, errors.NewDatabaseError("count reports by author", err)
 	}
@@ -192,10 +285,13 @@ func (r *DamagedRoadRepository) FindByAuthor(
 			dr.id, dr.title, dr.subdistrict_code,
 			ST_AsGeoJSON(dr.path) as path,
 			dr.description,
-			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id) as photo_urls,
-			dr.author_id, dr.status, dr.created_at, dr.updated_at
+			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id AND kind = 'evidence' AND validation_status <> 'invalid') as photo_urls,
+			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id AND kind = 'resolution') as resolution_photo_urls,
+			dr.author_id, dr.status, dr.created_at, dr.updated_at, dr.status_changed_at, dr.category_id, dr.deleted_at,
+			u.name AS author_name, u.role AS author_role, u.email AS author_email
 		FROM damaged_roads dr
-		WHERE dr.author_id = $1
+		LEFT JOIN users u ON u.id = dr.author_id
+		WHERE dr.author_id = $1 AND dr.deleted_at IS NULL
 		ORDER BY dr.created_at DESC
 		LIMIT $2 OFFSET $3
 	`
@@ -228,38 +324,22 @@ func (r *DamagedRoadRepository) List(
 			dr.id, dr.title, dr.subdistrict_code,
 			ST_AsGeoJSON(dr.path) as path,
 			dr.description,
-			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id) as photo_urls,
-			dr.author_id, dr.status, dr.created_at, dr.updated_at
+			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id AND kind = 'evidence' AND validation_status <> 'invalid') as photo_urls,
+			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id AND kind = 'resolution') as resolution_photo_urls,
+			dr.author_id, dr.status, dr.created_at, dr.updated_at, dr.status_changed_at, dr.category_id, dr.deleted_at,
+			u.name AS author_name, u.role AS author_role, u.email AS author_email` + projectedPathColumns(filters.SRID) + `
 		FROM damaged_roads dr
+		LEFT JOIN users u ON u.id = dr.author_id
 		WHERE 1=1
 	`
 
-	countQuery := `SELECT COUNT(*) FROM damaged_roads WHERE 1=1`
-
-	args := []interface{}{}
-	argPos := 1
+	countQuery := `SELECT COUNT(*) FROM damaged_roads dr WHERE 1=1`
 
 	// Apply filters
-	if filters.Status != nil {
-		baseQuery += fmt.Sprintf(" AND dr.status = $%d", argPos)
-		countQuery += fmt.Sprintf(" AND status = $%d", argPos)
-		args = append(args, filters.Status.String())
-		argPos++
-	}
-
-	if filters.SubDistrictCode != nil {
-		baseQuery += fmt.Sprintf(" AND dr.subdistrict_code = $%d", argPos)
-		countQuery += fmt.Sprintf(" AND subdistrict_code = $%d", argPos)
-		args = append(args, *filters.SubDistrictCode)
-		argPos++
-	}
-
-	if filters.AuthorID != nil {
-		baseQuery += fmt.Sprintf(" AND dr.author_id = $%d", argPos)
-		countQuery += fmt.Sprintf(" AND author_id = $%d", argPos)
-		args = append(args, *filters.AuthorID)
-		argPos++
-	}
+	where, args := listFilterClause(filters, 1)
+	baseQuery += where
+	countQuery += where
+	argPos := len(args) + 1
 
 	// Get total count
 	var total int
@@ -267,9 +347,20 @@ func (r *DamagedRoadRepository) List(
 		return nil, 0, errors.NewDatabaseError("count reports", err)
 	}
 
-	// Add ordering and pagination
-	baseQuery += fmt.Sprintf(" ORDER BY dr.created_at DESC LIMIT $%d OFFSET $%d", argPos, argPos+1)
-	args = append(args, filters.Limit, filters.Offset)
+	// Add ordering and pagination. A cursor seeks past the previous page on the (created_at, id)
+	// index instead of skipping rows, so new reports cannot shift the pages
+	if filters.Cursor != nil {
+		op := ">"
+		if filters.Sort.Descending {
+			op = "<"
+		}
+		baseQuery += fmt.Sprintf(" AND (dr.created_at, dr.id) %s ($%d, $%d)", op, argPos, argPos+1) +
+			listOrderClause(filters.Sort) + fmt.Sprintf(" LIMIT $%d", argPos+2)
+		args = append(args, filters.Cursor.CreatedAt, filters.Cursor.ID, filters.Limit)
+	} else {
+		baseQuery += listOrderClause(filters.Sort) + fmt.Sprintf(" LIMIT $%d OFFSET $%d", argPos, argPos+1)
+		args = append(args, filters.Limit, filters.Offset)
+	}
 
 	// Execute query
 	var rows []damagedRoadRow
@@ -289,15 +380,222 @@ func (r *DamagedRoadRepository) List(
 	return roads, total, nil
 }
 
-// UpdateStatus updates the status of a damaged road report
-func (r *DamagedRoadRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.Status) error {
+// Count returns the total number of reports that are not deleted, ignoring any filters
+func (r *DamagedRoadRepository) Count(ctx context.Context) (int, error) {
+	var total int
+	if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM damaged_roads WHERE deleted_at IS NULL`); err != nil {
+		return 0, errors.NewDatabaseError("count all reports", err)
+	}
+	return total, nil
+}
+
+// StreamList iterates over all reports matching the filters, ignoring pagination, and
+// invokes fn for each row as it is scanned from the cursor. Iteration stops at the first
+// error returned by fn.
+func (r *DamagedRoadRepository) StreamList(
+	ctx context.Context,
+	filters *entities.DamagedRoadFilters,
+	fn func(*entities.DamagedRoad) error,
+) error {
+	query := `
+		SELECT 
+			dr.id, dr.title, dr.subdistrict_code,
+			ST_AsGeoJSON(dr.path) as path,
+			dr.description,
+			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id AND kind = 'evidence' AND validation_status <> 'invalid') as photo_urls,
+			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id AND kind = 'resolution') as resolution_photo_urls,
+			dr.author_id, dr.status, dr.created_at, dr.updated_at, dr.status_changed_at, dr.category_id, dr.deleted_at,
+			u.name AS author_name, u.role AS author_role, u.email AS author_email` + projectedPathColumns(filters.SRID) + `
+		FROM damaged_roads dr
+		LEFT JOIN users u ON u.id = dr.author_id
+		WHERE 1=1
+	`
+
+	where, args := listFilterClause(filters, 1)
+	query += where + listOrderClause(filters.Sort)
+
+	// The cursor stays open for the whole export, past the default statement timeout
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return errors.NewDatabaseError("begin transaction", err)
+	}
+	defer tx.Rollback()
+	if err := liftStatementTimeout(ctx, tx); err != nil {
+		return errors.NewDatabaseError("lift statement timeout", err)
+	}
+
+	rows, err := tx.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return errors.NewDatabaseError("stream reports", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row damagedRoadRow
+		if err := rows.StructScan(&row); err != nil {
+			return errors.NewDatabaseError("scan report", err)
+		}
+		road, err := row.toEntity()
+		if err != nil {
+			return fmt.Errorf("failed to convert row to entity: %w", err)
+		}
+		if err := fn(road); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return errors.NewDatabaseError("stream reports", err)
+	}
+
+	return nil
+}
+
+// listFilterClause builds the " AND ..." conditions shared by List and StreamList.
+// Placeholders start at argPos.
+func listFilterClause(filters *entities.DamagedRoadFilters, argPos int) (string, []interface{}) {
+	var clause string
+	args := []interface{}{}
+
+	if !filters.IncludeDeleted {
+		clause += " AND dr.deleted_at IS NULL"
+	}
+
+	if filters.Status != nil {
+		clause += fmt.Sprintf(" AND dr.status = $%d", argPos)
+		args = append(args, filters.Status.String())
+		argPos++
+	}
+
+	if filters.SubDistrictCode != nil {
+		clause += fmt.Sprintf(" AND dr.subdistrict_code = $%d", argPos)
+		args = append(args, *filters.SubDistrictCode)
+		argPos++
+	}
+
+	if filters.CategoryID != nil {
+		clause += fmt.Sprintf(" AND dr.category_id = $%d", argPos)
+		args = append(args, *filters.CategoryID)
+		argPos++
+	}
+
+	if filters.AuthorID != nil {
+		clause += fmt.Sprintf(" AND dr.author_id = $%d", argPos)
+		args = append(args, *filters.AuthorID)
+		argPos++
+	}
+
+	if filters.Query != nil {
+		// Trigram indexes on both columns serve the unanchored ILIKE
+		clause += fmt.Sprintf(" AND (dr.title ILIKE $%d OR dr.description ILIKE $%d)", argPos, argPos)
+		args = append(args, "%"+escapeLikePattern(*filters.Query)+"%")
+		argPos++
+	}
+
+	if filters.CreatedFrom != nil {
+		clause += fmt.Sprintf(" AND dr.created_at >= $%d", argPos)
+		args = append(args, *filters.CreatedFrom)
+		argPos++
+	}
+
+	if filters.CreatedTo != nil {
+		clause += fmt.Sprintf(" AND dr.created_at <= $%d", argPos)
+		args = append(args, *filters.CreatedTo)
+		argPos++
+	}
+
+	if filters.SLABreached != nil {
+		condition, conditionArgs := slaBreachedClause(filters.SLAPolicy, argPos)
+		if !*filters.SLABreached {
+			condition = "NOT " + condition
+		}
+		clause += " AND " + condition
+		args = append(args, conditionArgs...)
+	}
+
+	return clause, args
+}
+
+// likeEscaper escapes the LIKE wildcards so a search term only matches literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLikePattern escapes a search term for use inside an ILIKE pattern
+func escapeLikePattern(term string) string {
+	return likeEscaper.Replace(term)
+}
+
+// sortColumns whitelists the columns a listing can be ordered by; sort fields are never
+// interpolated into SQL directly
+var sortColumns = map[string]string{
+	entities.ReportSortCreatedAt: "dr.created_at",
+	entities.ReportSortUpdatedAt: "dr.updated_at",
+	entities.ReportSortTitle:     "dr.title",
+}
+
+// listOrderClause builds the ORDER BY clause for a listing, falling back to the default
+// order for unknown fields. The id tie-breaker keeps pagination stable
+func listOrderClause(sort entities.ReportSort) string {
+	column, ok := sortColumns[sort.Field]
+	if !ok {
+		sort = entities.DefaultReportSort
+		column = sortColumns[sort.Field]
+	}
+
+	direction := "ASC"
+	if sort.Descending {
+		direction = "DESC"
+	}
+	return fmt.Sprintf(" ORDER BY %s %s, dr.id %s", column, direction, direction)
+}
+
+// slaBreachedClause builds a condition matching reports that exceeded the SLA of their
+// current status. Placeholders start at argPos; an empty policy matches nothing.
+func slaBreachedClause(policy entities.SLAPolicy, argPos int) (string, []interface{}) {
+	if len(policy) == 0 {
+		return "FALSE", nil
+	}
+
+	// Iterate statuses in a fixed order so the generated SQL is stable
+	var conditions []string
+	var args []interface{}
+	for _, status := range entities.AllStatuses() {
+		limit, ok := policy[status]
+		if !ok {
+			continue
+		}
+		conditions = append(conditions, fmt.Sprintf(
+			"(dr.status = $%d AND dr.status_changed_at < NOW() - make_interval(secs => $%d))",
+			argPos, argPos+1,
+		))
+		args = append(args, status.String(), limit.Seconds())
+		argPos += 2
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+// UpdateStatus updates the status of a damaged road report, recording the change in the
+// status history and storing any proof-of-repair photos in the same transaction
+func (r *DamagedRoadRepository) UpdateStatus(
+	ctx context.Context,
+	change *entities.StatusChange,
+	resolutionPhotoURLs []string,
+) error {
+	id := change.ReportID
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.NewDatabaseError("begin transaction", err)
+	}
+	defer tx.Rollback()
+
 	query := `
 		UPDATE damaged_roads
-		SET status = $1, updated_at = NOW()
-		WHERE id = $2
+		SET status = $1, updated_at = $2, status_changed_at = $2
+		WHERE id = $3 AND deleted_at IS NULL
 	`
 
-	result, err := r.db.ExecContext(ctx, query, status.String(), id)
+	result, err := tx.ExecContext(ctx, query, change.ToStatus.String(), change.ChangedAt, id)
 	if err != nil {
 		return errors.NewDatabaseError("update status", err)
 	}
@@ -311,6 +609,27 @@ func (r *DamagedRoadRepository) UpdateStatus(ctx context.Context, id uuid.UUID,
 		return errors.ErrRecordNotFound
 	}
 
+	if err := insertStatusChange(ctx, tx, change); err != nil {
+		return err
+	}
+
+	if len(resolutionPhotoURLs) > 0 {
+		photoQuery := `
+			INSERT INTO damaged_road_photos (road_id, url, kind, validation_status, validated_at)
+			VALUES ($1, $2, 'resolution', 'valid', NOW())
+			ON CONFLICT (road_id, kind, url) DO NOTHING
+		`
+		for _, photoURL := range resolutionPhotoURLs {
+			if _, err := tx.ExecContext(ctx, photoQuery, id, photoURL); err != nil {
+				return errors.NewDatabaseError("insert resolution photo", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.NewDatabaseError("commit transaction", err)
+	}
+
 	return nil
 }
 
@@ -333,12 +652,13 @@ func (r *DamagedRoadRepository) Update(ctx context.Context, road *entities.Damag
 	}
 	defer tx.Rollback()
 
-	// Update the damaged road (without photo_urls column)
+	// Update the damaged road (without photo_urls column). Status is owned by UpdateStatus and
+	// never written here; the guard refuses the edit if staff moved the report on since it was loaded
 	roadQuery := `
 		UPDATE damaged_roads
 		SET title = $1, subdistrict_code = $2, path = ST_GeomFromGeoJSON($3), 
-		    description = $4, status = $5, updated_at = $6
-		WHERE id = $7
+		    description = $4, updated_at = $5
+		WHERE id = $6 AND deleted_at IS NULL AND status = 'submitted'
 	`
 
 	result, err := tx.ExecContext(ctx, roadQuery,
@@ -346,7 +666,6 @@ func (r *DamagedRoadRepository) Update(ctx context.Context, road *entities.Damag
 		road.SubDistrictCode.String(),
 		string(geometryJSON),
 		description,
-		road.Status.String(),
 		road.UpdatedAt,
 		road.ID,
 	)
@@ -361,11 +680,11 @@ func (r *DamagedRoadRepository) Update(ctx context.Context, road *entities.Damag
 	}
 
 	if rows == 0 {
-		return errors.ErrRecordNotFound
+		return editMissReason(ctx, tx, road.ID)
 	}
 
-	// Delete existing photos
-	deletePhotosQuery := `DELETE FROM damaged_road_photos WHERE road_id = $1`
+	// Delete existing evidence photos; resolution photos are managed by UpdateStatus
+	deletePhotosQuery := `DELETE FROM damaged_road_photos WHERE road_id = $1 AND kind = 'evidence'`
 	_, err = tx.ExecContext(ctx, deletePhotosQuery, road.ID)
 	if err != nil {
 		return errors.NewDatabaseError("delete existing photos", err)
@@ -385,6 +704,20 @@ func (r *DamagedRoadRepository) Update(ctx context.Context, road *entities.Damag
 		}
 	}
 
+	// Record photos dropped during lenient validation so they can be audited later
+	if len(road.DroppedPhotos) > 0 {
+		droppedQuery := `
+			INSERT INTO damaged_road_photos (road_id, url, validation_status, validated_at, validation_error)
+			VALUES ($1, $2, 'invalid', NOW(), $3)
+		`
+		for _, dropped := range road.DroppedPhotos {
+			_, err = tx.ExecContext(ctx, droppedQuery, road.ID, dropped.URL, dropped.Reason)
+			if err != nil {
+				return errors.NewDatabaseError("insert dropped damaged road photo", err)
+			}
+		}
+	}
+
 	// Commit the transaction
 	if err = tx.Commit(); err != nil {
 		return errors.NewDatabaseError("commit transaction", err)
@@ -393,9 +726,55 @@ func (r *DamagedRoadRepository) Update(ctx context.Context, road *entities.Damag
 	return nil
 }
 
-// Delete deletes a damaged road report by ID
+// UpdatePath updates only the geometry of a damaged road report; photo rows are not touched
+func (r *DamagedRoadRepository) UpdatePath(ctx context.Context, id uuid.UUID, path entities.Geometry, updatedAt time.Time) error {
+	geometryJSON, err := json.Marshal(path)
+	if err != nil {
+		return errors.NewDatabaseError("marshal geometry", err)
+	}
+
+	query := `
+		UPDATE damaged_roads
+		SET path = ST_GeomFromGeoJSON($1), updated_at = $2
+		WHERE id = $3 AND deleted_at IS NULL AND status = 'submitted'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, string(geometryJSON), updatedAt, id)
+	if err != nil {
+		return errors.NewDatabaseError("update damaged road path", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.NewDatabaseError("check rows affected", err)
+	}
+
+	if rows == 0 {
+		return editMissReason(ctx, r.db, id)
+	}
+
+	return nil
+}
+
+// editMissReason explains why an author edit guarded by status = 'submitted' matched no row:
+// errors.ErrRecordNotFound when the report is gone, errors.ErrReportNotEditable when it has
+// moved past submitted since the author loaded it
+func editMissReason(ctx context.Context, q sqlx.QueryerContext, id uuid.UUID) error {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM damaged_roads WHERE id = $1 AND deleted_at IS NULL)`
+	if err := sqlx.GetContext(ctx, q, &exists, query, id); err != nil {
+		return errors.NewDatabaseError("check report exists", err)
+	}
+	if !exists {
+		return errors.ErrRecordNotFound
+	}
+	return errors.ErrReportNotEditable
+}
+
+// Delete soft-deletes a damaged road report by ID. The row and its photos and history are
+// kept for auditing and recovery, but the report disappears from every read
 func (r *DamagedRoadRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM damaged_roads WHERE id = $1`
+	query := `UPDATE damaged_roads SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
 
 	result, err := r.db.ExecContext(ctx, query, id)
 	if err != nil {
@@ -418,6 +797,7 @@ func (r *DamagedRoadRepository) Delete(ctx context.Context, id uuid.UUID) error
 func (r *DamagedRoadRepository) FindByGeometry(
 	ctx context.Context,
 	bounds entities.Geometry,
+	limit int,
 ) ([]*entities.DamagedRoad, error) {
 	geometryJSON, err := json.Marshal(bounds)
 	if err != nil {
@@ -429,15 +809,23 @@ func (r *DamagedRoadRepository) FindByGeometry(
 			dr.id, dr.title, dr.subdistrict_code,
 			ST_AsGeoJSON(dr.path) as path,
 			dr.description,
-			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id) as photo_urls,
-			dr.author_id, dr.status, dr.created_at, dr.updated_at
+			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id AND kind = 'evidence' AND validation_status <> 'invalid') as photo_urls,
+			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id AND kind = 'resolution') as resolution_photo_urls,
+			dr.author_id, dr.status, dr.created_at, dr.updated_at, dr.status_changed_at, dr.category_id, dr.deleted_at,
+			u.name AS author_name, u.role AS author_role, u.email AS author_email
 		FROM damaged_roads dr
-		WHERE ST_Intersects(dr.path, ST_GeomFromGeoJSON($1))
+		LEFT JOIN users u ON u.id = dr.author_id
+		WHERE ST_Intersects(dr.path, ST_GeomFromGeoJSON($1)) AND dr.deleted_at IS NULL
 		ORDER BY dr.created_at DESC
 	`
+	args := []interface{}{string(geometryJSON)}
+	if limit > 0 {
+		query += " LIMIT $2"
+		args = append(args, limit)
+	}
 
 	var rows []damagedRoadRow
-	if err := r.db.SelectContext(ctx, &rows, query, string(geometryJSON)); err != nil {
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
 		return nil, errors.NewDatabaseError("find by geometry", err)
 	}
 
@@ -452,3 +840,151 @@ func (r *DamagedRoadRepository) FindByGeometry(
 
 	return roads, nil
 }
+
+// FindWithinRadius finds reports within radiusMeters of center, ordered by distance
+func (r *DamagedRoadRepository) FindWithinRadius(
+	ctx context.Context,
+	center entities.Point,
+	radiusMeters float64,
+	limit int,
+) ([]*entities.DamagedRoad, error) {
+	// The geography cast makes both the distance and the radius meters instead of degrees
+	query := `
+		SELECT 
+			dr.id, dr.title, dr.subdistrict_code,
+			ST_AsGeoJSON(dr.path) as path,
+			dr.description,
+			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id AND kind = 'evidence' AND validation_status <> 'invalid') as photo_urls,
+			ARRAY(SELECT url FROM damaged_road_photos WHERE road_id = dr.id AND kind = 'resolution') as resolution_photo_urls,
+			dr.author_id, dr.status, dr.created_at, dr.updated_at, dr.status_changed_at, dr.category_id, dr.deleted_at,
+			u.name AS author_name, u.role AS author_role, u.email AS author_email,
+			ST_Distance(dr.path::geography, ST_SetSRID(ST_MakePoint($1, $2), 4326)::geography) AS distance_meters
+		FROM damaged_roads dr
+		LEFT JOIN users u ON u.id = dr.author_id
+		WHERE ST_DWithin(dr.path::geography, ST_SetSRID(ST_MakePoint($1, $2), 4326)::geography, $3)
+		  AND dr.deleted_at IS NULL
+		ORDER BY distance_meters ASC
+		LIMIT $4
+	`
+
+	var rows []struct {
+		damagedRoadRow
+		DistanceMeters float64 `db:"distance_meters"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, center.Lng, center.Lat, radiusMeters, limit); err != nil {
+		return nil, errors.NewDatabaseError("find within radius", err)
+	}
+
+	roads := make([]*entities.DamagedRoad, 0, len(rows))
+	for _, row := range rows {
+		road, err := row.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert row to entity: %w", err)
+		}
+		distance := row.DistanceMeters
+		road.DistanceMeters = &distance
+		roads = append(roads, road)
+	}
+
+	return roads, nil
+}
+
+// FindNearestUnresolvedDistance returns the distance in meters to the closest unresolved report within radiusMeters
+func (r *DamagedRoadRepository) FindNearestUnresolvedDistance(
+	ctx context.Context,
+	path entities.Geometry,
+	radiusMeters float64,
+) (*float64, error) {
+	geometryJSON, err := json.Marshal(path)
+	if err != nil {
+		return nil, errors.NewDatabaseError("marshal path geometry", err)
+	}
+
+	query := `
+		SELECT ST_Distance(dr.path::geography, ST_GeomFromGeoJSON($1)::geography) AS distance
+		FROM damaged_roads dr
+		WHERE dr.status NOT IN ('resolved', 'archived') AND dr.deleted_at IS NULL
+		  AND ST_DWithin(dr.path::geography, ST_GeomFromGeoJSON($1)::geography, $2)
+		ORDER BY distance ASC
+		LIMIT 1
+	`
+
+	var distance float64
+	err = r.db.GetContext(ctx, &distance, query, string(geometryJSON), radiusMeters)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.NewDatabaseError("find nearest unresolved report", err)
+	}
+
+	return &distance, nil
+}
+
+// FindNearestUnresolvedID returns the ID of the closest unresolved report within radiusMeters of path
+func (r *DamagedRoadRepository) FindNearestUnresolvedID(
+	ctx context.Context,
+	path entities.Geometry,
+	radiusMeters float64,
+) (*uuid.UUID, error) {
+	geometryJSON, err := json.Marshal(path)
+	if err != nil {
+		return nil, errors.NewDatabaseError("marshal path geometry", err)
+	}
+
+	query := `
+		SELECT dr.id
+		FROM damaged_roads dr
+		WHERE dr.status NOT IN ('resolved', 'archived') AND dr.deleted_at IS NULL
+		  AND ST_DWithin(dr.path::geography, ST_GeomFromGeoJSON($1)::geography, $2)
+		ORDER BY ST_Distance(dr.path::geography, ST_GeomFromGeoJSON($1)::geography) ASC
+		LIMIT 1
+	`
+
+	var id uuid.UUID
+	err = r.db.GetContext(ctx, &id, query, string(geometryJSON), radiusMeters)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.NewDatabaseError("find nearest unresolved report id", err)
+	}
+
+	return &id, nil
+}
+
+// checkAuthorInterval locks the author for the rest of tx and returns a TooSoonError when their
+// latest report, deleted or not, is more recent than minInterval
+func checkAuthorInterval(ctx context.Context, tx *sqlx.Tx, authorID uuid.UUID, minInterval time.Duration) error {
+	// hashtext folds the UUID into the advisory lock's key space; a collision only serializes
+	// two unrelated authors
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1::text))`, authorID); err != nil {
+		return errors.NewDatabaseError("lock author for report interval", err)
+	}
+
+	var wait sql.NullFloat64
+	query := `SELECT EXTRACT(EPOCH FROM (MAX(created_at) + make_interval(secs => $2) - NOW())) FROM damaged_roads WHERE author_id = $1`
+	if err := tx.GetContext(ctx, &wait, query, authorID, minInterval.Seconds()); err != nil {
+		return errors.NewDatabaseError("check report interval", err)
+	}
+	if wait.Valid && wait.Float64 > 0 {
+		return &errors.TooSoonError{RetryAfter: time.Duration(wait.Float64 * float64(time.Second))}
+	}
+	return nil
+}
+
+// FindLatestCreatedAtByAuthor returns the creation time of the author's most recent report.
+// Deleted reports count too, so deleting a report cannot reset the submission interval
+func (r *DamagedRoadRepository) FindLatestCreatedAtByAuthor(ctx context.Context, authorID uuid.UUID) (*time.Time, error) {
+	query := `SELECT MAX(created_at) FROM damaged_roads WHERE author_id = $1`
+
+	var latest sql.NullTime
+	if err := r.db.GetContext(ctx, &latest, query, authorID); err != nil {
+		return nil, errors.NewDatabaseError("find latest report by author", err)
+	}
+	if !latest.Valid {
+		return nil, nil
+	}
+
+	return &latest.Time, nil
+}
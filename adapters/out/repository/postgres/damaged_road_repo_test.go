@@ -0,0 +1,647 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+)
+
+// insertTestUser creates a user for reports to belong to; deleting it afterwards cascades to
+// the reports. The database must already be migrated
+func insertTestUser(t *testing.T, db *sqlx.DB) uuid.UUID {
+	t.Helper()
+	id := uuid.New()
+	_, err := db.Exec(`INSERT INTO users (id, name, email, password_hash) VALUES ($1, 'Test', $2, 'x')`,
+		id, id.String()+"@example.test")
+	if err != nil {
+		t.Fatalf("insert test user error = %v", err)
+	}
+	t.Cleanup(func() { db.Exec(`DELETE FROM users WHERE id = $1`, id) })
+	return id
+}
+
+// newTestRoad returns a valid report by authorID
+func newTestRoad(t *testing.T, authorID uuid.UUID) *entities.DamagedRoad {
+	t.Helper()
+	path, err := entities.NewGeometryFromPoints([]entities.Point{{Lat: -7.2575, Lng: 112.7521}, {Lat: -7.2580, Lng: 112.7530}})
+	if err != nil {
+		t.Fatalf("NewGeometryFromPoints() error = %v", err)
+	}
+	road, err := entities.NewDamagedRoad("Jalan berlubang", "35.78.01.1001", *path,
+		[]string{"https://photos.example.com/" + uuid.NewString() + ".jpg"}, authorID, nil, nil)
+	if err != nil {
+		t.Fatalf("NewDamagedRoad() error = %v", err)
+	}
+	return road
+}
+
+func TestCreateAfterIntervalSerializesConcurrentSubmissions(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	authorID := insertTestUser(t, db)
+
+	const submissions = 8
+	errs := make([]error, submissions)
+	var wg sync.WaitGroup
+	for i := 0; i < submissions; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = repo.CreateAfterInterval(context.Background(), newTestRoad(t, authorID), time.Minute)
+		}(i)
+	}
+	wg.Wait()
+
+	created := 0
+	for _, err := range errs {
+		var tooSoonErr *errors.TooSoonError
+		switch {
+		case err == nil:
+			created++
+		case !stderrors.As(err, &tooSoonErr):
+			t.Errorf("CreateAfterInterval() error = %v, want nil or TooSoonError", err)
+		}
+	}
+	if created != 1 {
+		t.Errorf("%d concurrent submissions created, want exactly 1", created)
+	}
+}
+
+func TestUpdateRefusesReportNoLongerSubmitted(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	ctx := context.Background()
+	road := newTestRoad(t, insertTestUser(t, db))
+	if err := repo.Create(ctx, road); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// A verificator picks the report up after the author loaded it
+	if _, err := db.Exec(`UPDATE damaged_roads SET status = 'under_verification' WHERE id = $1`, road.ID); err != nil {
+		t.Fatalf("status change error = %v", err)
+	}
+
+	road.Title = "Judul baru"
+	if err := repo.Update(ctx, road); !stderrors.Is(err, errors.ErrReportNotEditable) {
+		t.Fatalf("Update() error = %v, want ErrReportNotEditable", err)
+	}
+	stored, err := repo.FindByID(ctx, road.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if stored.Status != entities.StatusUnderVerification || stored.Title == "Judul baru" {
+		t.Errorf("stored report = %s %q, want the status change kept and the edit refused", stored.Status, stored.Title)
+	}
+}
+
+func TestUpdateStatusRecordsHistory(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	historyRepo := NewReportStatusHistoryRepository(db)
+	ctx := context.Background()
+	authorID := insertTestUser(t, db)
+	road := newTestRoad(t, authorID)
+	if err := repo.Create(ctx, road); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	changedAt := time.Now().UTC().Truncate(time.Second)
+	change := entities.NewStatusChange(road.ID, entities.StatusSubmitted, entities.StatusUnderVerification, authorID, changedAt, nil)
+	if err := repo.UpdateStatus(ctx, change, nil); err != nil {
+		t.Fatalf("UpdateStatus() error = %v", err)
+	}
+
+	history, err := historyRepo.FindByReport(ctx, road.ID)
+	if err != nil {
+		t.Fatalf("FindByReport() error = %v", err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("history has %d entries, want 1", len(history))
+	}
+	got := history[0]
+	if got.FromStatus != entities.StatusSubmitted || got.ToStatus != entities.StatusUnderVerification ||
+		got.ChangedBy != authorID || !got.ChangedAt.Equal(changedAt) {
+		t.Errorf("history entry = %+v, want the recorded change", got)
+	}
+}
+
+// createTestRoads stores one report by authorID per creation time
+func createTestRoads(t *testing.T, repo external.DamagedRoadRepository, authorID uuid.UUID, createdAt ...time.Time) []*entities.DamagedRoad {
+	t.Helper()
+	roads := make([]*entities.DamagedRoad, len(createdAt))
+	for i, at := range createdAt {
+		roads[i] = newTestRoad(t, authorID)
+		roads[i].CreatedAt, roads[i].UpdatedAt, roads[i].StatusChangedAt = at, at, at
+		if err := repo.Create(context.Background(), roads[i]); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	return roads
+}
+
+func TestListFiltersByCreatedRange(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	authorID := insertTestUser(t, db)
+	monday := time.Date(2025, 10, 13, 0, 0, 0, 0, time.UTC)
+	roads := createTestRoads(t, repo, authorID, monday.AddDate(0, 0, -1), monday, monday.AddDate(0, 0, 3), monday.AddDate(0, 0, 7))
+
+	filters := entities.NewDamagedRoadFilters()
+	filters.AuthorID = &authorID
+	from, to := monday, monday.AddDate(0, 0, 7)
+	filters.CreatedFrom, filters.CreatedTo = &from, &to
+
+	listed, total, err := repo.List(context.Background(), filters)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	// Both bounds are inclusive
+	if total != 3 || len(listed) != 3 {
+		t.Fatalf("List() returned %d of %d reports, want 3", len(listed), total)
+	}
+	for _, road := range listed {
+		if road.ID == roads[0].ID {
+			t.Error("List() returned a report created before created_from")
+		}
+	}
+}
+
+func TestDamagedRoadRowToEntityKeepsTimestamps(t *testing.T) {
+	createdAt := time.Date(2025, 10, 20, 10, 0, 0, 0, time.UTC)
+	row := &damagedRoadRow{
+		ID:              uuid.New(),
+		Title:           "Jalan berlubang",
+		SubDistrictCode: "35.78.01.1001",
+		Path:            `{"type":"LineString","coordinates":[[112.7521,-7.2575],[112.753,-7.258]]}`,
+		Status:          string(entities.StatusSubmitted),
+		CreatedAt:       createdAt,
+		UpdatedAt:       createdAt.Add(time.Hour),
+		StatusChangedAt: createdAt.Add(2 * time.Hour),
+	}
+
+	road, err := row.toEntity()
+	if err != nil {
+		t.Fatalf("toEntity() error = %v", err)
+	}
+	if !road.CreatedAt.Equal(row.CreatedAt) || !road.UpdatedAt.Equal(row.UpdatedAt) || !road.StatusChangedAt.Equal(row.StatusChangedAt) {
+		t.Errorf("toEntity() timestamps = %s, %s, %s; want the row's", road.CreatedAt, road.UpdatedAt, road.StatusChangedAt)
+	}
+}
+
+func TestReportTimestampsRoundTrip(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	ctx := context.Background()
+
+	// Postgres keeps microseconds, and the zone must not shift the instant
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	createdAt := time.Date(2025, 10, 20, 17, 0, 0, 123456000, jakarta)
+	road := createTestRoads(t, repo, insertTestUser(t, db), createdAt)[0]
+
+	stored, err := repo.FindByID(ctx, road.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	for name, got := range map[string]time.Time{
+		"created_at":        stored.CreatedAt,
+		"updated_at":        stored.UpdatedAt,
+		"status_changed_at": stored.StatusChangedAt,
+	} {
+		if got.IsZero() || !got.Equal(createdAt) {
+			t.Errorf("%s = %s, want %s", name, got, createdAt)
+		}
+	}
+}
+
+func TestListOrderClause(t *testing.T) {
+	tests := []struct {
+		sort entities.ReportSort
+		want string
+	}{
+		{sort: entities.DefaultReportSort, want: " ORDER BY dr.created_at DESC, dr.id DESC"},
+		{sort: entities.ReportSort{Field: entities.ReportSortUpdatedAt}, want: " ORDER BY dr.updated_at ASC, dr.id ASC"},
+		{sort: entities.ReportSort{Field: entities.ReportSortTitle, Descending: true}, want: " ORDER BY dr.title DESC, dr.id DESC"},
+		// Only whitelisted columns reach the SQL
+		{sort: entities.ReportSort{Field: "title; DROP TABLE damaged_roads"}, want: " ORDER BY dr.created_at DESC, dr.id DESC"},
+	}
+
+	for _, tt := range tests {
+		if got := listOrderClause(tt.sort); got != tt.want {
+			t.Errorf("listOrderClause(%+v) = %q, want %q", tt.sort, got, tt.want)
+		}
+	}
+}
+
+func TestListFilterClauseSearchesTitleOrDescription(t *testing.T) {
+	query := "SDN 01_50%"
+	filters := entities.NewDamagedRoadFilters()
+	filters.Query = &query
+
+	clause, args := listFilterClause(filters, 1)
+	if want := " AND dr.deleted_at IS NULL AND (dr.title ILIKE $1 OR dr.description ILIKE $1)"; clause != want {
+		t.Errorf("clause = %q, want %q", clause, want)
+	}
+	// LIKE wildcards in the term only match literally
+	if len(args) != 1 || args[0] != `%SDN 01\_50\%%` {
+		t.Errorf("args = %v, want the escaped term wrapped in wildcards", args)
+	}
+}
+
+func TestListSearchMatchesTitleOrDescription(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	authorID := insertTestUser(t, db)
+	now := time.Now()
+	roads := createTestRoads(t, repo, authorID, now, now, now)
+
+	if _, err := db.Exec(`UPDATE damaged_roads SET title = 'Depan SDN 01' WHERE id = $1`, roads[0].ID); err != nil {
+		t.Fatalf("update title error = %v", err)
+	}
+	if _, err := db.Exec(`UPDATE damaged_roads SET description = 'Lubang besar di depan sdn 01 Ketintang' WHERE id = $1`, roads[1].ID); err != nil {
+		t.Fatalf("update description error = %v", err)
+	}
+
+	query := "Sdn 01"
+	filters := entities.NewDamagedRoadFilters()
+	filters.AuthorID = &authorID
+	filters.Query = &query
+	listed, total, err := repo.List(context.Background(), filters)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 2 || len(listed) != 2 {
+		t.Errorf("List() returned %d of %d reports, want the title and the description match", len(listed), total)
+	}
+	for _, road := range listed {
+		if road.ID == roads[2].ID {
+			t.Error("List() returned a report mentioning neither")
+		}
+	}
+}
+
+func TestDamagedRoadRowToEntityAuthor(t *testing.T) {
+	row := &damagedRoadRow{
+		ID:              uuid.New(),
+		Title:           "Jalan berlubang",
+		SubDistrictCode: "35.78.01.1001",
+		Path:            `{"type":"LineString","coordinates":[[112.7521,-7.2575],[112.753,-7.258]]}`,
+		Status:          string(entities.StatusSubmitted),
+	}
+
+	// The LEFT JOIN found no user row
+	road, err := row.toEntity()
+	if err != nil {
+		t.Fatalf("toEntity() error = %v", err)
+	}
+	if road.Author != nil {
+		t.Errorf("Author = %+v, want nil without a user row", road.Author)
+	}
+
+	row.AuthorName = sql.NullString{String: "Budi Santoso", Valid: true}
+	row.AuthorRole = sql.NullString{String: entities.RoleUser, Valid: true}
+	row.AuthorEmail = sql.NullString{String: "budi@example.com", Valid: true}
+	if road, err = row.toEntity(); err != nil {
+		t.Fatalf("toEntity() error = %v", err)
+	}
+	if road.Author == nil || *road.Author != (entities.ReportAuthor{Name: "Budi Santoso", Role: entities.RoleUser, Email: "budi@example.com"}) {
+		t.Errorf("Author = %+v, want the joined user", road.Author)
+	}
+}
+
+func TestListFiltersByCategory(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	authorID := insertTestUser(t, db)
+	now := time.Now()
+	roads := createTestRoads(t, repo, authorID, now, now, now)
+
+	for i, category := range []string{"pothole", "cracking"} {
+		if _, err := db.Exec(`UPDATE damaged_roads SET category_id = $1 WHERE id = $2`, category, roads[i].ID); err != nil {
+			t.Fatalf("set category error = %v", err)
+		}
+	}
+
+	category := "pothole"
+	filters := entities.NewDamagedRoadFilters()
+	filters.AuthorID = &authorID
+	filters.CategoryID = &category
+	listed, total, err := repo.List(context.Background(), filters)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 1 || len(listed) != 1 || listed[0].ID != roads[0].ID {
+		t.Fatalf("List() returned %d of %d reports, want only the pothole", len(listed), total)
+	}
+	if listed[0].CategoryID == nil || *listed[0].CategoryID != "pothole" {
+		t.Errorf("CategoryID = %v, want pothole", listed[0].CategoryID)
+	}
+}
+
+func TestProjectedPathColumns(t *testing.T) {
+	for _, srid := range []int{0, entities.SRIDWGS84} {
+		if columns := projectedPathColumns(srid); columns != "" {
+			t.Errorf("projectedPathColumns(%d) = %q, want no extra columns for the stored CRS", srid, columns)
+		}
+	}
+	want := ", ST_AsGeoJSON(ST_Transform(dr.path, 3857)) AS projected_path, 3857 AS projected_srid"
+	if columns := projectedPathColumns(3857); columns != want {
+		t.Errorf("projectedPathColumns(3857) = %q, want %q", columns, want)
+	}
+}
+
+func TestFindByIDInSRIDReprojectsPath(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	authorID := insertTestUser(t, db)
+	road := createTestRoads(t, repo, authorID, time.Now())[0]
+
+	found, err := repo.FindByIDInSRID(context.Background(), road.ID, 3857)
+	if err != nil {
+		t.Fatalf("FindByIDInSRID() error = %v", err)
+	}
+	if found.ProjectedPath == nil || found.ProjectedSRID != 3857 {
+		t.Fatalf("ProjectedPath = %v in SRID %d, want the path in 3857", found.ProjectedPath, found.ProjectedSRID)
+	}
+
+	// Web Mercator: x = R·λ and y = R·ln(tan(π/4 + φ/2)), with R the WGS84 semi-major axis
+	const earthRadius = 6378137.0
+	wgs84 := found.Path.Coordinates
+	projected := found.ProjectedPath.Coordinates
+	if len(projected) != len(wgs84) {
+		t.Fatalf("projected path has %d points, want %d", len(projected), len(wgs84))
+	}
+	for i, point := range wgs84 {
+		lng, lat := point[0]*math.Pi/180, point[1]*math.Pi/180
+		wantX := earthRadius * lng
+		wantY := earthRadius * math.Log(math.Tan(math.Pi/4+lat/2))
+		x, y := projected[i][0], projected[i][1]
+		if math.Abs(x-wantX) > 0.01 || math.Abs(y-wantY) > 0.01 {
+			t.Errorf("point %d projected to (%f, %f), want (%f, %f)", i, x, y, wantX, wantY)
+		}
+		if x == point[0] || y == point[1] {
+			t.Errorf("point %d was not reprojected: (%f, %f)", i, x, y)
+		}
+	}
+
+	// The stored WGS84 path is still returned alongside
+	if wgs84[0][0] < 112 || wgs84[0][0] > 113 {
+		t.Errorf("WGS84 path starts at %v, want the stored coordinates", wgs84[0])
+	}
+}
+
+func TestReportTimestampsReadBackInUTC(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	road := newTestRoad(t, insertTestUser(t, db))
+	if err := repo.Create(context.Background(), road); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// Fetched twice so the serialized form is stable between reads, not just correct once
+	for read := 0; read < 2; read++ {
+		stored, err := repo.FindByID(context.Background(), road.ID)
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		for name, got := range map[string]time.Time{"created_at": stored.CreatedAt, "updated_at": stored.UpdatedAt} {
+			if got.Location() != time.UTC {
+				t.Errorf("%s read back in %s, want UTC", name, got.Location())
+			}
+			if formatted := got.Format(time.RFC3339); formatted != road.CreatedAt.UTC().Format(time.RFC3339) {
+				t.Errorf("%s = %s, want %s", name, formatted, road.CreatedAt.UTC().Format(time.RFC3339))
+			}
+		}
+	}
+}
+
+func TestListCursorPagesWithoutGapsOrDuplicates(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	authorID := insertTestUser(t, db)
+	base := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	// Two reports share a created_at, so the id breaks the tie
+	roads := createTestRoads(t, repo, authorID,
+		base, base.Add(time.Minute), base.Add(time.Minute), base.Add(2*time.Minute), base.Add(3*time.Minute))
+
+	seen := make(map[uuid.UUID]bool)
+	var cursor *entities.ReportCursor
+	for page := 0; ; page++ {
+		filters := entities.NewDamagedRoadFilters()
+		filters.AuthorID = &authorID
+		filters.Limit = 2
+		filters.Cursor = cursor
+		listed, _, err := repo.List(context.Background(), filters)
+		if err != nil {
+			t.Fatalf("List() page %d error = %v", page, err)
+		}
+		for _, road := range listed {
+			if seen[road.ID] {
+				t.Errorf("report %s listed twice", road.ID)
+			}
+			seen[road.ID] = true
+		}
+		if len(listed) < filters.Limit {
+			break
+		}
+		last := listed[len(listed)-1]
+		cursor = &entities.ReportCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+
+		// A report arriving mid-scroll is newer than every cursor, so it never shifts later pages
+		if page == 0 {
+			createTestRoads(t, repo, authorID, time.Now())
+		}
+	}
+
+	for _, road := range roads {
+		if !seen[road.ID] {
+			t.Errorf("report %s created at %s was skipped", road.ID, road.CreatedAt)
+		}
+	}
+	if len(seen) != len(roads) {
+		t.Errorf("listed %d reports, want the %d that existed before scrolling", len(seen), len(roads))
+	}
+}
+
+func TestDeleteSoftDeletesReport(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	ctx := context.Background()
+	authorID := insertTestUser(t, db)
+	now := time.Now()
+	roads := createTestRoads(t, repo, authorID, now, now)
+	deleted := roads[0]
+
+	if err := repo.Delete(ctx, deleted.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	// The row and its photos are kept
+	var photos int
+	if err := db.Get(&photos, `SELECT COUNT(*) FROM damaged_road_photos WHERE road_id = $1`, deleted.ID); err != nil {
+		t.Fatalf("count photos error = %v", err)
+	}
+	if photos == 0 {
+		t.Error("photos of the deleted report were removed")
+	}
+
+	if found, err := repo.FindByID(ctx, deleted.ID); found != nil || err != nil {
+		t.Errorf("FindByID() of a deleted report = %v, %v, want nil as for a missing report", found, err)
+	}
+	if err := repo.Delete(ctx, deleted.ID); !stderrors.Is(err, errors.ErrRecordNotFound) {
+		t.Errorf("second Delete() error = %v, want ErrRecordNotFound", err)
+	}
+
+	filters := entities.NewDamagedRoadFilters()
+	filters.AuthorID = &authorID
+	listed, total, err := repo.List(ctx, filters)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 1 || len(listed) != 1 || listed[0].ID != roads[1].ID {
+		t.Errorf("List() returned %d of %d reports, want only the remaining one", len(listed), total)
+	}
+
+	filters.IncludeDeleted = true
+	listed, total, err = repo.List(ctx, filters)
+	if err != nil {
+		t.Fatalf("List() including deleted error = %v", err)
+	}
+	if total != 2 || len(listed) != 2 {
+		t.Fatalf("List() including deleted returned %d of %d reports, want 2", len(listed), total)
+	}
+	for _, road := range listed {
+		if (road.ID == deleted.ID) != (road.DeletedAt != nil) {
+			t.Errorf("report %s DeletedAt = %v, want it set only on the deleted report", road.ID, road.DeletedAt)
+		}
+	}
+
+	// Deleting a report does not reset the submission interval
+	latest, err := repo.FindLatestCreatedAtByAuthor(ctx, authorID)
+	if err != nil || latest == nil {
+		t.Errorf("FindLatestCreatedAtByAuthor() = %v, %v, want the latest report, deleted or not", latest, err)
+	}
+}
+
+func TestFindNearestUnresolvedID(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	ctx := context.Background()
+
+	// Placed in Jayapura, away from the Surabaya reports of the other tests
+	road := newTestRoad(t, insertTestUser(t, db))
+	path, err := entities.NewGeometryFromPoints([]entities.Point{{Lat: -2.5337, Lng: 140.7181}, {Lat: -2.5340, Lng: 140.7190}})
+	if err != nil {
+		t.Fatalf("NewGeometryFromPoints() error = %v", err)
+	}
+	road.Path = *path
+	if err := repo.Create(ctx, road); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// About 11 meters north of the first point, and about 1.1km north
+	near, _ := entities.NewGeometryFromPoints([]entities.Point{{Lat: -2.5336, Lng: 140.7181}})
+	far, _ := entities.NewGeometryFromPoints([]entities.Point{{Lat: -2.5237, Lng: 140.7181}})
+
+	id, err := repo.FindNearestUnresolvedID(ctx, *near, 20)
+	if err != nil || id == nil || *id != road.ID {
+		t.Errorf("FindNearestUnresolvedID() near = %v, %v, want %s", id, err, road.ID)
+	}
+	if id, err := repo.FindNearestUnresolvedID(ctx, *far, 20); err != nil || id != nil {
+		t.Errorf("FindNearestUnresolvedID() far = %v, %v, want none", id, err)
+	}
+
+	if _, err := db.Exec(`UPDATE damaged_roads SET status = 'resolved' WHERE id = $1`, road.ID); err != nil {
+		t.Fatalf("resolve report error = %v", err)
+	}
+	if id, err := repo.FindNearestUnresolvedID(ctx, *near, 20); err != nil || id != nil {
+		t.Errorf("FindNearestUnresolvedID() after resolving = %v, %v, want none", id, err)
+	}
+}
+
+func TestFindWithinRadiusOrdersByDistance(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	authorID := insertTestUser(t, db)
+	ctx := context.Background()
+
+	// Placed in Kupang, away from the reports of the other tests. Each path starts about
+	// 3.3km, 553m and 111m north of the center; created farthest first
+	center := entities.Point{Lat: -10.1700, Lng: 123.6000}
+	var roads []*entities.DamagedRoad
+	for _, offset := range []float64{0.030, 0.005, 0.001} {
+		road := newTestRoad(t, authorID)
+		path, err := entities.NewGeometryFromPoints([]entities.Point{
+			{Lat: center.Lat + offset, Lng: center.Lng},
+			{Lat: center.Lat + offset + 0.001, Lng: center.Lng},
+		})
+		if err != nil {
+			t.Fatalf("NewGeometryFromPoints() error = %v", err)
+		}
+		road.Path = *path
+		if err := repo.Create(ctx, road); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		roads = append(roads, road)
+	}
+
+	found, err := repo.FindWithinRadius(ctx, center, 1000, 10)
+	if err != nil {
+		t.Fatalf("FindWithinRadius() error = %v", err)
+	}
+	if len(found) != 2 || found[0].ID != roads[2].ID || found[1].ID != roads[1].ID {
+		t.Fatalf("FindWithinRadius() returned %d reports, want the two within 1km, nearest first", len(found))
+	}
+	// A degree of latitude is about 110.6km at 10°S on the ellipsoid
+	for i, want := range []float64{110.6, 553.0} {
+		if found[i].DistanceMeters == nil {
+			t.Errorf("report %d has no distance", i)
+		} else if got := *found[i].DistanceMeters; math.Abs(got-want) > 1 {
+			t.Errorf("report %d distance = %.1f m, want about %.1f m", i, got, want)
+		}
+	}
+
+	if found, err := repo.FindWithinRadius(ctx, center, 1000, 1); err != nil || len(found) != 1 || found[0].ID != roads[2].ID {
+		t.Errorf("FindWithinRadius() with limit 1 = %d reports, %v, want only the nearest", len(found), err)
+	}
+}
+
+func TestCountAgreesWithUnfilteredList(t *testing.T) {
+	db := connectTestDB(t, 0)
+	repo := NewDamagedRoadRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+	roads := createTestRoads(t, repo, insertTestUser(t, db), now, now)
+	if err := repo.Delete(ctx, roads[0].ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	count, err := repo.Count(ctx)
+	if err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+	var deleted int
+	if err := db.Get(&deleted, `SELECT COUNT(*) FROM damaged_roads WHERE deleted_at IS NOT NULL`); err != nil {
+		t.Fatalf("count deleted reports error = %v", err)
+	}
+
+	filters := entities.NewDamagedRoadFilters()
+	if _, total, err := repo.List(ctx, filters); err != nil || total != count {
+		t.Errorf("List() without filters total = %d, %v, want Count() = %d", total, err, count)
+	}
+
+	// Including deleted reports changes the total, so it must count as a filter
+	filters.IncludeDeleted = true
+	if _, total, err := repo.List(ctx, filters); err != nil || total != count+deleted || !filters.IsFiltered() {
+		t.Errorf("List() with deleted total = %d, %v (filtered %v), want %d and filtered", total, err, filters.IsFiltered(), count+deleted)
+	}
+}
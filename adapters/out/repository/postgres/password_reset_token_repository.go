@@ -84,12 +84,7 @@ func (r *PasswordResetTokenRepository) DeleteByUserID(ctx context.Context, userI
 	return err
 }
 
-// DeleteExpired deletes all expired password reset tokens
-func (r *PasswordResetTokenRepository) DeleteExpired(ctx context.Context) error {
-	query := `
-		DELETE FROM password_reset_tokens
-		WHERE expires_at < NOW()
-	`
-	_, err := r.db.ExecContext(ctx, query)
-	return err
+// DeleteExpired deletes all expired password reset tokens, batchSize rows per statement
+func (r *PasswordResetTokenRepository) DeleteExpired(ctx context.Context, batchSize int) (int64, error) {
+	return deleteExpiredInBatches(ctx, r.db, "password_reset_tokens", batchSize)
 }
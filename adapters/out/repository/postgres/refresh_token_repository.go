@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
 	"github.com/nicklaros/jalanrusak-be/core/ports/external"
 )
 
@@ -24,8 +25,8 @@ func NewRefreshTokenRepository(db *sql.DB) external.RefreshTokenRepository {
 // Create creates a new refresh token
 func (r *RefreshTokenRepository) Create(ctx context.Context, token *entities.RefreshToken) error {
 	query := `
-		INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, revoked, created_at, last_used_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, revoked, created_at, last_used_at, device_id, family_id, parent_id, ip_address, user_agent)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 	`
 	_, err := r.db.ExecContext(ctx, query,
 		token.ID,
@@ -35,21 +36,38 @@ func (r *RefreshTokenRepository) Create(ctx context.Context, token *entities.Ref
 		token.Revoked,
 		token.CreatedAt,
 		token.LastUsedAt,
+		token.DeviceID,
+		token.FamilyID,
+		token.ParentID,
+		token.IPAddress,
+		token.UserAgent,
 	)
 	return err
 }
 
 // FindByTokenHash retrieves a refresh token by its hash
 func (r *RefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	return r.findOne(ctx, "token_hash = $1", tokenHash)
+}
+
+// FindByID retrieves a refresh token by its ID
+func (r *RefreshTokenRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error) {
+	return r.findOne(ctx, "id = $1", id)
+}
+
+// findOne retrieves the refresh token matching a single-argument condition, or nil if none does
+func (r *RefreshTokenRepository) findOne(ctx context.Context, condition string, arg interface{}) (*entities.RefreshToken, error) {
 	query := `
-		SELECT id, user_id, token_hash, expires_at, revoked, created_at, last_used_at
+		SELECT id, user_id, token_hash, expires_at, revoked, created_at, last_used_at, device_id, family_id, parent_id, ip_address, user_agent
 		FROM refresh_tokens
-		WHERE token_hash = $1
-	`
+		WHERE ` + condition
 	token := &entities.RefreshToken{}
 	var lastUsedAt sql.NullTime
+	var deviceID sql.NullString
+	var parentID uuid.NullUUID
+	var ipAddress, userAgent sql.NullString
 
-	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(
 		&token.ID,
 		&token.UserID,
 		&token.TokenHash,
@@ -57,6 +75,11 @@ func (r *RefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash
 		&token.Revoked,
 		&token.CreatedAt,
 		&lastUsedAt,
+		&deviceID,
+		&token.FamilyID,
+		&parentID,
+		&ipAddress,
+		&userAgent,
 	)
 
 	if err == sql.ErrNoRows {
@@ -69,6 +92,18 @@ func (r *RefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash
 	if lastUsedAt.Valid {
 		token.LastUsedAt = &lastUsedAt.Time
 	}
+	if deviceID.Valid {
+		token.DeviceID = &deviceID.String
+	}
+	if parentID.Valid {
+		token.ParentID = &parentID.UUID
+	}
+	if ipAddress.Valid {
+		token.IPAddress = &ipAddress.String
+	}
+	if userAgent.Valid {
+		token.UserAgent = &userAgent.String
+	}
 
 	return token, nil
 }
@@ -76,7 +111,7 @@ func (r *RefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash
 // FindByUserID retrieves all refresh tokens for a user
 func (r *RefreshTokenRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.RefreshToken, error) {
 	query := `
-		SELECT id, user_id, token_hash, expires_at, revoked, created_at, last_used_at
+		SELECT id, user_id, token_hash, expires_at, revoked, created_at, last_used_at, device_id, family_id, parent_id, ip_address, user_agent
 		FROM refresh_tokens
 		WHERE user_id = $1
 		ORDER BY created_at DESC
@@ -91,6 +126,9 @@ func (r *RefreshTokenRepository) FindByUserID(ctx context.Context, userID uuid.U
 	for rows.Next() {
 		token := &entities.RefreshToken{}
 		var lastUsedAt sql.NullTime
+		var deviceID sql.NullString
+		var parentID uuid.NullUUID
+		var ipAddress, userAgent sql.NullString
 
 		err := rows.Scan(
 			&token.ID,
@@ -100,6 +138,11 @@ func (r *RefreshTokenRepository) FindByUserID(ctx context.Context, userID uuid.U
 			&token.Revoked,
 			&token.CreatedAt,
 			&lastUsedAt,
+			&deviceID,
+			&token.FamilyID,
+			&parentID,
+			&ipAddress,
+			&userAgent,
 		)
 		if err != nil {
 			return nil, err
@@ -108,6 +151,18 @@ func (r *RefreshTokenRepository) FindByUserID(ctx context.Context, userID uuid.U
 		if lastUsedAt.Valid {
 			token.LastUsedAt = &lastUsedAt.Time
 		}
+		if deviceID.Valid {
+			token.DeviceID = &deviceID.String
+		}
+		if parentID.Valid {
+			token.ParentID = &parentID.UUID
+		}
+		if ipAddress.Valid {
+			token.IPAddress = &ipAddress.String
+		}
+		if userAgent.Valid {
+			token.UserAgent = &userAgent.String
+		}
 
 		tokens = append(tokens, token)
 	}
@@ -141,6 +196,66 @@ func (r *RefreshTokenRepository) RevokeByUserID(ctx context.Context, userID uuid
 	return err
 }
 
+// Rotate revokes the presented refresh token and inserts its replacement in one transaction.
+// The conditional UPDATE takes a row lock, so a concurrent rotation of the same token waits
+// and then matches no rows
+func (r *RefreshTokenRepository) Rotate(ctx context.Context, presentedID uuid.UUID, replacement *entities.RefreshToken) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	revokeQuery := `
+		UPDATE refresh_tokens
+		SET revoked = true, last_used_at = NOW()
+		WHERE id = $1 AND revoked = false AND expires_at > NOW()
+	`
+	result, err := tx.ExecContext(ctx, revokeQuery, presentedID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errors.ErrTokenRevoked
+	}
+
+	insertQuery := `
+		INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, revoked, created_at, last_used_at, device_id, family_id, parent_id, ip_address, user_agent)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+	_, err = tx.ExecContext(ctx, insertQuery,
+		replacement.ID,
+		replacement.UserID,
+		replacement.TokenHash,
+		replacement.ExpiresAt,
+		replacement.Revoked,
+		replacement.CreatedAt,
+		replacement.LastUsedAt,
+		replacement.DeviceID,
+		replacement.FamilyID,
+		replacement.ParentID,
+		replacement.IPAddress,
+		replacement.UserAgent,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// IsRotated reports whether the token was replaced through rotation, i.e. has a successor
+func (r *RefreshTokenRepository) IsRotated(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM refresh_tokens WHERE parent_id = $1)`
+	var rotated bool
+	err := r.db.QueryRowContext(ctx, query, tokenID).Scan(&rotated)
+	return rotated, err
+}
+
 // RevokeByTokenHash revokes a specific refresh token
 func (r *RefreshTokenRepository) RevokeByTokenHash(ctx context.Context, tokenHash string) error {
 	query := `
@@ -152,12 +267,32 @@ func (r *RefreshTokenRepository) RevokeByTokenHash(ctx context.Context, tokenHas
 	return err
 }
 
-// DeleteExpired deletes all expired refresh tokens
-func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) error {
+// RevokeByID revokes a specific refresh token by its ID
+func (r *RefreshTokenRepository) RevokeByID(ctx context.Context, id uuid.UUID) error {
 	query := `
-		DELETE FROM refresh_tokens
-		WHERE expires_at < NOW()
+		UPDATE refresh_tokens
+		SET revoked = true
+		WHERE id = $1
 	`
-	_, err := r.db.ExecContext(ctx, query)
+	_, err := r.db.ExecContext(ctx, query, id)
 	return err
 }
+
+// RevokeByUserIDAndDeviceID revokes all active refresh tokens issued to one device of a user
+func (r *RefreshTokenRepository) RevokeByUserIDAndDeviceID(ctx context.Context, userID uuid.UUID, deviceID string) (int64, error) {
+	query := `
+		UPDATE refresh_tokens
+		SET revoked = true
+		WHERE user_id = $1 AND device_id = $2 AND revoked = false
+	`
+	result, err := r.db.ExecContext(ctx, query, userID, deviceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// DeleteExpired deletes all expired refresh tokens, batchSize rows per statement
+func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context, batchSize int) (int64, error) {
+	return deleteExpiredInBatches(ctx, r.db, "refresh_tokens", batchSize)
+}
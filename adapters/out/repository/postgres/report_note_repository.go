@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+)
+
+// ReportNoteRepository implements the ReportNoteRepository interface using PostgreSQL
+type ReportNoteRepository struct {
+	db *sqlx.DB
+}
+
+// NewReportNoteRepository creates a new PostgreSQL report note repository
+func NewReportNoteRepository(db *sqlx.DB) external.ReportNoteRepository {
+	return &ReportNoteRepository{db: db}
+}
+
+// reportNoteRow represents the database row structure
+type reportNoteRow struct {
+	ID        uuid.UUID     `db:"id"`
+	ReportID  uuid.UUID     `db:"report_id"`
+	AuthorID  uuid.NullUUID `db:"author_id"` // NULL once the author account is deleted
+	Body      string        `db:"body"`
+	Internal  bool          `db:"internal"`
+	CreatedAt time.Time     `db:"created_at"`
+}
+
+// Create creates a new report note
+func (r *ReportNoteRepository) Create(ctx context.Context, note *entities.ReportNote) error {
+	query := `
+		INSERT INTO report_notes (id, report_id, author_id, body, internal, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		note.ID,
+		note.ReportID,
+		note.AuthorID,
+		note.Body,
+		note.Internal,
+		note.CreatedAt,
+	)
+	if err != nil {
+		return errors.NewDatabaseError("create report note", err)
+	}
+	return nil
+}
+
+// FindByReport retrieves the notes of a report, oldest first
+func (r *ReportNoteRepository) FindByReport(ctx context.Context, reportID uuid.UUID, includeInternal bool) ([]*entities.ReportNote, error) {
+	query := `
+		SELECT id, report_id, author_id, body, internal, created_at
+		FROM report_notes
+		WHERE report_id = $1 AND ($2 OR NOT internal)
+		ORDER BY created_at ASC
+	`
+
+	var rows []reportNoteRow
+	if err := r.db.SelectContext(ctx, &rows, query, reportID, includeInternal); err != nil {
+		return nil, errors.NewDatabaseError("find report notes", err)
+	}
+
+	notes := make([]*entities.ReportNote, 0, len(rows))
+	for _, row := range rows {
+		notes = append(notes, &entities.ReportNote{
+			ID:        row.ID,
+			ReportID:  row.ReportID,
+			AuthorID:  row.AuthorID.UUID,
+			Body:      row.Body,
+			Internal:  row.Internal,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+	return notes, nil
+}
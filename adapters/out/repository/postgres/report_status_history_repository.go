@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+)
+
+// ReportStatusHistoryRepository implements the ReportStatusHistoryRepository interface using PostgreSQL.
+// Entries are written by DamagedRoadRepository.UpdateStatus through insertStatusChange, inside the
+// transaction that updates the report
+type ReportStatusHistoryRepository struct {
+	db *sqlx.DB
+}
+
+// NewReportStatusHistoryRepository creates a new PostgreSQL report status history repository
+func NewReportStatusHistoryRepository(db *sqlx.DB) external.ReportStatusHistoryRepository {
+	return &ReportStatusHistoryRepository{db: db}
+}
+
+// statusChangeRow represents the database row structure
+type statusChangeRow struct {
+	ID         uuid.UUID      `db:"id"`
+	ReportID   uuid.UUID      `db:"report_id"`
+	FromStatus string         `db:"from_status"`
+	ToStatus   string         `db:"to_status"`
+	ChangedBy  uuid.NullUUID  `db:"changed_by"`
+	ChangedAt  time.Time      `db:"changed_at"`
+	Reason     sql.NullString `db:"reason"`
+}
+
+// FindByReport retrieves the status transitions of a report, oldest first
+func (r *ReportStatusHistoryRepository) FindByReport(ctx context.Context, reportID uuid.UUID) ([]*entities.StatusChange, error) {
+	query := `
+		SELECT id, report_id, from_status, to_status, changed_by, changed_at, reason
+		FROM report_status_history
+		WHERE report_id = $1
+		ORDER BY changed_at ASC
+	`
+
+	var rows []statusChangeRow
+	if err := r.db.SelectContext(ctx, &rows, query, reportID); err != nil {
+		return nil, errors.NewDatabaseError("find report status history", err)
+	}
+
+	changes := make([]*entities.StatusChange, 0, len(rows))
+	for _, row := range rows {
+		change := &entities.StatusChange{
+			ID:         row.ID,
+			ReportID:   row.ReportID,
+			FromStatus: entities.Status(row.FromStatus),
+			ToStatus:   entities.Status(row.ToStatus),
+			ChangedBy:  row.ChangedBy.UUID,
+			ChangedAt:  row.ChangedAt,
+		}
+		if row.Reason.Valid {
+			reason := entities.Description(row.Reason.String)
+			change.Reason = &reason
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// insertStatusChange records a status transition using the caller's transaction
+func insertStatusChange(ctx context.Context, tx *sqlx.Tx, change *entities.StatusChange) error {
+	query := `
+		INSERT INTO report_status_history (id, report_id, from_status, to_status, changed_by, changed_at, reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	var reason sql.NullString
+	if change.Reason != nil {
+		reason = sql.NullString{String: change.Reason.String(), Valid: true}
+	}
+
+	_, err := tx.ExecContext(ctx, query,
+		change.ID,
+		change.ReportID,
+		change.FromStatus.String(),
+		change.ToStatus.String(),
+		change.ChangedBy,
+		change.ChangedAt,
+		reason,
+	)
+	if err != nil {
+		return errors.NewDatabaseError("insert status change", err)
+	}
+	return nil
+}
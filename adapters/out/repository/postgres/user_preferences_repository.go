@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+)
+
+// UserPreferencesRepository implements the UserPreferencesRepository interface using PostgreSQL
+type UserPreferencesRepository struct {
+	db *sqlx.DB
+}
+
+// NewUserPreferencesRepository creates a new PostgreSQL user preferences repository
+func NewUserPreferencesRepository(db *sqlx.DB) external.UserPreferencesRepository {
+	return &UserPreferencesRepository{db: db}
+}
+
+// FindByUserID retrieves a user's stored preferences, or nil if they never changed any
+func (r *UserPreferencesRepository) FindByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserPreferences, error) {
+	query := `
+		SELECT user_id, email_on_status_change, updated_at
+		FROM user_preferences
+		WHERE user_id = $1
+	`
+
+	preferences := &entities.UserPreferences{}
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&preferences.UserID,
+		&preferences.EmailOnStatusChange,
+		&preferences.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.NewDatabaseError("find user preferences", err)
+	}
+	return preferences, nil
+}
+
+// Upsert creates or replaces a user's preferences
+func (r *UserPreferencesRepository) Upsert(ctx context.Context, preferences *entities.UserPreferences) error {
+	query := `
+		INSERT INTO user_preferences (user_id, email_on_status_change, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		SET email_on_status_change = EXCLUDED.email_on_status_change,
+			updated_at = EXCLUDED.updated_at
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		preferences.UserID,
+		preferences.EmailOnStatusChange,
+		preferences.UpdatedAt,
+	)
+	if err != nil {
+		return errors.NewDatabaseError("upsert user preferences", err)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/nicklaros/jalanrusak-be/core/ports/external"
 )
 
@@ -29,15 +30,18 @@ func NewJWTTokenGenerator(secretKey string, accessTokenTTLHours int) external.To
 // Claims represents the JWT claims structure
 type Claims struct {
 	UserID string `json:"user_id"`
+	Role   string `json:"role,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// GenerateAccessToken creates a new JWT access token for the given user ID
-func (g *JWTTokenGenerator) GenerateAccessToken(ctx context.Context, userID string) (string, error) {
+// GenerateAccessToken creates a new JWT access token for the given user ID and role
+func (g *JWTTokenGenerator) GenerateAccessToken(ctx context.Context, userID, role string) (string, error) {
 	now := time.Now()
 	claims := Claims{
 		UserID: userID,
+		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
 			ExpiresAt: jwt.NewNumericDate(now.Add(g.accessTokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
@@ -66,8 +70,8 @@ func (g *JWTTokenGenerator) GenerateRefreshToken(ctx context.Context) (string, e
 	return token, nil
 }
 
-// ValidateAccessToken validates an access token and returns the user ID
-func (g *JWTTokenGenerator) ValidateAccessToken(ctx context.Context, tokenString string) (userID string, err error) {
+// ValidateAccessToken validates an access token and returns its claims
+func (g *JWTTokenGenerator) ValidateAccessToken(ctx context.Context, tokenString string) (*external.AccessTokenClaims, error) {
 	// Parse token
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		// Verify signing method
@@ -78,16 +82,24 @@ func (g *JWTTokenGenerator) ValidateAccessToken(ctx context.Context, tokenString
 	})
 
 	if err != nil {
-		return "", fmt.Errorf("failed to parse token: %w", err)
+		return nil, fmt.Errorf("failed to parse token: %w", err)
 	}
 
 	// Extract claims
 	claims, ok := token.Claims.(*Claims)
 	if !ok || !token.Valid {
-		return "", fmt.Errorf("invalid token claims")
+		return nil, fmt.Errorf("invalid token claims")
 	}
 
-	return claims.UserID, nil
+	result := &external.AccessTokenClaims{
+		UserID:  claims.UserID,
+		Role:    claims.Role,
+		TokenID: claims.ID,
+	}
+	if claims.ExpiresAt != nil {
+		result.ExpiresAt = claims.ExpiresAt.Time
+	}
+	return result, nil
 }
 
 // HashToken creates a SHA-256 hash of the token for secure storage
@@ -0,0 +1,51 @@
+package security
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+)
+
+// MemoryTokenDenylist implements the TokenDenylist interface in process memory.
+// Entries are dropped once the token would have expired anyway. The list is not shared
+// between instances and does not survive restarts, so multi-instance deployments need a
+// shared store behind the same interface.
+type MemoryTokenDenylist struct {
+	mu      sync.Mutex
+	entries map[string]time.Time
+}
+
+// NewMemoryTokenDenylist creates an empty in-memory token denylist
+func NewMemoryTokenDenylist() external.TokenDenylist {
+	return &MemoryTokenDenylist{
+		entries: make(map[string]time.Time),
+	}
+}
+
+// Add denylists a token until its expiry
+func (d *MemoryTokenDenylist) Add(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// Prune on write so the map only holds tokens that are still otherwise valid
+	now := time.Now()
+	for id, expiry := range d.entries {
+		if now.After(expiry) {
+			delete(d.entries, id)
+		}
+	}
+
+	d.entries[tokenID] = expiresAt
+	return nil
+}
+
+// Contains reports whether a token has been denylisted and has not yet expired
+func (d *MemoryTokenDenylist) Contains(ctx context.Context, tokenID string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	expiry, ok := d.entries[tokenID]
+	return ok && time.Now().Before(expiry), nil
+}
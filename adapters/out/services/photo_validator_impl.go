@@ -6,52 +6,306 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
 )
 
-// photoValidatorImpl implements external.PhotoValidator with SSRF protection
+// DefaultMaxFetchesPerBatch is the outbound fetch cap used when none is configured
+const DefaultMaxFetchesPerBatch = 10
+
+// DefaultMaxConcurrentFetches is how many photo URLs one ValidateURLs call checks at once
+// when none is configured
+const DefaultMaxConcurrentFetches = 5
+
+// DefaultBatchTimeout bounds a whole ValidateURLs call when no timeout is configured
+const DefaultBatchTimeout = 15 * time.Second
+
+// DefaultMaxPhotoSizeBytes is the photo size limit used when none is configured (5 MB)
+const DefaultMaxPhotoSizeBytes int64 = 5 << 20
+
+// PhotoAgeCheckMode selects what happens to photos whose Last-Modified is older than the maximum age
+type PhotoAgeCheckMode string
+
+const (
+	// PhotoAgeCheckOff skips the Last-Modified check
+	PhotoAgeCheckOff PhotoAgeCheckMode = "off"
+	// PhotoAgeCheckAdvisory logs old photos and flags them with a warning but accepts them
+	PhotoAgeCheckAdvisory PhotoAgeCheckMode = "advisory"
+	// PhotoAgeCheckEnforce rejects old photos
+	PhotoAgeCheckEnforce PhotoAgeCheckMode = "enforce"
+)
+
+// PhotoHTTPClientConfig holds the transport settings of the client that fetches photo URLs
+type PhotoHTTPClientConfig struct {
+	// Timeout bounds each photo request, redirects included (5 seconds per FR-004)
+	Timeout time.Duration
+	// MaxRedirects is how many redirects are followed, each re-checked for SSRF
+	MaxRedirects int
+	// MaxIdleConnsPerHost is how many keep-alive connections are kept per photo host
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout is how long an unused keep-alive connection is kept
+	IdleConnTimeout time.Duration
+}
+
+// DefaultPhotoHTTPClientConfig returns the settings of the shared photo HTTP client
+func DefaultPhotoHTTPClientConfig() PhotoHTTPClientConfig {
+	return PhotoHTTPClientConfig{
+		Timeout:             5 * time.Second,
+		MaxRedirects:        3,
+		MaxIdleConnsPerHost: 4,
+		IdleConnTimeout:     90 * time.Second,
+	}
+}
+
+var (
+	sharedPhotoHTTPClient     *http.Client
+	sharedPhotoHTTPClientOnce sync.Once
+)
+
+// SharedPhotoHTTPClient returns the process-wide photo HTTP client built from
+// DefaultPhotoHTTPClientConfig, so validators that are not given a client share one
+// connection pool instead of each opening their own
+func SharedPhotoHTTPClient() *http.Client {
+	sharedPhotoHTTPClientOnce.Do(func() {
+		sharedPhotoHTTPClient = NewPhotoHTTPClient(DefaultPhotoHTTPClientConfig())
+	})
+	return sharedPhotoHTTPClient
+}
+
+// NewPhotoHTTPClient creates an HTTP client for fetching photo URLs with its own transport.
+// Redirect targets go through the same SSRF checks as the original URL.
+// The client is safe for concurrent use and meant to be shared between validators.
+func NewPhotoHTTPClient(config PhotoHTTPClientConfig) *http.Client {
+	return newPhotoHTTPClient(config, net.DefaultResolver)
+}
+
+// newPhotoHTTPClient creates a photo HTTP client whose SSRF checks resolve hosts with resolver
+func newPhotoHTTPClient(config PhotoHTTPClientConfig, resolver ipResolver) *http.Client {
+	defaults := DefaultPhotoHTTPClientConfig()
+	if config.Timeout <= 0 {
+		config.Timeout = defaults.Timeout
+	}
+	if config.MaxRedirects < 0 {
+		config.MaxRedirects = 0
+	}
+	if config.MaxIdleConnsPerHost <= 0 {
+		config.MaxIdleConnsPerHost = defaults.MaxIdleConnsPerHost
+	}
+	if config.IdleConnTimeout <= 0 {
+		config.IdleConnTimeout = defaults.IdleConnTimeout
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Dial only addresses that pass the SSRF check, and go direct: through a proxy the
+	// check would apply to the proxy's address instead of the photo host's
+	transport.DialContext = newSSRFSafeDialer(resolver).DialContext
+	transport.Proxy = nil
+	transport.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+	transport.IdleConnTimeout = config.IdleConnTimeout
+	transport.ResponseHeaderTimeout = config.Timeout
+
+	maxRedirects := config.MaxRedirects
+	return &http.Client{
+		Timeout:   config.Timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			// Prevent redirect loops
+			if len(via) > maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			// Validate redirect target for SSRF
+			if err := validateURL(req.Context(), resolver, req.URL.String()); err != nil {
+				return fmt.Errorf("unsafe redirect target: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+// ipResolver looks up the IP addresses of a host, satisfied by *net.Resolver
+type ipResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// ssrfSafeDialer resolves hosts itself and connects only to addresses that pass the SSRF
+// check. validateURL checks the addresses of an earlier, separate lookup; re-checking at
+// dial time means a host that resolves to a private address by then (DNS rebinding) is
+// refused instead of connected to
+type ssrfSafeDialer struct {
+	resolver ipResolver
+	dialer   *net.Dialer
+}
+
+// newSSRFSafeDialer creates a dialer with the same connect settings as http.DefaultTransport
+func newSSRFSafeDialer(resolver ipResolver) *ssrfSafeDialer {
+	return &ssrfSafeDialer{
+		resolver: resolver,
+		dialer: &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		},
+	}
+}
+
+// DialContext resolves the host of addr, refuses the connection if any address is private or
+// reserved, and otherwise dials the checked addresses in order until one connects
+func (d *ssrfSafeDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs, err := d.resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no addresses found for %s", host)
+	}
+	for _, ipAddr := range addrs {
+		if isPrivateOrReservedIP(ipAddr.IP) {
+			return nil, fmt.Errorf("refusing to connect to %s: private, reserved, or link-local IP address %s (SSRF protection)", host, ipAddr.IP.String())
+		}
+	}
+
+	var dialErr error
+	for _, ipAddr := range addrs {
+		conn, err := d.dialer.DialContext(ctx, network, net.JoinHostPort(ipAddr.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+		dialErr = err
+	}
+	return nil, dialErr
+}
+
+// PhotoValidatorConfig holds tunable limits for the photo validator
+type PhotoValidatorConfig struct {
+	// MaxFetchesPerBatch is a hard cap on URLs fetched per ValidateURLs call,
+	// independent of the report photo limit. URLs past the cap are rejected unfetched
+	MaxFetchesPerBatch int
+
+	// MaxConcurrentFetches is how many URLs of one ValidateURLs call are checked at once.
+	// Zero uses DefaultMaxConcurrentFetches
+	MaxConcurrentFetches int
+
+	// BatchTimeout bounds a whole ValidateURLs call so that slow hosts cannot hold up the
+	// batch; URLs still unchecked when it expires are returned invalid. Zero uses DefaultBatchTimeout
+	BatchTimeout time.Duration
+
+	// StorageHost is the public host of the service's own object storage.
+	// When set, photo URLs must point to it or one of its subdomains
+	StorageHost string
+
+	// AllowedHosts restricts photo URLs to these hosts and their subdomains, e.g. a storage
+	// bucket domain. Checked before any network call; the SSRF checks still apply to allowed
+	// hosts. Combined with StorageHost when both are set; empty allows any host
+	AllowedHosts []string
+
+	// TrustedHosts lists known-good hosts (and their subdomains) whose URLs skip the
+	// HEAD request. They still go through the scheme and SSRF checks and don't count
+	// towards MaxFetchesPerBatch
+	TrustedHosts []string
+
+	// MaxSizeBytes rejects photos whose reported size is larger. Zero uses DefaultMaxPhotoSizeBytes
+	MaxSizeBytes int64
+
+	// ProbeUnknownSize sends a ranged GET for the first byte when the HEAD response has no
+	// Content-Length, to learn the size from Content-Range. Photos of unknown size are accepted
+	ProbeUnknownSize bool
+
+	// AgeCheck decides what happens to photos whose Last-Modified header is older than MaxAge,
+	// since old photos may be recycled evidence. Photos without the header always pass. Empty means off
+	AgeCheck PhotoAgeCheckMode
+	MaxAge   time.Duration
+
+	// HTTPClient fetches photo URLs; nil uses SharedPhotoHTTPClient
+	HTTPClient *http.Client
+}
+
+// photoValidatorImpl implements external.PhotoValidator with SSRF protection.
+// It is not modified after construction, so one instance may serve any number of
+// goroutines; the underlying http.Client is itself safe for concurrent use.
 type photoValidatorImpl struct {
 	httpClient *http.Client
+	config     PhotoValidatorConfig
+	resolver   ipResolver // resolves hosts for the SSRF check before each request
 }
 
-// NewPhotoValidator creates a new PhotoValidator with 5-second timeout per FR-004
-func NewPhotoValidator() external.PhotoValidator {
+// NewPhotoValidator creates a new PhotoValidator. Requests time out after the HTTP client's
+// timeout, 5 seconds per FR-004 for the shared client
+func NewPhotoValidator(config PhotoValidatorConfig) external.PhotoValidator {
+	if config.MaxFetchesPerBatch <= 0 {
+		config.MaxFetchesPerBatch = DefaultMaxFetchesPerBatch
+	}
+	if config.MaxConcurrentFetches <= 0 {
+		config.MaxConcurrentFetches = DefaultMaxConcurrentFetches
+	}
+	if config.BatchTimeout <= 0 {
+		config.BatchTimeout = DefaultBatchTimeout
+	}
+	if config.AgeCheck == "" || config.MaxAge <= 0 {
+		config.AgeCheck = PhotoAgeCheckOff
+	}
+	if config.MaxSizeBytes <= 0 {
+		config.MaxSizeBytes = DefaultMaxPhotoSizeBytes
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = SharedPhotoHTTPClient()
+	}
+	config.StorageHost = strings.ToLower(strings.TrimSpace(config.StorageHost))
+	config.TrustedHosts = normalizeHosts(config.TrustedHosts)
+	config.AllowedHosts = normalizeHosts(config.AllowedHosts)
+	if config.StorageHost != "" {
+		config.AllowedHosts = append(config.AllowedHosts, config.StorageHost)
+	}
+
 	return &photoValidatorImpl{
-		httpClient: &http.Client{
-			Timeout: 5 * time.Second,
-			CheckRedirect: func(req *http.Request, via []*http.Request) error {
-				// Prevent redirect loops (max 3 redirects)
-				if len(via) >= 3 {
-					return fmt.Errorf("stopped after 3 redirects")
-				}
-				// Validate redirect target for SSRF
-				if err := validateURL(req.URL.String()); err != nil {
-					return fmt.Errorf("unsafe redirect target: %w", err)
-				}
-				return nil
-			},
-		},
+		config:     config,
+		httpClient: config.HTTPClient,
+		resolver:   net.DefaultResolver,
 	}
 }
 
-// ValidateURL checks if a single photo URL is valid, accessible, and secure
-func (v *photoValidatorImpl) ValidateURL(urlStr string) external.PhotoValidationResult {
+// ValidateURL checks if a single photo URL is valid, accessible, and secure.
+// The HEAD request ends at the earlier of ctx's deadline and the per-request timeout
+func (v *photoValidatorImpl) ValidateURL(parent context.Context, urlStr string) external.PhotoValidationResult {
 	result := external.PhotoValidationResult{
 		URL:   urlStr,
 		Valid: false,
 	}
 
+	// Don't start checks once the request or batch deadline has passed
+	if parent.Err() != nil {
+		result.Error = "not validated: photo validation deadline exceeded"
+		return result
+	}
+
+	// Check photo is hosted on an allowed host, before any DNS lookup or request
+	if err := v.checkAllowedHost(urlStr); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
 	// Check SSRF protection
-	if err := v.IsSecureURL(urlStr); err != nil {
+	if err := v.IsSecureURL(parent, urlStr); err != nil {
 		result.Error = err.Error()
 		return result
 	}
 
+	// Known-good hosts are accepted without fetching
+	if v.isTrustedHost(urlStr) {
+		result.Valid = true
+		return result
+	}
+
 	// Make HEAD request to check accessibility and content type
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(parent, v.requestTimeout())
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodHead, urlStr, nil)
@@ -83,9 +337,35 @@ func (v *photoValidatorImpl) ValidateURL(urlStr string) external.PhotoValidation
 		return result
 	}
 
-	// Get content length if available
-	if contentLength := resp.ContentLength; contentLength > 0 {
-		result.SizeBytes = contentLength
+	// Get content length if available, probing for it when the HEAD response omits it
+	size := resp.ContentLength
+	if size < 0 && v.config.ProbeUnknownSize {
+		size = v.probeSize(ctx, urlStr)
+	}
+	if size > 0 {
+		result.SizeBytes = size
+	}
+	if size < 0 {
+		addWarning(&result, "photo size could not be verified: no Content-Length")
+	}
+
+	// Check file size
+	if size > v.config.MaxSizeBytes {
+		result.Error = fmt.Sprintf("photo too large: %s exceeds the maximum of %s", formatBytes(size), formatBytes(v.config.MaxSizeBytes))
+		return result
+	}
+
+	// Check photo age by Last-Modified, when the server sends it
+	if message := v.checkLastModified(resp.Header.Get("Last-Modified")); message != "" {
+		if v.config.AgeCheck == PhotoAgeCheckEnforce {
+			result.Error = message
+			return result
+		}
+		logger.WarnContext(ctx, "Accepting old photo", map[string]interface{}{
+			"url":    urlStr,
+			"reason": message,
+		})
+		addWarning(&result, message)
 	}
 
 	result.Valid = true
@@ -93,22 +373,218 @@ func (v *photoValidatorImpl) ValidateURL(urlStr string) external.PhotoValidation
 	return result
 }
 
-// ValidateURLs checks multiple photo URLs
-func (v *photoValidatorImpl) ValidateURLs(urls []string) []external.PhotoValidationResult {
+// addWarning appends a warning to the result, keeping earlier ones
+func addWarning(result *external.PhotoValidationResult, message string) {
+	if result.Warning != "" {
+		result.Warning += "; "
+	}
+	result.Warning += message
+}
+
+// probeSize requests the first byte of the photo and reads its total size from the
+// Content-Range header. Returns -1 when the size cannot be determined
+func (v *photoValidatorImpl) probeSize(ctx context.Context, urlStr string) int64 {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return -1
+	}
+	req.Header.Set("User-Agent", "JalanRusak-PhotoValidator/1.0")
+	req.Header.Set("Range", "bytes=0-0")
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return -1
+	}
+	// The body is never read: at most one byte for a ranged reply, the full photo otherwise
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		// Content-Range: bytes 0-0/<total>, where total may be "*" when unknown
+		_, total, ok := strings.Cut(resp.Header.Get("Content-Range"), "/")
+		if !ok {
+			return -1
+		}
+		size, err := strconv.ParseInt(strings.TrimSpace(total), 10, 64)
+		if err != nil {
+			return -1
+		}
+		return size
+	case http.StatusOK:
+		// Range not supported; the full response length is the photo size
+		return resp.ContentLength
+	default:
+		return -1
+	}
+}
+
+// checkLastModified describes why a photo is too old, or returns "" when the age check is off,
+// the header is missing or unparsable, or the photo is recent enough
+func (v *photoValidatorImpl) checkLastModified(header string) string {
+	if v.config.AgeCheck == PhotoAgeCheckOff || header == "" {
+		return ""
+	}
+
+	lastModified, err := http.ParseTime(header)
+	if err != nil {
+		return ""
+	}
+
+	age := time.Since(lastModified)
+	if age <= v.config.MaxAge {
+		return ""
+	}
+	return fmt.Sprintf("photo too old: last modified %s, more than %s ago",
+		lastModified.UTC().Format(time.DateOnly), formatAge(v.config.MaxAge))
+}
+
+// formatAge renders a maximum age for error messages in days, or as a duration below one day
+func formatAge(age time.Duration) string {
+	if age < 24*time.Hour {
+		return age.String()
+	}
+	days := int(age / (24 * time.Hour))
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
+
+// formatBytes renders a byte count for error messages, e.g. 5 MB or 740 KB
+func formatBytes(size int64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%d bytes", size)
+	}
+}
+
+// requestTimeout is the deadline of one HEAD request, following the client's timeout
+func (v *photoValidatorImpl) requestTimeout() time.Duration {
+	if v.httpClient.Timeout > 0 {
+		return v.httpClient.Timeout
+	}
+	return DefaultPhotoHTTPClientConfig().Timeout
+}
+
+// ValidateURLs checks multiple photo URLs, fetching at most MaxFetchesPerBatch of them.
+// URLs on trusted hosts are never fetched and don't count towards the cap.
+// Up to MaxConcurrentFetches URLs are checked at once within BatchTimeout, or ctx's deadline
+// if earlier; results keep the order of urls
+func (v *photoValidatorImpl) ValidateURLs(parent context.Context, urls []string) []external.PhotoValidationResult {
 	results := make([]external.PhotoValidationResult, len(urls))
+
+	// Apply the fetch cap in input order before fanning out, so which URLs are rejected
+	// does not depend on scheduling
+	pending := make([]int, 0, len(urls))
+	fetches := 0
 	for i, urlStr := range urls {
-		results[i] = v.ValidateURL(urlStr)
+		if !v.isTrustedHost(urlStr) {
+			fetches++
+			if fetches > v.config.MaxFetchesPerBatch {
+				results[i] = external.PhotoValidationResult{
+					URL:        urlStr,
+					Valid:      false,
+					Error:      fmt.Sprintf("not validated: exceeds the maximum of %d photo URLs checked per request", v.config.MaxFetchesPerBatch),
+					NotChecked: true,
+				}
+				continue
+			}
+		}
+		pending = append(pending, i)
 	}
+
+	ctx, cancel := context.WithTimeout(parent, v.config.BatchTimeout)
+	defer cancel()
+
+	// Each worker writes only the result slots of the indexes it receives
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(v.config.MaxConcurrentFetches, len(pending)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = v.ValidateURL(ctx, urls[i])
+			}
+		}()
+	}
+	for _, i := range pending {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
 	return results
 }
 
 // IsSecureURL checks if URL passes SSRF protection
-func (v *photoValidatorImpl) IsSecureURL(urlStr string) error {
-	return validateURL(urlStr)
+func (v *photoValidatorImpl) IsSecureURL(ctx context.Context, urlStr string) error {
+	return validateURL(ctx, v.resolver, urlStr)
+}
+
+// checkAllowedHost rejects URLs outside the allowed hosts (including the storage host), if any
+func (v *photoValidatorImpl) checkAllowedHost(urlStr string) error {
+	if len(v.config.AllowedHosts) == 0 {
+		return nil
+	}
+
+	parsed, err := url.Parse(urlStr)
+	if err != nil {
+		return fmt.Errorf("invalid URL format: %w", err)
+	}
+
+	for _, host := range v.config.AllowedHosts {
+		if hostMatches(parsed.Hostname(), host) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("photo must be hosted on %s (external hosts are not allowed)", strings.Join(v.config.AllowedHosts, ", "))
+}
+
+// normalizeHosts lowercases and trims host names into a new slice, dropping empty entries,
+// so that normalizing does not write to the caller's slice
+func normalizeHosts(hosts []string) []string {
+	normalized := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			normalized = append(normalized, host)
+		}
+	}
+	return normalized
+}
+
+// isTrustedHost reports whether the URL points to one of the configured trusted hosts
+func (v *photoValidatorImpl) isTrustedHost(urlStr string) bool {
+	if len(v.config.TrustedHosts) == 0 {
+		return false
+	}
+
+	parsed, err := url.Parse(urlStr)
+	if err != nil {
+		return false
+	}
+
+	for _, host := range v.config.TrustedHosts {
+		if hostMatches(parsed.Hostname(), host) {
+			return true
+		}
+	}
+	return false
+}
+
+// hostMatches reports whether hostname is host or one of its subdomains
+func hostMatches(hostname, host string) bool {
+	hostname = strings.ToLower(hostname)
+	return host != "" && (hostname == host || strings.HasSuffix(hostname, "."+host))
 }
 
-// validateURL performs comprehensive SSRF protection checks
-func validateURL(urlStr string) error {
+// validateURL performs comprehensive SSRF protection checks, resolving the host with resolver
+func validateURL(ctx context.Context, resolver ipResolver, urlStr string) error {
 	parsed, err := url.Parse(urlStr)
 	if err != nil {
 		return fmt.Errorf("invalid URL format: %w", err)
@@ -132,15 +608,15 @@ func validateURL(urlStr string) error {
 	}
 
 	// Resolve hostname to IP addresses
-	ips, err := net.LookupIP(hostname)
+	addrs, err := resolver.LookupIPAddr(ctx, hostname)
 	if err != nil {
 		return fmt.Errorf("failed to resolve hostname: %w", err)
 	}
 
 	// Check all resolved IPs
-	for _, ip := range ips {
-		if isPrivateOrReservedIP(ip) {
-			return fmt.Errorf("private, reserved, or link-local IP addresses are not allowed: %s (SSRF protection)", ip.String())
+	for _, addr := range addrs {
+		if isPrivateOrReservedIP(addr.IP) {
+			return fmt.Errorf("private, reserved, or link-local IP addresses are not allowed: %s (SSRF protection)", addr.IP.String())
 		}
 	}
 
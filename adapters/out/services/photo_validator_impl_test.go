@@ -0,0 +1,471 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// staticResolver answers lookups from a fixed table, so tests can give hosts public addresses
+type staticResolver map[string][]net.IPAddr
+
+func (r staticResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	addrs, ok := r[host]
+	if !ok {
+		return nil, fmt.Errorf("no such host %s", host)
+	}
+	return addrs, nil
+}
+
+// rebindingResolver simulates DNS rebinding: the first lookup of a host returns a public
+// address, every later one returns loopback
+type rebindingResolver struct {
+	lookups atomic.Int32
+}
+
+func (r *rebindingResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	if r.lookups.Add(1) == 1 {
+		return []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}}, nil
+	}
+	return []net.IPAddr{{IP: net.ParseIP("127.0.0.1")}}, nil
+}
+
+// testPhotoHost is the host photo URLs point to; the test resolver gives it a public address
+const testPhotoHost = "photos.example.test"
+
+// newTestPhotoServer serves photo responses for the paths the tests use
+func newTestPhotoServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/photo.jpg":
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.Header().Set("Content-Length", "2048")
+		case "/old.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Header().Set("Content-Length", "1024")
+			w.Header().Set("Last-Modified", time.Now().AddDate(-5, 0, 0).UTC().Format(http.TimeFormat))
+		case "/huge.webp":
+			w.Header().Set("Content-Type", "image/webp")
+			w.Header().Set("Content-Length", fmt.Sprint(DefaultMaxPhotoSizeBytes+1))
+		case "/page.html":
+			w.Header().Set("Content-Type", "text/html")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTestPhotoValidator returns a validator whose SSRF check sees testPhotoHost as a public
+// host and whose client connects every request to server
+func newTestPhotoValidator(server *httptest.Server, config PhotoValidatorConfig) *photoValidatorImpl {
+	client := server.Client()
+	transport := client.Transport.(*http.Transport).Clone()
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+	client.Transport = transport
+	config.HTTPClient = client
+
+	validator := NewPhotoValidator(config).(*photoValidatorImpl)
+	validator.resolver = staticResolver{
+		testPhotoHost: {{IP: net.ParseIP("93.184.216.34")}},
+	}
+	return validator
+}
+
+func TestValidateURLConcurrent(t *testing.T) {
+	server := newTestPhotoServer(t)
+	validator := newTestPhotoValidator(server, PhotoValidatorConfig{
+		AgeCheck: PhotoAgeCheckAdvisory,
+		MaxAge:   365 * 24 * time.Hour,
+	})
+
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	base := "http://" + net.JoinHostPort(testPhotoHost, port)
+	cases := []struct {
+		url         string
+		valid       bool
+		wantWarning bool
+	}{
+		{url: base + "/photo.jpg", valid: true},
+		{url: base + "/old.png", valid: true, wantWarning: true},
+		{url: base + "/huge.webp", valid: false},
+		{url: base + "/page.html", valid: false},
+		{url: base + "/missing.jpg", valid: false},
+		{url: "http://127.0.0.1:" + port + "/photo.jpg", valid: false}, // SSRF check
+	}
+
+	const goroutines = 32
+	const iterations = 25
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				tc := cases[(g+i)%len(cases)]
+				result := validator.ValidateURL(context.Background(), tc.url)
+				if result.Valid != tc.valid {
+					t.Errorf("ValidateURL(%s) valid = %v, want %v (error %q)", tc.url, result.Valid, tc.valid, result.Error)
+				}
+				if tc.wantWarning && result.Warning == "" {
+					t.Errorf("ValidateURL(%s) returned no warning, want a photo age warning", tc.url)
+				}
+			}
+		}(g)
+	}
+
+	// Batches share the validator with the single-URL calls above
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			urls := make([]string, len(cases))
+			for i, tc := range cases {
+				urls[i] = tc.url
+			}
+			for i, result := range validator.ValidateURLs(context.Background(), urls) {
+				if result.Valid != cases[i].valid {
+					t.Errorf("ValidateURLs()[%d] valid = %v, want %v (error %q)", i, result.Valid, cases[i].valid, result.Error)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestNewPhotoValidatorSharesHTTPClient(t *testing.T) {
+	const goroutines = 16
+	clients := make([]*http.Client, goroutines)
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			clients[g] = NewPhotoValidator(PhotoValidatorConfig{}).(*photoValidatorImpl).httpClient
+		}(g)
+	}
+	wg.Wait()
+
+	shared := SharedPhotoHTTPClient()
+	for g, client := range clients {
+		if client != shared {
+			t.Errorf("validator %d has its own HTTP client, want the shared one", g)
+		}
+	}
+}
+
+func TestValidateURLRefusesDNSRebinding(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.Header().Set("Content-Length", "2048")
+	}))
+	defer server.Close()
+
+	// The URL check sees a public address, the dial that follows gets the loopback server
+	resolver := &rebindingResolver{}
+	validator := NewPhotoValidator(PhotoValidatorConfig{
+		HTTPClient: newPhotoHTTPClient(DefaultPhotoHTTPClientConfig(), resolver),
+	}).(*photoValidatorImpl)
+	validator.resolver = resolver
+
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	result := validator.ValidateURL(context.Background(), "http://"+net.JoinHostPort("rebind.example.test", port)+"/photo.jpg")
+
+	if result.Valid {
+		t.Fatal("ValidateURL() accepted a host that rebound to loopback")
+	}
+	if !strings.Contains(result.Error, "SSRF") {
+		t.Errorf("ValidateURL() error = %q, want the dial refused by the SSRF check", result.Error)
+	}
+	if got := resolver.lookups.Load(); got != 2 {
+		t.Errorf("host resolved %d times, want once for the URL check and once for the dial", got)
+	}
+	if got := requests.Load(); got != 0 {
+		t.Errorf("server received %d requests, want the connection refused", got)
+	}
+}
+
+func TestValidateURLMaxSize(t *testing.T) {
+	const limit = 1 << 20
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg")
+		switch r.URL.Path {
+		case "/small.jpg":
+			w.Header().Set("Content-Length", fmt.Sprint(limit))
+		case "/large.jpg":
+			w.Header().Set("Content-Length", fmt.Sprint(2*limit))
+		case "/unsized.jpg":
+			// HEAD answered without Content-Length; a ranged GET reveals the size
+			if r.Method == http.MethodGet && r.Header.Get("Range") == "bytes=0-0" {
+				w.Header().Set("Content-Range", fmt.Sprintf("bytes 0-0/%d", 3*limit))
+				w.WriteHeader(http.StatusPartialContent)
+				w.Write([]byte{0xff})
+			}
+		}
+	}))
+	defer server.Close()
+
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	base := "http://" + net.JoinHostPort(testPhotoHost, port)
+	tests := []struct {
+		name      string
+		path      string
+		probe     bool
+		wantValid bool
+		wantError string
+	}{
+		{name: "at the limit", path: "/small.jpg", wantValid: true},
+		{name: "over the limit", path: "/large.jpg", wantError: "photo too large: 2.0 MB exceeds the maximum of 1.0 MB"},
+		{name: "unknown size without probe", path: "/unsized.jpg", wantValid: true},
+		{name: "unknown size probed", path: "/unsized.jpg", probe: true, wantError: "photo too large: 3.0 MB exceeds the maximum of 1.0 MB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := newTestPhotoValidator(server, PhotoValidatorConfig{MaxSizeBytes: limit, ProbeUnknownSize: tt.probe})
+			result := validator.ValidateURL(context.Background(), base+tt.path)
+			if result.Valid != tt.wantValid || result.Error != tt.wantError {
+				t.Errorf("ValidateURL() = valid %v error %q, want valid %v error %q", result.Valid, result.Error, tt.wantValid, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestNewPhotoValidatorDefaultMaxSize(t *testing.T) {
+	validator := NewPhotoValidator(PhotoValidatorConfig{}).(*photoValidatorImpl)
+	if validator.config.MaxSizeBytes != 5<<20 {
+		t.Errorf("MaxSizeBytes = %d, want the 5 MB default", validator.config.MaxSizeBytes)
+	}
+}
+
+func TestValidateURLPhotoAge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.Header().Set("Content-Length", "2048")
+		switch r.URL.Path {
+		case "/old.jpg":
+			w.Header().Set("Last-Modified", time.Now().AddDate(-3, 0, 0).UTC().Format(http.TimeFormat))
+		case "/recent.jpg":
+			w.Header().Set("Last-Modified", time.Now().AddDate(0, 0, -7).UTC().Format(http.TimeFormat))
+		}
+	}))
+	defer server.Close()
+
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	base := "http://" + net.JoinHostPort(testPhotoHost, port)
+	tests := []struct {
+		name        string
+		mode        PhotoAgeCheckMode
+		path        string
+		wantValid   bool
+		wantWarning bool
+	}{
+		{name: "old photo, check off by default", path: "/old.jpg", wantValid: true},
+		{name: "old photo, advisory", mode: PhotoAgeCheckAdvisory, path: "/old.jpg", wantValid: true, wantWarning: true},
+		{name: "old photo, enforced", mode: PhotoAgeCheckEnforce, path: "/old.jpg"},
+		{name: "recent photo, advisory", mode: PhotoAgeCheckAdvisory, path: "/recent.jpg", wantValid: true},
+		{name: "recent photo, enforced", mode: PhotoAgeCheckEnforce, path: "/recent.jpg", wantValid: true},
+		{name: "no Last-Modified, enforced", mode: PhotoAgeCheckEnforce, path: "/undated.jpg", wantValid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := PhotoValidatorConfig{AgeCheck: tt.mode}
+			if tt.mode != "" {
+				config.MaxAge = 365 * 24 * time.Hour
+			}
+			result := newTestPhotoValidator(server, config).ValidateURL(context.Background(), base+tt.path)
+
+			if result.Valid != tt.wantValid {
+				t.Errorf("ValidateURL() valid = %v, want %v (error %q)", result.Valid, tt.wantValid, result.Error)
+			}
+			if !tt.wantValid && !strings.HasPrefix(result.Error, "photo too old") {
+				t.Errorf("ValidateURL() error = %q, want a photo age error", result.Error)
+			}
+			if gotWarning := strings.Contains(result.Warning, "photo too old"); gotWarning != tt.wantWarning {
+				t.Errorf("ValidateURL() warning = %q, want age warning %v", result.Warning, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestValidateURLHonorsCallerContext(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		// Hang like a slow photo host until the client gives up
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	url := "http://" + net.JoinHostPort(testPhotoHost, port) + "/photo.jpg"
+	validator := newTestPhotoValidator(server, PhotoValidatorConfig{})
+
+	// A caller deadline far shorter than the client timeout cuts the HEAD request off
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	result := validator.ValidateURL(ctx, url)
+	if result.Valid || !strings.Contains(result.Error, "context deadline exceeded") {
+		t.Errorf("ValidateURL() = valid %v error %q, want the caller's deadline to end the request", result.Valid, result.Error)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("ValidateURL() took %s, want it to stop at the caller's deadline", elapsed)
+	}
+
+	// An already cancelled caller, e.g. a client that went away, sends no request at all
+	before := requests.Load()
+	cancelled, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+	for _, result := range validator.ValidateURLs(cancelled, []string{url, url}) {
+		if result.Valid || result.Error == "" {
+			t.Errorf("ValidateURLs() result = %+v, want not validated", result)
+		}
+	}
+	if got := requests.Load() - before; got != 0 {
+		t.Errorf("server received %d requests after the caller cancelled, want 0", got)
+	}
+}
+
+// countingResolver records the hosts it is asked to resolve, answering with a public address
+type countingResolver struct {
+	mu    sync.Mutex
+	hosts []string
+}
+
+func (r *countingResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	r.mu.Lock()
+	r.hosts = append(r.hosts, host)
+	r.mu.Unlock()
+	return []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}}, nil
+}
+
+func TestValidateURLAllowedHosts(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.Header().Set("Content-Length", "2048")
+	}))
+	defer server.Close()
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+
+	validator := newTestPhotoValidator(server, PhotoValidatorConfig{AllowedHosts: []string{" Bucket.Example.TEST "}})
+	resolver := &countingResolver{}
+	validator.resolver = resolver
+
+	for _, host := range []string{"bucket.example.test", "eu.bucket.example.test"} {
+		if result := validator.ValidateURL(context.Background(), "http://"+net.JoinHostPort(host, port)+"/photo.jpg"); !result.Valid {
+			t.Errorf("ValidateURL() on %s = %q, want the allowlisted host accepted", host, result.Error)
+		}
+	}
+
+	resolver.hosts = nil
+	before := requests.Load()
+	for _, host := range []string{"photos.example.test", "notbucket.example.test", "bucket.example.test.evil.test"} {
+		result := validator.ValidateURL(context.Background(), "http://"+net.JoinHostPort(host, port)+"/photo.jpg")
+		if result.Valid || !strings.Contains(result.Error, "photo must be hosted on bucket.example.test") {
+			t.Errorf("ValidateURL() on %s = valid %v error %q, want it rejected by the allowlist", host, result.Valid, result.Error)
+		}
+	}
+	if len(resolver.hosts) != 0 || requests.Load() != before {
+		t.Errorf("rejected hosts caused lookups %v and %d requests, want none", resolver.hosts, requests.Load()-before)
+	}
+
+	// The SSRF check still applies to allowlisted hosts
+	validator = NewPhotoValidator(PhotoValidatorConfig{AllowedHosts: []string{"localhost"}}).(*photoValidatorImpl)
+	if result := validator.ValidateURL(context.Background(), "http://localhost:"+port+"/photo.jpg"); result.Valid {
+		t.Error("ValidateURL() accepted an allowlisted host resolving to loopback")
+	}
+}
+
+func TestValidateURLSkipsHeadForTrustedHosts(t *testing.T) {
+	var heads atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			heads.Add(1)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+
+	validator := newTestPhotoValidator(server, PhotoValidatorConfig{TrustedHosts: []string{"CDN.example.test"}, MaxFetchesPerBatch: 1})
+	validator.resolver = &countingResolver{}
+	photoURL := func(host string) string { return "http://" + net.JoinHostPort(host, port) + "/missing.jpg" }
+
+	for _, host := range []string{"cdn.example.test", "img.cdn.example.test"} {
+		if result := validator.ValidateURL(context.Background(), photoURL(host)); !result.Valid {
+			t.Errorf("ValidateURL() on trusted %s = %q, want it accepted without a HEAD", host, result.Error)
+		}
+	}
+	if heads.Load() != 0 {
+		t.Fatalf("%d HEAD requests sent to trusted hosts, want none", heads.Load())
+	}
+
+	for _, host := range []string{"photos.example.test", "notcdn.example.test"} {
+		if result := validator.ValidateURL(context.Background(), photoURL(host)); result.Valid {
+			t.Errorf("ValidateURL() on untrusted %s accepted a 404 photo", host)
+		}
+	}
+	if heads.Load() != 2 {
+		t.Errorf("%d HEAD requests sent to untrusted hosts, want 2", heads.Load())
+	}
+
+	// Trusted URLs do not use up the per-request fetch cap of 1
+	results := validator.ValidateURLs(context.Background(), []string{
+		photoURL("cdn.example.test"), photoURL("img.cdn.example.test"), photoURL("photos.example.test"),
+	})
+	if !results[0].Valid || !results[1].Valid || strings.Contains(results[2].Error, "not validated") {
+		t.Errorf("ValidateURLs() = %+v, want the untrusted URL still fetched", results)
+	}
+
+	// The SSRF check still applies to trusted hosts
+	validator = NewPhotoValidator(PhotoValidatorConfig{TrustedHosts: []string{"localhost"}}).(*photoValidatorImpl)
+	if result := validator.ValidateURL(context.Background(), "http://localhost:"+port+"/photo.jpg"); result.Valid {
+		t.Error("ValidateURL() accepted a trusted host resolving to loopback")
+	}
+}
+
+func TestValidateURLsMarksURLsPastTheFetchCap(t *testing.T) {
+	server := newTestPhotoServer(t)
+	validator := newTestPhotoValidator(server, PhotoValidatorConfig{MaxFetchesPerBatch: 2})
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	base := "http://" + net.JoinHostPort(testPhotoHost, port)
+
+	results := validator.ValidateURLs(context.Background(), []string{
+		base + "/photo.jpg", base + "/missing.jpg", base + "/photo.jpg?copy=1", base + "/photo.jpg?copy=2",
+	})
+
+	if !results[0].Valid || results[0].NotChecked {
+		t.Errorf("first result = %+v, want fetched and valid", results[0])
+	}
+	if results[1].Valid || results[1].NotChecked {
+		t.Errorf("second result = %+v, want fetched and invalid", results[1])
+	}
+	for _, result := range results[2:] {
+		if result.Valid || !result.NotChecked {
+			t.Errorf("result past the cap = %+v, want invalid and marked not checked", result)
+		}
+	}
+}
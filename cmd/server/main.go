@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	_ "github.com/lib/pq"
+	"github.com/nicklaros/jalanrusak-be/adapters/in/http/dto"
 	"github.com/nicklaros/jalanrusak-be/adapters/in/http/handlers"
 	"github.com/nicklaros/jalanrusak-be/adapters/in/http/middleware"
 	"github.com/nicklaros/jalanrusak-be/adapters/in/http/routes"
@@ -15,9 +17,12 @@ import (
 	"github.com/nicklaros/jalanrusak-be/adapters/out/security"
 	outServices "github.com/nicklaros/jalanrusak-be/adapters/out/services"
 	"github.com/nicklaros/jalanrusak-be/config"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
 	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
 	"github.com/nicklaros/jalanrusak-be/core/services"
 	docs "github.com/nicklaros/jalanrusak-be/docs"
+	"github.com/nicklaros/jalanrusak-be/pkg/email"
 	"github.com/ulule/limiter/v3"
 )
 
@@ -37,17 +42,20 @@ func main() {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
-	// Initialize database connection with PostGIS support
+	// Initialize database connection; PostGIS is required unless geospatial features are disabled
+	geometryEnabled := cfg.Database.GeometryBackend != "none"
 	dbConfig := postgres.ConnectionConfig{
-		Host:            cfg.Database.Host,
-		Port:            cfg.Database.Port,
-		User:            cfg.Database.User,
-		Password:        cfg.Database.Password,
-		DBName:          cfg.Database.DBName,
-		SSLMode:         cfg.Database.SSLMode,
-		MaxOpenConns:    cfg.Database.MaxOpenConns,
-		MaxIdleConns:    cfg.Database.MaxIdleConns,
-		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
+		Host:             cfg.Database.Host,
+		Port:             cfg.Database.Port,
+		User:             cfg.Database.User,
+		Password:         cfg.Database.Password,
+		DBName:           cfg.Database.DBName,
+		SSLMode:          cfg.Database.SSLMode,
+		MaxOpenConns:     cfg.Database.MaxOpenConns,
+		MaxIdleConns:     cfg.Database.MaxIdleConns,
+		ConnMaxLifetime:  cfg.Database.ConnMaxLifetime,
+		StatementTimeout: cfg.Database.StatementTimeout,
+		RequirePostGIS:   geometryEnabled,
 	}
 
 	db, err := postgres.NewConnection(dbConfig)
@@ -55,36 +63,58 @@ func main() {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 	defer postgres.Close(db)
-	log.Println("✓ Connected to database with PostGIS support")
+	if geometryEnabled {
+		log.Println("✓ Connected to database with PostGIS support")
+	} else {
+		log.Println("✓ Connected to database; geospatial features disabled (GEOMETRY_BACKEND=none)")
+	}
 
 	// Initialize repositories (driven adapters)
 	userRepo := postgres.NewUserRepository(db.DB)
 	refreshTokenRepo := postgres.NewRefreshTokenRepository(db.DB)
 	passwordResetTokenRepo := postgres.NewPasswordResetTokenRepository(db.DB)
 	authEventLogRepo := postgres.NewAuthEventLogRepository(db.DB)
+	adminAuditLogRepo := postgres.NewAdminAuditLogRepository(db.DB)
 	damagedRoadRepo := postgres.NewDamagedRoadRepository(db)
+	reportNoteRepo := postgres.NewReportNoteRepository(db)
+	statusHistoryRepo := postgres.NewReportStatusHistoryRepository(db)
+	categoryRepo := postgres.NewCategoryRepository(db)
+	userPreferencesRepo := postgres.NewUserPreferencesRepository(db)
 
 	// Initialize security adapters
 	passwordHasher := security.NewBcryptHasher(12) // cost 12 for production
 	tokenGenerator := security.NewJWTTokenGenerator(cfg.JWT.Secret, int(cfg.JWT.AccessTokenTTL.Hours()))
+	tokenDenylist := security.NewMemoryTokenDenylist() // per-process; access tokens are short-lived
 
 	// Initialize messaging adapters
 	var emailService external.EmailService
 	if cfg.Email.ServiceType == "smtp" {
-		// TODO: Implement SMTP email service
-		log.Println("⚠️  SMTP email service not yet implemented, falling back to console")
-		emailService = messaging.NewConsoleEmailService()
+		emailTemplates, err := email.NewRenderer(cfg.Server.FrontendBaseURL)
+		if err != nil {
+			log.Fatalf("Failed to load email templates: %v", err)
+		}
+		emailService = messaging.NewSMTPEmailService(messaging.SMTPConfig{
+			Host:        cfg.Email.SMTPHost,
+			Port:        cfg.Email.SMTPPort,
+			Username:    cfg.Email.SMTPUser,
+			Password:    cfg.Email.SMTPPass,
+			FromName:    cfg.Email.SMTPFromName,
+			FromEmail:   cfg.Email.SMTPFromEmail,
+			DialTimeout: cfg.Email.SMTPDialTimeout,
+			SendTimeout: cfg.Email.SMTPSendTimeout,
+		}, emailTemplates)
 	} else {
 		emailService = messaging.NewConsoleEmailService()
 	}
 
 	// Initialize services (core business logic)
-	userService := services.NewUserService(userRepo, passwordHasher, authEventLogRepo)
+	userService := services.NewUserService(userRepo, passwordHasher, emailService, authEventLogRepo)
 	authService := services.NewAuthService(
 		userRepo,
 		refreshTokenRepo,
 		passwordHasher,
 		tokenGenerator,
+		tokenDenylist,
 		authEventLogRepo,
 		int(cfg.JWT.RefreshTokenTTL.Hours()/24), // convert to days
 	)
@@ -99,45 +129,197 @@ func main() {
 
 	// Initialize boundary repository and geometry service
 	boundaryRepo := postgres.NewBoundaryRepository(db)
-	geometryService := services.NewGeometryService(boundaryRepo)
+	boundaryMode := services.BoundaryMode(cfg.Report.BoundaryMode)
+	if boundaryMode == services.BoundaryModeAny {
+		// Offshore points within the buffer must get past entity and request validation to
+		// reach the geometry service's check; in "all" mode the national bounds stay exact
+		entities.SetBoundaryBuffer(cfg.Report.BoundaryBufferMeters)
+	}
+	geometryService := services.NewGeometryService(boundaryRepo, services.GeometryServiceConfig{
+		BoundaryMode:   boundaryMode,
+		BufferMeters:   cfg.Report.BoundaryBufferMeters,
+		DistanceMethod: services.DistanceMethod(cfg.Report.DistanceMethod),
+	})
+	if !geometryService.IsBoundaryDataAvailable(context.Background()) {
+		log.Println("⚠️  WARNING: subdistrict_centroids is empty - location validation is degraded to national bounds only until boundary data is seeded")
+	}
 
 	// Initialize photo validator with SSRF protection
-	photoValidator := outServices.NewPhotoValidator()
+	photoHTTPClientConfig := outServices.DefaultPhotoHTTPClientConfig()
+	photoHTTPClientConfig.Timeout = cfg.Report.PhotoFetchTimeout
+	photoValidatorConfig := outServices.PhotoValidatorConfig{
+		MaxFetchesPerBatch:   cfg.Report.PhotoMaxFetches,
+		MaxConcurrentFetches: cfg.Report.PhotoConcurrency,
+		BatchTimeout:         cfg.Report.PhotoBatchTimeout,
+		MaxSizeBytes:         cfg.Report.PhotoMaxSizeBytes,
+		ProbeUnknownSize:     cfg.Report.PhotoProbeUnknownSize,
+		AgeCheck:             outServices.PhotoAgeCheckMode(cfg.Report.PhotoMaxAgeMode),
+		MaxAge:               cfg.Report.PhotoMaxAge,
+		TrustedHosts:         cfg.Storage.TrustedPhotoHosts,
+		AllowedHosts:         cfg.Storage.AllowedPhotoHosts,
+		HTTPClient:           outServices.NewPhotoHTTPClient(photoHTTPClientConfig),
+	}
+	if cfg.Storage.EnforcePhotoHost {
+		photoValidatorConfig.StorageHost = cfg.Storage.PublicHost
+	}
+	photoValidator := outServices.NewPhotoValidator(photoValidatorConfig)
+
+	slaPolicy, err := entities.NewSLAPolicy(cfg.Report.SLADurations)
+	if err != nil {
+		log.Fatalf("Invalid REPORT_SLA configuration: %v", err)
+	}
+
+	requireReasonStatuses := make([]entities.Status, 0, len(cfg.Report.RequireReasonStatuses))
+	for _, value := range cfg.Report.RequireReasonStatuses {
+		status := entities.Status(value)
+		if !status.IsValid() {
+			log.Fatalf("Invalid REPORT_REQUIRE_REASON_STATUSES configuration: unknown status %q", value)
+		}
+		requireReasonStatuses = append(requireReasonStatuses, status)
+	}
 
 	// Initialize report service with geometry and photo validation
-	reportService := services.NewReportService(damagedRoadRepo, geometryService, photoValidator)
+	// Initialize event bus for decoupled side effects of domain events
+	eventBus := messaging.NewSyncEventBus()
+	if cfg.Report.LifecycleLogs {
+		messaging.SubscribeReportLifecycleLogger(eventBus)
+	}
+
+	// Email report authors about status changes they did not make, honoring their preferences
+	userPreferencesService := services.NewUserPreferencesService(userPreferencesRepo)
+	services.NewReportStatusNotifier(userRepo, userPreferencesService, emailService).Subscribe(eventBus)
+
+	reportService := services.NewReportService(damagedRoadRepo, statusHistoryRepo, categoryRepo, userRepo, geometryService, photoValidator, eventBus, services.ReportServiceConfig{
+		LenientPhotoValidation:    cfg.Features.LenientPhotoValidation,
+		StrictCentroidCheck:       cfg.Features.StrictCentroidCheck,
+		CentroidRadiusMeters:      cfg.Report.CentroidRadiusMeters,
+		TextSanitization:          entities.TextSanitizationMode(cfg.Report.TextSanitization),
+		NearestReportRadiusMeters: cfg.Report.NearbyRadiusMeters,
+		DuplicateRadiusMeters:     cfg.Report.DuplicateRadiusMeters,
+		SLAPolicy:                 slaPolicy,
+		RequireResolutionPhotos:   cfg.Report.RequireResolutionPhotos,
+		RequireKnownSubDistrict:   cfg.Report.RequireKnownSubDistrict,
+		RequireCategory:           cfg.Report.RequireCategory,
+		RequireReasonStatuses:     requireReasonStatuses,
+		MinReportInterval:         cfg.Report.MinInterval,
+		EditWindow:                cfg.Report.EditWindow,
+		MapResultLimit:            cfg.Report.MapResultLimit,
+		PathOrdering:              services.PathOrderingMode(cfg.Report.PathOrdering),
+		PathMaxTurnDegrees:        cfg.Report.PathMaxTurnDegrees,
+		PhotoURLNormalization: entities.PhotoURLNormalization{
+			Enabled:     cfg.Report.NormalizePhotoURLs,
+			StripParams: cfg.Report.PhotoURLStripParams,
+			SortQuery:   cfg.Report.PhotoURLSortQuery,
+		},
+	})
+
+	// Initialize category service (report category taxonomy)
+	categoryService := services.NewCategoryService(categoryRepo)
+
+	// Initialize report note service (verifier notes)
+	reportNoteService := services.NewReportNoteService(reportNoteRepo, damagedRoadRepo)
+
+	// Initialize activity service (auth events + report submissions timeline)
+	activityService := services.NewActivityService(authEventLogRepo, damagedRoadRepo)
+
+	// Initialize data export service (data-portability bundles)
+	dataExportService := services.NewDataExportService(userRepo, damagedRoadRepo, authEventLogRepo)
+
+	// Initialize maintenance service (expired token cleanup)
+	maintenanceService := services.NewMaintenanceService(refreshTokenRepo, passwordResetTokenRepo, services.MaintenanceServiceConfig{
+		TokenCleanupBatchSize: cfg.JWT.CleanupBatchSize,
+	})
+	var adminAuditService usecases.AdminAuditService
+	if cfg.Admin.AuditLog {
+		adminAuditService = services.NewAdminAuditService(adminAuditLogRepo)
+	}
 
 	// Initialize handlers (driving adapters)
+	dto.SetCoordinatePrecision(cfg.Report.CoordinatePrecision)
 	registrationHandler := handlers.NewRegistrationHandler(userService)
-	authHandler := handlers.NewAuthHandler(authService, userService, int(cfg.JWT.AccessTokenTTL.Hours()))
+	authHandler := handlers.NewAuthHandler(authService, userService, int(cfg.JWT.AccessTokenTTL.Hours()), handlers.AuthCookieConfig{
+		Enabled:         cfg.JWT.CookieAuth,
+		Secure:          cfg.JWT.CookieSecure,
+		SameSite:        handlers.ParseSameSite(cfg.JWT.CookieSameSite),
+		Domain:          cfg.JWT.CookieDomain,
+		AccessTokenTTL:  cfg.JWT.AccessTokenTTL,
+		RefreshTokenTTL: cfg.JWT.RefreshTokenTTL,
+	})
 	passwordHandler := handlers.NewPasswordHandler(passwordService)
-	reportHandler := handlers.NewReportHandler(reportService)
-	validationHandler := handlers.NewValidationHandler(geometryService, photoValidator)
+	reportHandler := handlers.NewReportHandler(reportService, handlers.ReportHandlerConfig{
+		GeometryErrorDetails: cfg.Report.GeometryErrorDetail,
+		MapResultLimit:       cfg.Report.MapResultLimit,
+		OutputSRIDs:          cfg.Report.OutputSRIDs,
+	})
+	reportNoteHandler := handlers.NewReportNoteHandler(reportNoteService)
+	reportEventsHandler := handlers.NewReportEventsHandler(eventBus)
+	categoryHandler := handlers.NewCategoryHandler(categoryService)
+	validationHandler := handlers.NewValidationHandler(geometryService, photoValidator, cfg.Report.CentroidRadiusMeters)
+	userHandler := handlers.NewUserHandler(userService, activityService, dataExportService, userPreferencesService)
+	adminHandler := handlers.NewAdminHandler(maintenanceService, dto.FeatureFlagsResponse{
+		StrictCentroidCheck:    cfg.Features.StrictCentroidCheck,
+		LenientPhotoValidation: cfg.Features.LenientPhotoValidation,
+		PublicReadMode:         cfg.Features.PublicReadMode,
+		ResponseEnvelope:       cfg.Features.ResponseEnvelope,
+	})
 	healthHandler := handlers.NewHealthHandler(db)
 
 	// Setup Gin router without default middleware
 	router := gin.New()
 
+	// Gin redirects near-miss paths by default (301 for GET, 307 otherwise), which some clients
+	// follow without resending the body. An API should answer 404 so the bug surfaces instead
+	router.RedirectTrailingSlash = cfg.Server.RedirectTrailingSlash
+	router.RedirectFixedPath = cfg.Server.RedirectFixedPath
+
+	// Gin trusts X-Forwarded-For from any peer by default, which lets clients pick their own IP
+	// for the admin allowlist, rate limits and logs. Only configured proxies are believed
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		log.Fatalf("Invalid SERVER_TRUSTED_PROXIES: %v", err)
+	}
+
 	// Add custom middleware
-	router.Use(gin.Recovery())                        // Panic recovery
+	router.Use(middleware.RecoveryMiddleware())       // Panic recovery
 	router.Use(middleware.RequestIDMiddleware())      // Request ID tracking
 	router.Use(middleware.RequestLoggingMiddleware()) // Structured logging
 
+	// Request deadline, shortened by X-Request-Timeout. The report event stream stays open until
+	// the client disconnects and large exports outlast it, so they are exempt
+	router.Use(middleware.RequestTimeoutMiddleware(cfg.Server.RequestTimeout,
+		"/api/v1"+routes.ReportEventsPath,
+		"/api/v1"+routes.ReportExportPath,
+		"/api/v1"+routes.UserExportPath,
+	))
+	if cfg.Features.ResponseEnvelope {
+		router.Use(middleware.ResponseEnvelopeMiddleware()) // Uniform {data, error, meta} responses
+	}
+	router.Use(middleware.LocalizationMiddleware(cfg.Server.DefaultLocale)) // Error messages in the Accept-Language locale
+
 	// Configure CORS
 	router.Use(middleware.CORSMiddleware())
 
-	// Apply rate limiting to API routes
+	// Lax and none cookies are sent on cross-site requests, so cookie auth needs an Origin check
+	if cfg.JWT.CookieAuth && cfg.JWT.CookieSameSite != "strict" {
+		router.Use(middleware.CSRFOriginMiddleware(middleware.AllowedOrigins))
+	}
+
+	// Apply rate limiting to API routes; health and metrics probes are exempt
 	router.Use(middleware.RateLimitMiddleware(limiter.Rate{
 		Period: 1 * time.Minute,
 		Limit:  100, // 100 requests per minute per IP
-	}))
+	}, cfg.Server.RateLimitExemptPaths...))
 
 	docs.SwaggerInfo.BasePath = "/api/v1"
 	docs.SwaggerInfo.Host = fmt.Sprintf("localhost:%s", cfg.Server.Port)
 	docs.SwaggerInfo.Schemes = []string{"http"}
 
+	adminAllowedNetworks, err := middleware.ParseCIDRList(cfg.Admin.AllowedCIDRs)
+	if err != nil {
+		log.Fatalf("Invalid ADMIN_ALLOWED_CIDRS: %v", err)
+	}
+
 	// Configure routes
-	routes.SetupRoutes(router, registrationHandler, authHandler, passwordHandler, reportHandler, validationHandler, healthHandler, authService)
+	routes.SetupRoutes(router, registrationHandler, authHandler, passwordHandler, reportHandler, reportNoteHandler, reportEventsHandler, categoryHandler, validationHandler, userHandler, adminHandler, healthHandler, authService, userService, adminAuditService, adminAllowedNetworks, cfg.Features.PublicReadMode, geometryEnabled, cfg.JWT.CookieAuth)
 
 	// Start server
 	addr := fmt.Sprintf(":%s", cfg.Server.Port)
@@ -2,6 +2,9 @@ package config
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -12,36 +15,114 @@ type Config struct {
 	Database DatabaseConfig
 	JWT      JWTConfig
 	Email    EmailConfig
+	Report   ReportConfig
+	Storage  StorageConfig
+	Admin    AdminConfig
+	Features FeatureFlags
 }
 
 type ServerConfig struct {
-	Port string
+	Port                  string
+	RequestTimeout        time.Duration // max time per request; clients may ask for less via X-Request-Timeout
+	RedirectTrailingSlash bool          // redirect /path/ to /path instead of answering 404
+	RedirectFixedPath     bool          // redirect case and ../ variants to the matching route instead of answering 404
+	DefaultLocale         string        // error message language when Accept-Language names no supported one: "en" or "id"
+	FrontendBaseURL       string        // absolute URL of the web app, used for links in emails
+	RateLimitExemptPaths  []string      // paths never rate limited, such as health and metrics probes
+	TrustedProxies        []string      // proxy IPs/CIDRs whose X-Forwarded-For is believed; empty trusts none
+}
+
+// FeatureFlags centralizes the behavior toggles, injected into the components they affect
+type FeatureFlags struct {
+	StrictCentroidCheck    bool // reject reports with no path point within REPORT_CENTROID_RADIUS_METERS of the subdistrict centroid (FR-006)
+	LenientPhotoValidation bool // drop invalid photos instead of rejecting the report (PHOTO_VALIDATION_MODE=lenient)
+	PublicReadMode         bool // serve report reads without authentication
+	ResponseEnvelope       bool // wrap every JSON response in a {data, error, meta} envelope
 }
 
 type DatabaseConfig struct {
-	Host            string
-	Port            int
-	User            string
-	Password        string
-	DBName          string
-	SSLMode         string
-	MaxOpenConns    int
-	MaxIdleConns    int
-	ConnMaxLifetime time.Duration
+	Host             string
+	Port             int
+	User             string
+	Password         string
+	DBName           string
+	SSLMode          string
+	MaxOpenConns     int
+	MaxIdleConns     int
+	ConnMaxLifetime  time.Duration
+	StatementTimeout time.Duration // server-side cap on a single statement, 0 disables
+	GeometryBackend  string        // "postgis" (required, fail fast when missing) or "none" to run without geospatial features
 }
 
 type JWTConfig struct {
-	Secret          string
-	AccessTokenTTL  time.Duration
-	RefreshTokenTTL time.Duration
+	Secret           string
+	AccessTokenTTL   time.Duration
+	RefreshTokenTTL  time.Duration
+	CookieAuth       bool   // also deliver tokens as HttpOnly cookies and accept the access token cookie
+	CookieSecure     bool   // mark token cookies Secure (HTTPS only)
+	CookieSameSite   string // "strict", "lax" or "none" (requires CookieSecure); lax and none add an Origin check
+	CookieDomain     string // cookie Domain attribute; empty scopes cookies to the API host
+	CleanupBatchSize int    // expired tokens deleted per statement by the cleanup job, 0 deletes all at once
 }
 
 type EmailConfig struct {
-	ServiceType string
-	SMTPHost    string
-	SMTPPort    int
-	SMTPUser    string
-	SMTPPass    string
+	ServiceType     string
+	SMTPHost        string
+	SMTPPort        int
+	SMTPUser        string
+	SMTPPass        string
+	SMTPFromName    string
+	SMTPFromEmail   string
+	SMTPDialTimeout time.Duration // max time to connect to the SMTP server
+	SMTPSendTimeout time.Duration // max time for the whole SMTP conversation
+}
+
+type ReportConfig struct {
+	PhotoValidationMode     string                   // "strict" rejects the report on any invalid photo, "lenient" drops invalid photos
+	TextSanitization        string                   // "off", "escape" or "reject" HTML in titles and descriptions
+	PhotoMaxFetches         int                      // hard cap on photo URLs fetched per validation batch
+	PhotoFetchTimeout       time.Duration            // max time for one photo URL check, redirects included
+	PhotoConcurrency        int                      // photo URLs checked at once per validation batch
+	PhotoBatchTimeout       time.Duration            // max time for validating all photo URLs of one request
+	PhotoMaxSizeBytes       int64                    // largest accepted photo, by reported size
+	PhotoProbeUnknownSize   bool                     // ranged GET to learn the size when HEAD omits Content-Length
+	PhotoMaxAgeMode         string                   // "off", "advisory" (log and warn) or "enforce" rejection of photos older than PhotoMaxAge
+	PhotoMaxAge             time.Duration            // oldest accepted Last-Modified age of a photo
+	NearbyRadiusMeters      float64                  // search radius for the nearest-report hint on create, 0 disables
+	DuplicateRadiusMeters   float64                  // reject a new report this close to an unresolved one unless forced, 0 disables
+	CentroidRadiusMeters    float64                  // FR-006 radius around the subdistrict centroid that a path must reach
+	SLADurations            map[string]time.Duration // max time per status, e.g. "submitted=48h,under_verification=72h"
+	GeometryErrorDetail     bool                     // include per-coordinate violations in error details
+	BoundaryMode            string                   // "all" points inside national bounds, or "any" with the rest within the buffer
+	MinInterval             time.Duration            // minimum time between a user's consecutive reports, 0 disables
+	EditWindow              time.Duration            // how long after creation authors may edit a report, 0 disables
+	CoordinatePrecision     int                      // decimal places of coordinates in responses
+	MapResultLimit          int                      // max reports returned for one map viewport
+	PathOrdering            string                   // "off", "advisory" (log only) or "enforce" rejection of paths that double back
+	PathMaxTurnDegrees      float64                  // largest allowed direction change between consecutive segments
+	BoundaryBufferMeters    float64                  // offshore tolerance for non-anchor points in "any" mode
+	DistanceMethod          string                   // "haversine" (spherical) or "vincenty" (WGS84 ellipsoid) point distances
+	RequireResolutionPhotos bool                     // resolving a report requires proof-of-repair photos
+	RequireKnownSubDistrict bool                     // reject subdistrict codes missing from the boundary dataset instead of only logging them
+	RequireCategory         bool                     // reject new reports without a category_id
+	RequireReasonStatuses   []string                 // statuses a report can only be moved to with a reason
+	LifecycleLogs           bool                     // log created/status-changed/deleted report events for analytics
+	NormalizePhotoURLs      bool                     // strip fragments and tracking params, lowercase host before storing photo URLs
+	PhotoURLStripParams     []string                 // query params removed by normalization, "utm_*" matches a prefix
+	PhotoURLSortQuery       bool                     // sort remaining query params by name during normalization
+	OutputSRIDs             []int                    // CRSs report geometries may be requested in with ?srid=; always includes 4326
+}
+
+type StorageConfig struct {
+	PublicHost        string   // public host serving uploaded photos, e.g. cdn.jalanrusak.id
+	EnforcePhotoHost  bool     // reject report photos not hosted on PublicHost
+	TrustedPhotoHosts []string // hosts whose photo URLs skip the HEAD request; SSRF checks still apply
+	AllowedPhotoHosts []string // only accept photo URLs on these hosts (and subdomains); empty allows any
+}
+
+type AdminConfig struct {
+	AllowedCIDRs []string // client networks allowed to reach /admin routes; empty allows all
+	AuditLog     bool     // record /admin mutations in the admin_audit_log table
 }
 
 func Load() (*Config, error) {
@@ -50,14 +131,66 @@ func Load() (*Config, error) {
 
 	// Set defaults
 	viper.SetDefault("SERVER_PORT", "8080")
+	viper.SetDefault("SERVER_REQUEST_TIMEOUT", "30s")
+	viper.SetDefault("SERVER_REDIRECT_TRAILING_SLASH", false)
+	viper.SetDefault("SERVER_REDIRECT_FIXED_PATH", false)
+	viper.SetDefault("SERVER_DEFAULT_LOCALE", "en")
+	viper.SetDefault("FRONTEND_BASE_URL", "http://localhost:3000")
+	viper.SetDefault("ADMIN_AUDIT_LOG", true)
+	viper.SetDefault("RESPONSE_ENVELOPE", false)
+	viper.SetDefault("FEATURE_STRICT_CENTROID_CHECK", false)
+	viper.SetDefault("FEATURE_PUBLIC_READ", false)
 	viper.SetDefault("ACCESS_TOKEN_TTL_HOURS", 24)
 	viper.SetDefault("REFRESH_TOKEN_TTL_DAYS", 30)
+	viper.SetDefault("AUTH_COOKIE_ENABLED", false)
+	viper.SetDefault("AUTH_COOKIE_SECURE", true)
+	viper.SetDefault("AUTH_COOKIE_SAMESITE", "strict")
+	viper.SetDefault("TOKEN_CLEANUP_BATCH_SIZE", 1000)
 	viper.SetDefault("EMAIL_SERVICE_TYPE", "console")
+	viper.SetDefault("SMTP_PORT", 587)
+	viper.SetDefault("SMTP_FROM_NAME", "JalanRusak Team")
+	viper.SetDefault("SMTP_DIAL_TIMEOUT", "5s")
+	viper.SetDefault("SMTP_SEND_TIMEOUT", "15s")
 	viper.SetDefault("DB_PORT", 5432)
 	viper.SetDefault("DB_SSL_MODE", "disable")
 	viper.SetDefault("DB_MAX_OPEN_CONNS", 25)
 	viper.SetDefault("DB_MAX_IDLE_CONNS", 5)
 	viper.SetDefault("DB_CONN_MAX_LIFETIME_MINUTES", 5)
+	viper.SetDefault("DB_STATEMENT_TIMEOUT", "30s")
+	viper.SetDefault("RATE_LIMIT_EXEMPT_PATHS", "/health,/ready,/metrics")
+	viper.SetDefault("GEOMETRY_BACKEND", "postgis")
+	viper.SetDefault("PHOTO_VALIDATION_MODE", "strict")
+	viper.SetDefault("REPORT_TEXT_SANITIZATION", "off")
+	viper.SetDefault("PHOTO_VALIDATION_MAX_FETCHES", 10)
+	viper.SetDefault("PHOTO_VALIDATION_TIMEOUT", "5s")
+	viper.SetDefault("PHOTO_VALIDATION_CONCURRENCY", 5)
+	viper.SetDefault("PHOTO_VALIDATION_BATCH_TIMEOUT", "15s")
+	viper.SetDefault("PHOTO_VALIDATION_MAX_SIZE_MB", 5)
+	viper.SetDefault("PHOTO_VALIDATION_PROBE_SIZE", false)
+	viper.SetDefault("PHOTO_VALIDATION_MAX_AGE_MODE", "off")
+	viper.SetDefault("PHOTO_VALIDATION_MAX_AGE", "8760h")
+	viper.SetDefault("REPORT_NEARBY_RADIUS_METERS", 100)
+	viper.SetDefault("REPORT_DUPLICATE_RADIUS_METERS", 20)
+	viper.SetDefault("REPORT_CENTROID_RADIUS_METERS", 200)
+	viper.SetDefault("REPORT_GEOMETRY_ERROR_DETAILS", true)
+	viper.SetDefault("REPORT_BOUNDARY_MODE", "all")
+	viper.SetDefault("REPORT_MIN_INTERVAL", "0s")
+	viper.SetDefault("REPORT_EDIT_WINDOW", "24h")
+	viper.SetDefault("REPORT_COORDINATE_PRECISION", 6)
+	viper.SetDefault("REPORT_MAP_RESULT_LIMIT", 500)
+	viper.SetDefault("REPORT_PATH_ORDERING", "advisory")
+	viper.SetDefault("REPORT_PATH_MAX_TURN_DEGREES", 150)
+	viper.SetDefault("REPORT_BOUNDARY_BUFFER_METERS", 2000)
+	viper.SetDefault("REPORT_DISTANCE_METHOD", "haversine")
+	viper.SetDefault("REPORT_REQUIRE_RESOLUTION_PHOTOS", false)
+	viper.SetDefault("REPORT_REQUIRE_KNOWN_SUBDISTRICT", false)
+	viper.SetDefault("REPORT_REQUIRE_CATEGORY", false)
+	viper.SetDefault("REPORT_LIFECYCLE_LOGS", false)
+	viper.SetDefault("REPORT_OUTPUT_SRIDS", "4326,3857")
+	viper.SetDefault("PHOTO_URL_NORMALIZATION", false)
+	viper.SetDefault("PHOTO_URL_STRIP_PARAMS", "utm_*,fbclid,gclid,mc_cid,mc_eid")
+	viper.SetDefault("PHOTO_URL_SORT_QUERY", true)
+	viper.SetDefault("STORAGE_ENFORCE_PHOTO_HOST", false)
 
 	// Read config file if it exists
 	if err := viper.ReadInConfig(); err != nil {
@@ -69,32 +202,111 @@ func Load() (*Config, error) {
 
 	config := &Config{
 		Server: ServerConfig{
-			Port: viper.GetString("SERVER_PORT"),
+			Port:                  viper.GetString("SERVER_PORT"),
+			RequestTimeout:        viper.GetDuration("SERVER_REQUEST_TIMEOUT"),
+			RedirectTrailingSlash: viper.GetBool("SERVER_REDIRECT_TRAILING_SLASH"),
+			RedirectFixedPath:     viper.GetBool("SERVER_REDIRECT_FIXED_PATH"),
+			DefaultLocale:         viper.GetString("SERVER_DEFAULT_LOCALE"),
+			FrontendBaseURL:       viper.GetString("FRONTEND_BASE_URL"),
+			RateLimitExemptPaths:  splitList(viper.GetString("RATE_LIMIT_EXEMPT_PATHS")),
+			TrustedProxies:        splitList(viper.GetString("SERVER_TRUSTED_PROXIES")),
 		},
 		Database: DatabaseConfig{
-			Host:            viper.GetString("DB_HOST"),
-			Port:            viper.GetInt("DB_PORT"),
-			User:            viper.GetString("DB_USER"),
-			Password:        viper.GetString("DB_PASSWORD"),
-			DBName:          viper.GetString("DB_NAME"),
-			SSLMode:         viper.GetString("DB_SSL_MODE"),
-			MaxOpenConns:    viper.GetInt("DB_MAX_OPEN_CONNS"),
-			MaxIdleConns:    viper.GetInt("DB_MAX_IDLE_CONNS"),
-			ConnMaxLifetime: time.Duration(viper.GetInt("DB_CONN_MAX_LIFETIME_MINUTES")) * time.Minute,
+			Host:             viper.GetString("DB_HOST"),
+			Port:             viper.GetInt("DB_PORT"),
+			User:             viper.GetString("DB_USER"),
+			Password:         viper.GetString("DB_PASSWORD"),
+			DBName:           viper.GetString("DB_NAME"),
+			SSLMode:          viper.GetString("DB_SSL_MODE"),
+			MaxOpenConns:     viper.GetInt("DB_MAX_OPEN_CONNS"),
+			MaxIdleConns:     viper.GetInt("DB_MAX_IDLE_CONNS"),
+			ConnMaxLifetime:  time.Duration(viper.GetInt("DB_CONN_MAX_LIFETIME_MINUTES")) * time.Minute,
+			StatementTimeout: viper.GetDuration("DB_STATEMENT_TIMEOUT"),
+			GeometryBackend:  viper.GetString("GEOMETRY_BACKEND"),
 		},
 		JWT: JWTConfig{
-			Secret:          viper.GetString("JWT_SECRET"),
-			AccessTokenTTL:  time.Duration(viper.GetInt("ACCESS_TOKEN_TTL_HOURS")) * time.Hour,
-			RefreshTokenTTL: time.Duration(viper.GetInt("REFRESH_TOKEN_TTL_DAYS")) * 24 * time.Hour,
+			Secret:           viper.GetString("JWT_SECRET"),
+			AccessTokenTTL:   time.Duration(viper.GetInt("ACCESS_TOKEN_TTL_HOURS")) * time.Hour,
+			RefreshTokenTTL:  time.Duration(viper.GetInt("REFRESH_TOKEN_TTL_DAYS")) * 24 * time.Hour,
+			CookieAuth:       viper.GetBool("AUTH_COOKIE_ENABLED"),
+			CookieSecure:     viper.GetBool("AUTH_COOKIE_SECURE"),
+			CookieSameSite:   strings.ToLower(viper.GetString("AUTH_COOKIE_SAMESITE")),
+			CookieDomain:     viper.GetString("AUTH_COOKIE_DOMAIN"),
+			CleanupBatchSize: viper.GetInt("TOKEN_CLEANUP_BATCH_SIZE"),
 		},
 		Email: EmailConfig{
-			ServiceType: viper.GetString("EMAIL_SERVICE_TYPE"),
-			SMTPHost:    viper.GetString("SMTP_HOST"),
-			SMTPPort:    viper.GetInt("SMTP_PORT"),
-			SMTPUser:    viper.GetString("SMTP_USER"),
-			SMTPPass:    viper.GetString("SMTP_PASS"),
+			ServiceType:     viper.GetString("EMAIL_SERVICE_TYPE"),
+			SMTPHost:        viper.GetString("SMTP_HOST"),
+			SMTPPort:        viper.GetInt("SMTP_PORT"),
+			SMTPUser:        viper.GetString("SMTP_USER"),
+			SMTPPass:        viper.GetString("SMTP_PASS"),
+			SMTPFromName:    viper.GetString("SMTP_FROM_NAME"),
+			SMTPFromEmail:   viper.GetString("SMTP_FROM_EMAIL"),
+			SMTPDialTimeout: viper.GetDuration("SMTP_DIAL_TIMEOUT"),
+			SMTPSendTimeout: viper.GetDuration("SMTP_SEND_TIMEOUT"),
 		},
+		Report: ReportConfig{
+			PhotoValidationMode:     viper.GetString("PHOTO_VALIDATION_MODE"),
+			TextSanitization:        viper.GetString("REPORT_TEXT_SANITIZATION"),
+			PhotoMaxFetches:         viper.GetInt("PHOTO_VALIDATION_MAX_FETCHES"),
+			PhotoFetchTimeout:       viper.GetDuration("PHOTO_VALIDATION_TIMEOUT"),
+			PhotoConcurrency:        viper.GetInt("PHOTO_VALIDATION_CONCURRENCY"),
+			PhotoBatchTimeout:       viper.GetDuration("PHOTO_VALIDATION_BATCH_TIMEOUT"),
+			PhotoMaxSizeBytes:       int64(viper.GetFloat64("PHOTO_VALIDATION_MAX_SIZE_MB") * (1 << 20)),
+			PhotoProbeUnknownSize:   viper.GetBool("PHOTO_VALIDATION_PROBE_SIZE"),
+			PhotoMaxAgeMode:         viper.GetString("PHOTO_VALIDATION_MAX_AGE_MODE"),
+			PhotoMaxAge:             viper.GetDuration("PHOTO_VALIDATION_MAX_AGE"),
+			NearbyRadiusMeters:      viper.GetFloat64("REPORT_NEARBY_RADIUS_METERS"),
+			DuplicateRadiusMeters:   viper.GetFloat64("REPORT_DUPLICATE_RADIUS_METERS"),
+			CentroidRadiusMeters:    viper.GetFloat64("REPORT_CENTROID_RADIUS_METERS"),
+			GeometryErrorDetail:     viper.GetBool("REPORT_GEOMETRY_ERROR_DETAILS"),
+			BoundaryMode:            viper.GetString("REPORT_BOUNDARY_MODE"),
+			MinInterval:             viper.GetDuration("REPORT_MIN_INTERVAL"),
+			EditWindow:              viper.GetDuration("REPORT_EDIT_WINDOW"),
+			CoordinatePrecision:     viper.GetInt("REPORT_COORDINATE_PRECISION"),
+			MapResultLimit:          viper.GetInt("REPORT_MAP_RESULT_LIMIT"),
+			PathOrdering:            viper.GetString("REPORT_PATH_ORDERING"),
+			PathMaxTurnDegrees:      viper.GetFloat64("REPORT_PATH_MAX_TURN_DEGREES"),
+			BoundaryBufferMeters:    viper.GetFloat64("REPORT_BOUNDARY_BUFFER_METERS"),
+			DistanceMethod:          viper.GetString("REPORT_DISTANCE_METHOD"),
+			RequireResolutionPhotos: viper.GetBool("REPORT_REQUIRE_RESOLUTION_PHOTOS"),
+			RequireKnownSubDistrict: viper.GetBool("REPORT_REQUIRE_KNOWN_SUBDISTRICT"),
+			RequireCategory:         viper.GetBool("REPORT_REQUIRE_CATEGORY"),
+			RequireReasonStatuses:   splitList(viper.GetString("REPORT_REQUIRE_REASON_STATUSES")),
+			LifecycleLogs:           viper.GetBool("REPORT_LIFECYCLE_LOGS"),
+			NormalizePhotoURLs:      viper.GetBool("PHOTO_URL_NORMALIZATION"),
+			PhotoURLStripParams:     splitList(viper.GetString("PHOTO_URL_STRIP_PARAMS")),
+			PhotoURLSortQuery:       viper.GetBool("PHOTO_URL_SORT_QUERY"),
+		},
+		Features: FeatureFlags{
+			StrictCentroidCheck:    viper.GetBool("FEATURE_STRICT_CENTROID_CHECK"),
+			LenientPhotoValidation: viper.GetString("PHOTO_VALIDATION_MODE") == "lenient",
+			PublicReadMode:         viper.GetBool("FEATURE_PUBLIC_READ"),
+			ResponseEnvelope:       viper.GetBool("RESPONSE_ENVELOPE"),
+		},
+		Admin: AdminConfig{
+			AllowedCIDRs: splitList(viper.GetString("ADMIN_ALLOWED_CIDRS")),
+			AuditLog:     viper.GetBool("ADMIN_AUDIT_LOG"),
+		},
+		Storage: StorageConfig{
+			PublicHost:        viper.GetString("STORAGE_PUBLIC_HOST"),
+			EnforcePhotoHost:  viper.GetBool("STORAGE_ENFORCE_PHOTO_HOST"),
+			TrustedPhotoHosts: splitList(viper.GetString("STORAGE_TRUSTED_PHOTO_HOSTS")),
+			AllowedPhotoHosts: splitList(viper.GetString("STORAGE_ALLOWED_PHOTO_HOSTS")),
+		},
+	}
+
+	slaDurations, err := parseSLADurations(viper.GetString("REPORT_SLA"))
+	if err != nil {
+		return nil, err
+	}
+	config.Report.SLADurations = slaDurations
+
+	outputSRIDs, err := parseSRIDs(viper.GetString("REPORT_OUTPUT_SRIDS"))
+	if err != nil {
+		return nil, err
 	}
+	config.Report.OutputSRIDs = outputSRIDs
 
 	// Validate required fields
 	if config.Database.Host == "" || config.Database.User == "" || config.Database.DBName == "" {
@@ -103,6 +315,153 @@ func Load() (*Config, error) {
 	if config.JWT.Secret == "" {
 		return nil, fmt.Errorf("JWT_SECRET is required")
 	}
+	switch config.JWT.CookieSameSite {
+	case "strict", "lax", "none":
+	default:
+		return nil, fmt.Errorf("AUTH_COOKIE_SAMESITE must be one of strict, lax or none")
+	}
+	if config.JWT.CookieSameSite == "none" && !config.JWT.CookieSecure {
+		return nil, fmt.Errorf("AUTH_COOKIE_SAMESITE=none requires AUTH_COOKIE_SECURE=true")
+	}
+	if config.JWT.CleanupBatchSize < 0 {
+		return nil, fmt.Errorf("TOKEN_CLEANUP_BATCH_SIZE cannot be negative")
+	}
+	if config.Report.PhotoValidationMode != "strict" && config.Report.PhotoValidationMode != "lenient" {
+		return nil, fmt.Errorf("PHOTO_VALIDATION_MODE must be either strict or lenient")
+	}
+	switch config.Report.TextSanitization {
+	case "off", "escape", "reject":
+	default:
+		return nil, fmt.Errorf("REPORT_TEXT_SANITIZATION must be one of off, escape or reject")
+	}
+	if config.Database.GeometryBackend != "postgis" && config.Database.GeometryBackend != "none" {
+		return nil, fmt.Errorf("GEOMETRY_BACKEND must be either postgis or none")
+	}
+	if config.Database.StatementTimeout < 0 {
+		return nil, fmt.Errorf("DB_STATEMENT_TIMEOUT cannot be negative")
+	}
+	if config.Database.StatementTimeout > 0 && config.Database.StatementTimeout < time.Millisecond {
+		return nil, fmt.Errorf("DB_STATEMENT_TIMEOUT must be 0 or at least 1ms")
+	}
+	if config.Report.PhotoFetchTimeout <= 0 {
+		return nil, fmt.Errorf("PHOTO_VALIDATION_TIMEOUT must be greater than 0")
+	}
+	if config.Report.PhotoConcurrency < 1 {
+		return nil, fmt.Errorf("PHOTO_VALIDATION_CONCURRENCY must be at least 1")
+	}
+	if config.Report.PhotoBatchTimeout <= 0 {
+		return nil, fmt.Errorf("PHOTO_VALIDATION_BATCH_TIMEOUT must be greater than 0")
+	}
+	if config.Report.PhotoMaxSizeBytes <= 0 {
+		return nil, fmt.Errorf("PHOTO_VALIDATION_MAX_SIZE_MB must be greater than 0")
+	}
+	switch config.Report.PhotoMaxAgeMode {
+	case "off", "advisory", "enforce":
+	default:
+		return nil, fmt.Errorf("PHOTO_VALIDATION_MAX_AGE_MODE must be one of off, advisory or enforce")
+	}
+	if config.Report.PhotoMaxAgeMode != "off" && config.Report.PhotoMaxAge <= 0 {
+		return nil, fmt.Errorf("PHOTO_VALIDATION_MAX_AGE must be greater than 0")
+	}
+	if config.Report.PhotoMaxFetches < 1 {
+		return nil, fmt.Errorf("PHOTO_VALIDATION_MAX_FETCHES must be at least 1")
+	}
+	if config.Report.NearbyRadiusMeters < 0 {
+		return nil, fmt.Errorf("REPORT_NEARBY_RADIUS_METERS cannot be negative")
+	}
+	if config.Report.DuplicateRadiusMeters < 0 {
+		return nil, fmt.Errorf("REPORT_DUPLICATE_RADIUS_METERS cannot be negative")
+	}
+	if config.Report.CentroidRadiusMeters <= 0 {
+		return nil, fmt.Errorf("REPORT_CENTROID_RADIUS_METERS must be positive")
+	}
+	if config.Report.BoundaryMode != "all" && config.Report.BoundaryMode != "any" {
+		return nil, fmt.Errorf("REPORT_BOUNDARY_MODE must be either all or any")
+	}
+	if config.Report.BoundaryBufferMeters < 0 || config.Report.BoundaryBufferMeters > 50000 {
+		return nil, fmt.Errorf("REPORT_BOUNDARY_BUFFER_METERS must be between 0 and 50000")
+	}
+	if config.Report.DistanceMethod != "haversine" && config.Report.DistanceMethod != "vincenty" {
+		return nil, fmt.Errorf("REPORT_DISTANCE_METHOD must be either haversine or vincenty")
+	}
+	if config.Report.CoordinatePrecision < 0 || config.Report.CoordinatePrecision > 15 {
+		return nil, fmt.Errorf("REPORT_COORDINATE_PRECISION must be between 0 and 15")
+	}
+	if config.Report.MapResultLimit < 1 {
+		return nil, fmt.Errorf("REPORT_MAP_RESULT_LIMIT must be at least 1")
+	}
+	if config.Report.EditWindow < 0 {
+		return nil, fmt.Errorf("REPORT_EDIT_WINDOW cannot be negative")
+	}
+	if config.Report.MinInterval < 0 {
+		return nil, fmt.Errorf("REPORT_MIN_INTERVAL cannot be negative")
+	}
+	switch config.Report.PathOrdering {
+	case "off", "advisory", "enforce":
+	default:
+		return nil, fmt.Errorf("REPORT_PATH_ORDERING must be one of off, advisory or enforce")
+	}
+	if config.Report.PathMaxTurnDegrees <= 0 || config.Report.PathMaxTurnDegrees > 180 {
+		return nil, fmt.Errorf("REPORT_PATH_MAX_TURN_DEGREES must be greater than 0 and at most 180")
+	}
+	if config.Email.ServiceType == "smtp" && (config.Email.SMTPHost == "" || config.Email.SMTPFromEmail == "") {
+		return nil, fmt.Errorf("SMTP_HOST and SMTP_FROM_EMAIL are required when EMAIL_SERVICE_TYPE is smtp")
+	}
+	switch config.Server.DefaultLocale {
+	case "en", "id":
+	default:
+		return nil, fmt.Errorf("SERVER_DEFAULT_LOCALE must be either en or id")
+	}
+	if config.Storage.EnforcePhotoHost && config.Storage.PublicHost == "" {
+		return nil, fmt.Errorf("STORAGE_PUBLIC_HOST is required when STORAGE_ENFORCE_PHOTO_HOST is enabled")
+	}
 
 	return config, nil
 }
+
+// parseSLADurations parses a comma-separated list of status=duration pairs
+func parseSLADurations(spec string) (map[string]time.Duration, error) {
+	durations := make(map[string]time.Duration)
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		status, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("REPORT_SLA entry %q must be in status=duration format", pair)
+		}
+		duration, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("REPORT_SLA entry %q has invalid duration: %w", pair, err)
+		}
+		durations[strings.TrimSpace(status)] = duration
+	}
+	return durations, nil
+}
+
+// parseSRIDs parses a comma-separated list of EPSG codes, adding 4326 (WGS84) when missing
+func parseSRIDs(spec string) ([]int, error) {
+	srids := []int{4326}
+	for _, entry := range splitList(spec) {
+		srid, err := strconv.Atoi(entry)
+		if err != nil || srid <= 0 {
+			return nil, fmt.Errorf("REPORT_OUTPUT_SRIDS entry %q must be a positive EPSG code", entry)
+		}
+		if !slices.Contains(srids, srid) {
+			srids = append(srids, srid)
+		}
+	}
+	return srids, nil
+}
+
+// splitList splits a comma-separated value into trimmed, non-empty entries
+func splitList(value string) []string {
+	var entries []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+)
+
+// loadTestConfig loads the config from an empty .env, the required variables and env
+func loadTestConfig(t *testing.T, env map[string]string) *Config {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".env"), nil, 0o600); err != nil {
+		t.Fatalf("write .env error = %v", err)
+	}
+	t.Chdir(dir)
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	t.Setenv("DB_HOST", "localhost")
+	t.Setenv("DB_USER", "jalanrusak")
+	t.Setenv("DB_NAME", "jalanrusak")
+	t.Setenv("JWT_SECRET", "test-secret")
+	for key, value := range env {
+		t.Setenv(key, value)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return cfg
+}
+
+func TestRedirectConfig(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		env        map[string]string
+		path       string
+		wantStatus int
+	}{
+		{"trailing slash off by default", nil, "/reports/", http.StatusNotFound},
+		{"fixed path off by default", nil, "/REPORTS", http.StatusNotFound},
+		{"trailing slash on", map[string]string{"SERVER_REDIRECT_TRAILING_SLASH": "true"}, "/reports/", http.StatusMovedPermanently},
+		{"fixed path on", map[string]string{"SERVER_REDIRECT_FIXED_PATH": "true"}, "/REPORTS", http.StatusMovedPermanently},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := loadTestConfig(t, tt.env)
+
+			router := gin.New()
+			router.RedirectTrailingSlash = cfg.Server.RedirectTrailingSlash
+			router.RedirectFixedPath = cfg.Server.RedirectFixedPath
+			router.GET("/reports", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if w.Code != tt.wantStatus {
+				t.Errorf("GET %s = %d, want %d", tt.path, w.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusMovedPermanently && w.Header().Get("Location") != "/reports" {
+				t.Errorf("Location = %q, want /reports", w.Header().Get("Location"))
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package entities
+
+import "time"
+
+// Activity type constants
+const (
+	ActivityTypeAuthEvent = "auth_event"
+	ActivityTypeReport    = "report"
+)
+
+// ActivityItem represents a single entry in a user's activity timeline.
+// Exactly one of AuthEvent or Report is set, depending on Type.
+type ActivityItem struct {
+	Type       string
+	OccurredAt time.Time
+	AuthEvent  *AuthEventLog
+	Report     *DamagedRoad
+}
+
+// NewAuthEventActivity wraps an auth event log entry as an activity item
+func NewAuthEventActivity(log *AuthEventLog) *ActivityItem {
+	return &ActivityItem{
+		Type:       ActivityTypeAuthEvent,
+		OccurredAt: log.CreatedAt,
+		AuthEvent:  log,
+	}
+}
+
+// NewReportActivity wraps a submitted damaged road report as an activity item
+func NewReportActivity(road *DamagedRoad) *ActivityItem {
+	return &ActivityItem{
+		Type:       ActivityTypeReport,
+		OccurredAt: road.CreatedAt,
+		Report:     road,
+	}
+}
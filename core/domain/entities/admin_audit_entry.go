@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Admin audit action constants
+const (
+	AdminActionCleanupTokens = "maintenance.cleanup_tokens"
+)
+
+// AdminAuditEntry records a mutation performed through the admin API
+type AdminAuditEntry struct {
+	ID         uuid.UUID
+	AdminID    uuid.UUID
+	Action     string // what was done, e.g. AdminActionCleanupTokens
+	Target     string // affected resource identifier; empty when the action has no single target
+	Method     string
+	Path       string
+	StatusCode int
+	IPAddress  string
+	UserAgent  string
+	CreatedAt  time.Time
+}
+
+// NewAdminAuditEntry creates a new AdminAuditEntry entity
+func NewAdminAuditEntry(adminID uuid.UUID, action, target, method, path string, statusCode int, ipAddress, userAgent string) *AdminAuditEntry {
+	return &AdminAuditEntry{
+		ID:         uuid.New(),
+		AdminID:    adminID,
+		Action:     action,
+		Target:     target,
+		Method:     method,
+		Path:       path,
+		StatusCode: statusCode,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		CreatedAt:  time.Now(),
+	}
+}
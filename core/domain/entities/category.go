@@ -0,0 +1,16 @@
+package entities
+
+import "time"
+
+// MaxCategoryIDLength is the maximum length of a category identifier
+const MaxCategoryIDLength = 50
+
+// Category is an entry of the managed report category taxonomy, e.g. pothole or cracking.
+// The ID is a stable slug referenced by reports
+type Category struct {
+	ID          string
+	Name        string
+	Description *string
+	SortOrder   int
+	CreatedAt   time.Time
+}
@@ -1,6 +1,8 @@
 package entities
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -77,6 +79,41 @@ func (s Status) String() string {
 	return string(s)
 }
 
+// Photo count bounds for a damaged road report. These are the single source of
+// truth for both entity validation and request binding (see the photo_count validator).
+const (
+	// MinPhotoURLs is the minimum number of photo URLs a report must have
+	MinPhotoURLs = 1
+	// MaxPhotoURLs is the maximum number of photo URLs a report can have
+	MaxPhotoURLs = 10
+)
+
+// Radius bounds for nearby report searches. The cap keeps a single search from
+// scanning most of the table.
+const (
+	// DefaultNearbyRadiusMeters is used when no radius is given
+	DefaultNearbyRadiusMeters = 1000.0
+	// MaxNearbyRadiusMeters is the largest radius a nearby search accepts
+	MaxNearbyRadiusMeters = 50000.0
+)
+
+// ValidatePhotoCount checks that the number of photo URLs is within the allowed bounds
+func ValidatePhotoCount(count int) error {
+	if count < MinPhotoURLs {
+		return errors.NewValidationError("photo_urls", fmt.Sprintf("at least %d photo URL required", MinPhotoURLs), errors.ErrInvalidPhotoURLs)
+	}
+	if count > MaxPhotoURLs {
+		return errors.NewValidationError("photo_urls", fmt.Sprintf("cannot have more than %d photo URLs", MaxPhotoURLs), errors.ErrInvalidPhotoURLs)
+	}
+	return nil
+}
+
+// DroppedPhoto represents a photo URL that failed validation and was excluded from a report
+type DroppedPhoto struct {
+	URL    string `json:"url"`
+	Reason string `json:"reason"`
+}
+
 // DamagedRoad represents a damaged road report entity
 type DamagedRoad struct {
 	ID              uuid.UUID       `json:"id" db:"id"`
@@ -85,10 +122,51 @@ type DamagedRoad struct {
 	Path            Geometry        `json:"path" db:"path"`
 	Description     *Description    `json:"description,omitempty" db:"description"`
 	PhotoURLs       []string        `json:"photo_urls" db:"photo_urls"`
-	AuthorID        uuid.UUID       `json:"author_id" db:"author_id"`
-	Status          Status          `json:"status" db:"status"`
-	CreatedAt       time.Time       `json:"created_at" db:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at" db:"updated_at"`
+	// ResolutionPhotoURLs are proof-of-repair photos attached when the report is resolved
+	ResolutionPhotoURLs []string       `json:"resolution_photo_urls,omitempty" db:"resolution_photo_urls"`
+	DroppedPhotos       []DroppedPhoto `json:"dropped_photos,omitempty" db:"-"`
+	AuthorID            uuid.UUID      `json:"author_id" db:"author_id"`
+	Status              Status         `json:"status" db:"status"`
+	CreatedAt           time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at" db:"updated_at"`
+	StatusChangedAt     time.Time      `json:"status_changed_at" db:"status_changed_at"`
+	// CategoryID references the report category taxonomy; nil when uncategorized
+	CategoryID *string `json:"category_id,omitempty" db:"category_id"`
+	// DeletedAt is set when the report was soft-deleted; only admins can list deleted reports
+	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+
+	// SLABreached is computed against the configured SLAPolicy when the report is read
+	SLABreached bool `json:"sla_breached" db:"-"`
+
+	// Warnings lists non-fatal concerns found while validating a create or edit, e.g. a path
+	// far from the subdistrict centroid. They never block the report
+	Warnings []string `json:"warnings,omitempty" db:"-"`
+
+	// NearestReportDistanceMeters is an advisory distance to the closest unresolved report,
+	// only populated on create when one exists within the search radius
+	NearestReportDistanceMeters *float64 `json:"nearest_report_distance_meters,omitempty" db:"-"`
+
+	// DistanceToCentroidMeters is the distance from the path's closest point to the subdistrict
+	// centroid, only populated on detail reads when the centroid is known
+	DistanceToCentroidMeters *float64 `json:"distance_to_centroid_meters,omitempty" db:"-"`
+
+	// DistanceMeters is the distance from the search center, only populated by nearby searches
+	DistanceMeters *float64 `json:"distance_meters,omitempty" db:"-"`
+
+	// Author is loaded alongside the report when read; nil if the author's account no longer exists
+	Author *ReportAuthor `json:"author,omitempty" db:"-"`
+
+	// ProjectedPath is Path reprojected to ProjectedSRID for output, only populated when a read
+	// asked for a CRS other than WGS84. Path itself always stays in WGS84 for domain logic
+	ProjectedPath *Geometry `json:"-" db:"-"`
+	ProjectedSRID int       `json:"-" db:"-"`
+}
+
+// ReportAuthor holds the public profile of a report's author, plus the email for admin views
+type ReportAuthor struct {
+	Name  string `json:"name"`
+	Role  string `json:"role"`
+	Email string `json:"-"`
 }
 
 // NewDamagedRoad creates a new DamagedRoad with validation
@@ -99,6 +177,7 @@ func NewDamagedRoad(
 	photoURLs []string,
 	authorID uuid.UUID,
 	description *Description,
+	categoryID *string,
 ) (*DamagedRoad, error) {
 	now := time.Now()
 
@@ -110,9 +189,11 @@ func NewDamagedRoad(
 		Description:     description,
 		PhotoURLs:       photoURLs,
 		AuthorID:        authorID,
+		CategoryID:      categoryID,
 		Status:          StatusSubmitted,
 		CreatedAt:       now,
 		UpdatedAt:       now,
+		StatusChangedAt: now,
 	}
 
 	if err := road.Validate(); err != nil {
@@ -147,11 +228,8 @@ func (d *DamagedRoad) Validate() error {
 	}
 
 	// Validate photo URLs
-	if len(d.PhotoURLs) < 1 {
-		return errors.NewValidationError("photo_urls", "at least 1 photo URL required", errors.ErrInvalidPhotoURLs)
-	}
-	if len(d.PhotoURLs) > 10 {
-		return errors.NewValidationError("photo_urls", "cannot have more than 10 photo URLs", errors.ErrInvalidPhotoURLs)
+	if err := ValidatePhotoCount(len(d.PhotoURLs)); err != nil {
+		return err
 	}
 
 	// Validate status
@@ -183,9 +261,82 @@ func (d *DamagedRoad) UpdateStatus(newStatus Status) error {
 
 	d.Status = newStatus
 	d.UpdatedAt = time.Now()
+	d.StatusChangedAt = d.UpdatedAt
 	return nil
 }
 
+// AttachResolutionPhotos records proof-of-repair photos on a report that has just been resolved.
+// Photos are only accepted on resolution; when required, resolving without any is rejected.
+func (d *DamagedRoad) AttachResolutionPhotos(photoURLs []string, required bool) error {
+	if d.Status != StatusResolved {
+		if len(photoURLs) > 0 {
+			return errors.NewValidationError(
+				"resolution_photo_urls",
+				"resolution photos can only be attached when resolving a report",
+				errors.ErrInvalidPhotoURLs,
+			)
+		}
+		return nil
+	}
+
+	if len(photoURLs) == 0 && required {
+		return errors.NewValidationError(
+			"resolution_photo_urls",
+			"at least 1 proof-of-repair photo URL is required to resolve a report",
+			errors.ErrResolutionPhotosRequired,
+		)
+	}
+	if len(photoURLs) > MaxPhotoURLs {
+		return errors.NewValidationError(
+			"resolution_photo_urls",
+			fmt.Sprintf("cannot have more than %d resolution photo URLs", MaxPhotoURLs),
+			errors.ErrInvalidPhotoURLs,
+		)
+	}
+
+	d.ResolutionPhotoURLs = photoURLs
+	return nil
+}
+
+// IsEditable reports whether the report content may still be changed.
+// Once verification starts the report is frozen so verified content can't be tampered with
+func (d *DamagedRoad) IsEditable() bool {
+	return d.Status == StatusSubmitted
+}
+
+// IsWithinEditWindow reports whether now falls within window after the report was created.
+// A zero window never closes
+func (d *DamagedRoad) IsWithinEditWindow(window time.Duration, now time.Time) bool {
+	return window <= 0 || now.Before(d.CreatedAt.Add(window))
+}
+
+// Edit replaces the user-supplied content of the report and re-validates it
+func (d *DamagedRoad) Edit(title Title, description *Description, path Geometry, photoURLs []string) error {
+	if !d.IsEditable() {
+		return errors.ErrReportNotEditable
+	}
+
+	d.Title = title
+	d.Description = description
+	d.Path = path
+	d.PhotoURLs = photoURLs
+	d.UpdatedAt = time.Now()
+
+	return d.Validate()
+}
+
+// EditPath replaces only the path of a submitted report, keeping its text and photos
+func (d *DamagedRoad) EditPath(path Geometry) error {
+	if !d.IsEditable() {
+		return errors.ErrReportNotEditable
+	}
+
+	d.Path = path
+	d.UpdatedAt = time.Now()
+
+	return d.Validate()
+}
+
 // CanBeEditedBy checks if the damaged road can be edited by the given user
 func (d *DamagedRoad) CanBeEditedBy(userID uuid.UUID) bool {
 	// Only the author can edit their own report
@@ -197,13 +348,90 @@ type DamagedRoadFilters struct {
 	Status          *Status    `json:"status,omitempty"`
 	SubDistrictCode *string    `json:"subdistrict_code,omitempty"`
 	AuthorID        *uuid.UUID `json:"author_id,omitempty"`
+	CategoryID      *string    `json:"category_id,omitempty"`
+	SLABreached     *bool      `json:"sla_breached,omitempty"`
+	Query           *string    `json:"q,omitempty"`            // case-insensitive match in title or description
+	CreatedFrom     *time.Time `json:"created_from,omitempty"` // inclusive
+	CreatedTo       *time.Time `json:"created_to,omitempty"`   // inclusive
+	SLAPolicy       SLAPolicy  `json:"-"`                      // set by the service, needed to evaluate SLABreached
+	SRID            int        `json:"srid,omitempty"`         // reproject paths to this CRS for output; 0 or 4326 keeps WGS84
+	Sort            ReportSort `json:"sort"`
 	Limit           int        `json:"limit"`
 	Offset          int        `json:"offset"`
+	// IncludeDeleted also lists soft-deleted reports; reserved for admins
+	IncludeDeleted bool `json:"include_deleted,omitempty"`
+	// Cursor switches to keyset pagination: only reports after it in the sort order are listed
+	// and Offset is ignored. Requires sorting by created_at
+	Cursor *ReportCursor `json:"-"`
 }
 
+// ReportCursor is a keyset pagination position: the creation time and ID of the last
+// report on the previous page
+type ReportCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// Sortable report fields
+const (
+	ReportSortCreatedAt = "created_at"
+	ReportSortUpdatedAt = "updated_at"
+	ReportSortTitle     = "title"
+)
+
+// ReportSort is the order of a report listing. Field is one of the ReportSort* constants
+type ReportSort struct {
+	Field      string `json:"field"`
+	Descending bool   `json:"descending"`
+}
+
+// DefaultReportSort lists the newest reports first
+var DefaultReportSort = ReportSort{Field: ReportSortCreatedAt, Descending: true}
+
+// ParseReportSort parses a sort expression of the form "field" or "field:asc|desc".
+// Without a direction timestamps sort newest first and titles alphabetically
+func ParseReportSort(value string) (ReportSort, error) {
+	field, direction, hasDirection := strings.Cut(value, ":")
+
+	var sort ReportSort
+	switch field {
+	case ReportSortCreatedAt, ReportSortUpdatedAt:
+		sort = ReportSort{Field: field, Descending: true}
+	case ReportSortTitle:
+		sort = ReportSort{Field: field}
+	default:
+		return ReportSort{}, errors.NewValidationError("sort",
+			"sort field must be one of created_at, updated_at or title", errors.ErrInvalidInput)
+	}
+
+	if hasDirection {
+		switch direction {
+		case "asc":
+			sort.Descending = false
+		case "desc":
+			sort.Descending = true
+		default:
+			return ReportSort{}, errors.NewValidationError("sort", "sort direction must be asc or desc", errors.ErrInvalidInput)
+		}
+	}
+	return sort, nil
+}
+
+// IsFiltered reports whether any filter changes the result set beyond pagination, so that
+// the listing total differs from Count. IncludeDeleted widens it rather than narrowing it
+func (f *DamagedRoadFilters) IsFiltered() bool {
+	return f.Status != nil || f.SubDistrictCode != nil || f.AuthorID != nil || f.SLABreached != nil ||
+		f.CreatedFrom != nil || f.CreatedTo != nil || f.Query != nil || f.CategoryID != nil ||
+		f.IncludeDeleted
+}
+
+// MaxSearchQueryLength caps the free-text search term of a report listing
+const MaxSearchQueryLength = 100
+
 // NewDamagedRoadFilters creates filters with defaults
 func NewDamagedRoadFilters() *DamagedRoadFilters {
 	return &DamagedRoadFilters{
+		Sort:   DefaultReportSort,
 		Limit:  20,
 		Offset: 0,
 	}
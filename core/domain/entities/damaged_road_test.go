@@ -0,0 +1,72 @@
+package entities
+
+import (
+	stderrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+)
+
+func TestParseReportSort(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    ReportSort
+		wantErr bool
+	}{
+		{value: "created_at", want: ReportSort{Field: ReportSortCreatedAt, Descending: true}},
+		{value: "created_at:asc", want: ReportSort{Field: ReportSortCreatedAt}},
+		{value: "updated_at", want: ReportSort{Field: ReportSortUpdatedAt, Descending: true}},
+		{value: "updated_at:asc", want: ReportSort{Field: ReportSortUpdatedAt}},
+		{value: "title", want: ReportSort{Field: ReportSortTitle}},
+		{value: "title:desc", want: ReportSort{Field: ReportSortTitle, Descending: true}},
+		{value: "author_id", wantErr: true},
+		{value: "title; DROP TABLE damaged_roads", wantErr: true},
+		{value: "created_at:sideways", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseReportSort(tt.value)
+		if tt.wantErr {
+			if !stderrors.Is(err, errors.ErrInvalidInput) {
+				t.Errorf("ParseReportSort(%q) error = %v, want ErrInvalidInput", tt.value, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseReportSort(%q) = %+v, %v; want %+v", tt.value, got, err, tt.want)
+		}
+	}
+}
+
+func TestIsFilteredCoversEveryFilter(t *testing.T) {
+	// Fields that shape the page or its output without changing which reports are counted
+	notFilters := map[string]bool{"SLAPolicy": true, "SRID": true, "Sort": true, "Limit": true, "Offset": true, "Cursor": true}
+
+	if NewDamagedRoadFilters().IsFiltered() {
+		t.Fatal("IsFiltered() = true for default filters")
+	}
+
+	fields := reflect.TypeOf(DamagedRoadFilters{})
+	for i := 0; i < fields.NumField(); i++ {
+		field := fields.Field(i)
+		if notFilters[field.Name] {
+			continue
+		}
+
+		filters := NewDamagedRoadFilters()
+		value := reflect.ValueOf(filters).Elem().Field(i)
+		switch value.Kind() {
+		case reflect.Pointer:
+			value.Set(reflect.New(field.Type.Elem()))
+		case reflect.Bool:
+			value.SetBool(true)
+		default:
+			t.Fatalf("filter %s has unhandled kind %s", field.Name, value.Kind())
+		}
+		if !filters.IsFiltered() {
+			t.Errorf("IsFiltered() = false with %s set, want the listing total to be treated as filtered", field.Name)
+		}
+	}
+}
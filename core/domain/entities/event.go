@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event names published on the event bus
+const (
+	// EventReportCreated is published after a damaged road report has been stored
+	EventReportCreated = "report.created"
+	// EventReportStatusChanged is published after a report status transition has been stored
+	EventReportStatusChanged = "report.status_changed"
+	// EventReportDeleted is published after a report has been deleted
+	EventReportDeleted = "report.deleted"
+)
+
+// DomainEvent is something that happened in the domain that other components may react to
+type DomainEvent interface {
+	// EventName identifies the event type subscribers register for
+	EventName() string
+}
+
+// ReportCreatedEvent is published when a new damaged road report has been stored
+type ReportCreatedEvent struct {
+	Report     *DamagedRoad
+	OccurredAt time.Time
+}
+
+// NewReportCreatedEvent creates a ReportCreatedEvent for the given report
+func NewReportCreatedEvent(report *DamagedRoad) ReportCreatedEvent {
+	return ReportCreatedEvent{
+		Report:     report,
+		OccurredAt: time.Now(),
+	}
+}
+
+// EventName returns EventReportCreated
+func (e ReportCreatedEvent) EventName() string {
+	return EventReportCreated
+}
+
+// ReportStatusChangedEvent is published when a report has moved to a new status
+type ReportStatusChangedEvent struct {
+	Report     *DamagedRoad
+	Change     *StatusChange
+	OccurredAt time.Time
+}
+
+// NewReportStatusChangedEvent creates a ReportStatusChangedEvent for the given report and transition
+func NewReportStatusChangedEvent(report *DamagedRoad, change *StatusChange) ReportStatusChangedEvent {
+	return ReportStatusChangedEvent{
+		Report:     report,
+		Change:     change,
+		OccurredAt: time.Now(),
+	}
+}
+
+// EventName returns EventReportStatusChanged
+func (e ReportStatusChangedEvent) EventName() string {
+	return EventReportStatusChanged
+}
+
+// ReportDeletedEvent is published when a report has been deleted. Report is the state
+// it had before deletion
+type ReportDeletedEvent struct {
+	Report     *DamagedRoad
+	DeletedBy  uuid.UUID
+	OccurredAt time.Time
+}
+
+// NewReportDeletedEvent creates a ReportDeletedEvent for the given report
+func NewReportDeletedEvent(report *DamagedRoad, deletedBy uuid.UUID) ReportDeletedEvent {
+	return ReportDeletedEvent{
+		Report:     report,
+		DeletedBy:  deletedBy,
+		OccurredAt: time.Now(),
+	}
+}
+
+// EventName returns EventReportDeleted
+func (e ReportDeletedEvent) EventName() string {
+	return EventReportDeleted
+}
@@ -0,0 +1,116 @@
+package entities
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// PhotoURLNormalization holds the rules applied to photo URLs before they are stored, so that
+// URLs differing only by fragment, tracking parameters, parameter order or host case
+// collapse to the same row
+type PhotoURLNormalization struct {
+	// Enabled turns normalization on; when off URLs are stored verbatim.
+	// Enabled normalization always drops the fragment and lowercases the host
+	Enabled bool
+	// StripParams lists query parameter names to remove, matched case-insensitively.
+	// A trailing "*" matches any parameter with that prefix, e.g. "utm_*"
+	StripParams []string
+	// SortQuery orders the remaining query parameters by name
+	SortQuery bool
+}
+
+// Normalize returns the normalized form of a photo URL. URLs that cannot be parsed are
+// returned unchanged and left for photo validation to reject.
+func (n PhotoURLNormalization) Normalize(rawURL string) string {
+	if !n.Enabled {
+		return rawURL
+	}
+
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Host == "" {
+		return rawURL
+	}
+
+	parsed.Fragment = ""
+	parsed.RawFragment = ""
+	parsed.Host = strings.ToLower(parsed.Host)
+	parsed.RawQuery = n.normalizeQuery(parsed.RawQuery)
+	parsed.ForceQuery = false
+
+	return parsed.String()
+}
+
+// NormalizeAll normalizes each URL and drops duplicates, keeping the first occurrence.
+// Exact duplicates are dropped even with normalization off, as a report stores each URL once
+func (n PhotoURLNormalization) NormalizeAll(rawURLs []string) []string {
+	seen := make(map[string]bool, len(rawURLs))
+	normalized := make([]string, 0, len(rawURLs))
+	for _, rawURL := range rawURLs {
+		photoURL := n.Normalize(rawURL)
+		if seen[photoURL] {
+			continue
+		}
+		seen[photoURL] = true
+		normalized = append(normalized, photoURL)
+	}
+	return normalized
+}
+
+// normalizeQuery removes stripped parameters and optionally sorts the rest, keeping the
+// original encoding of each pair
+func (n PhotoURLNormalization) normalizeQuery(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+
+	type queryPair struct {
+		key  string
+		pair string
+	}
+
+	var pairs []queryPair
+	for _, pair := range strings.Split(rawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+		key, _, _ := strings.Cut(pair, "=")
+		if unescaped, err := url.QueryUnescape(key); err == nil {
+			key = unescaped
+		}
+		if n.isStripped(key) {
+			continue
+		}
+		pairs = append(pairs, queryPair{key: key, pair: pair})
+	}
+
+	if n.SortQuery {
+		sort.SliceStable(pairs, func(i, j int) bool {
+			return pairs[i].key < pairs[j].key
+		})
+	}
+
+	encoded := make([]string, len(pairs))
+	for i, p := range pairs {
+		encoded[i] = p.pair
+	}
+	return strings.Join(encoded, "&")
+}
+
+// isStripped reports whether a query parameter matches one of the strip rules
+func (n PhotoURLNormalization) isStripped(key string) bool {
+	key = strings.ToLower(key)
+	for _, rule := range n.StripParams {
+		rule = strings.ToLower(rule)
+		if prefix, ok := strings.CutSuffix(rule, "*"); ok {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+			continue
+		}
+		if key == rule {
+			return true
+		}
+	}
+	return false
+}
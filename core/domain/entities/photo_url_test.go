@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPhotoURLNormalizationNormalizeAll(t *testing.T) {
+	rawURLs := []string{
+		"https://Photos.example.com/1.jpg?utm_source=wa#top",
+		"https://photos.example.com/2.jpg",
+		"https://photos.example.com/1.jpg",
+		"https://photos.example.com/2.jpg",
+	}
+
+	tests := []struct {
+		name          string
+		normalization PhotoURLNormalization
+		want          []string
+	}{
+		{
+			name:          "off drops exact duplicates only",
+			normalization: PhotoURLNormalization{},
+			want:          []string{rawURLs[0], rawURLs[1], rawURLs[2]},
+		},
+		{
+			name:          "on collapses equivalent URLs",
+			normalization: PhotoURLNormalization{Enabled: true, StripParams: []string{"utm_*"}},
+			want:          []string{"https://photos.example.com/1.jpg", "https://photos.example.com/2.jpg"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.normalization.NormalizeAll(rawURLs); !slices.Equal(got, tt.want) {
+				t.Errorf("NormalizeAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
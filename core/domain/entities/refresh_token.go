@@ -15,18 +15,56 @@ type RefreshToken struct {
 	Revoked    bool
 	CreatedAt  time.Time
 	LastUsedAt *time.Time
+	DeviceID   *string    // Nullable when the client did not identify its device
+	FamilyID   uuid.UUID  // Shared by every token rotated from the same login
+	ParentID   *uuid.UUID // Token this one replaced; nil for the token issued at login
+	IPAddress  *string    // Client address the token was issued to; nil for tokens issued before it was recorded
+	UserAgent  *string    // Client user agent the token was issued to
 }
 
 // NewRefreshToken creates a new RefreshToken entity
 func NewRefreshToken(userID uuid.UUID, tokenHash string, ttlDays int) *RefreshToken {
 	now := time.Now()
+	id := uuid.New()
 	return &RefreshToken{
-		ID:        uuid.New(),
+		ID:        id,
 		UserID:    userID,
 		TokenHash: tokenHash,
 		ExpiresAt: now.Add(time.Duration(ttlDays) * 24 * time.Hour),
 		Revoked:   false,
 		CreatedAt: now,
+		FamilyID:  id,
+	}
+}
+
+// Successor creates the token that replaces this one on rotation, in the same family and on the same device
+func (rt *RefreshToken) Successor(tokenHash string, ttlDays int) *RefreshToken {
+	next := NewRefreshToken(rt.UserID, tokenHash, ttlDays)
+	next.FamilyID = rt.FamilyID
+	parentID := rt.ID
+	next.ParentID = &parentID
+	next.DeviceID = rt.DeviceID
+	return next
+}
+
+// MaxDeviceIDLength is the maximum accepted length of a client device identifier
+const MaxDeviceIDLength = 255
+
+// BindDevice associates the token with a client device; empty IDs are ignored
+func (rt *RefreshToken) BindDevice(deviceID string) {
+	if deviceID == "" {
+		return
+	}
+	rt.DeviceID = &deviceID
+}
+
+// BindClient records the client address and user agent the token is issued to; empty values are ignored
+func (rt *RefreshToken) BindClient(ipAddress, userAgent string) {
+	if ipAddress != "" {
+		rt.IPAddress = &ipAddress
+	}
+	if userAgent != "" {
+		rt.UserAgent = &userAgent
 	}
 }
 
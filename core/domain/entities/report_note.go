@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+)
+
+// MaxReportNoteLength is the maximum length of a report note body in characters
+const MaxReportNoteLength = 2000
+
+// ReportNote is a working note left on a report by staff during verification.
+// Internal notes are only visible to staff roles
+type ReportNote struct {
+	ID        uuid.UUID
+	ReportID  uuid.UUID
+	AuthorID  uuid.UUID
+	Body      string
+	Internal  bool
+	CreatedAt time.Time
+}
+
+// NewReportNote creates a new ReportNote entity with validation
+func NewReportNote(reportID, authorID uuid.UUID, body string, internal bool) (*ReportNote, error) {
+	body = strings.TrimSpace(body)
+	if body == "" || len([]rune(body)) > MaxReportNoteLength {
+		return nil, errors.ErrInvalidNoteBody
+	}
+
+	return &ReportNote{
+		ID:        uuid.New(),
+		ReportID:  reportID,
+		AuthorID:  authorID,
+		Body:      body,
+		Internal:  internal,
+		CreatedAt: time.Now(),
+	}, nil
+}
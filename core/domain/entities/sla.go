@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+)
+
+// SLAPolicy maps a report status to the maximum time a report may stay in it
+type SLAPolicy map[Status]time.Duration
+
+// NewSLAPolicy creates an SLAPolicy from status names and durations, validating both
+func NewSLAPolicy(durations map[string]time.Duration) (SLAPolicy, error) {
+	policy := make(SLAPolicy, len(durations))
+	for name, duration := range durations {
+		status := Status(name)
+		if !status.IsValid() {
+			return nil, errors.NewValidationError("sla", fmt.Sprintf("unknown status %q", name), errors.ErrInvalidStatus)
+		}
+		if duration <= 0 {
+			return nil, errors.NewValidationError("sla", fmt.Sprintf("duration for %s must be positive", name), errors.ErrInvalidInput)
+		}
+		policy[status] = duration
+	}
+	return policy, nil
+}
+
+// IsBreached reports whether the road has stayed in its current status longer than allowed.
+// Statuses without a configured SLA are never breached.
+func (p SLAPolicy) IsBreached(road *DamagedRoad, now time.Time) bool {
+	limit, ok := p[road.Status]
+	if !ok {
+		return false
+	}
+	return now.Sub(road.StatusChangedAt) > limit
+}
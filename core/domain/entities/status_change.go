@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusChange records a single status transition of a damaged road report
+type StatusChange struct {
+	ID         uuid.UUID
+	ReportID   uuid.UUID
+	FromStatus Status
+	ToStatus   Status
+	ChangedBy  uuid.UUID // uuid.Nil once the user account is deleted
+	ChangedAt  time.Time
+	Reason     *Description // why the status was changed; nil when none was given
+}
+
+// NewStatusChange creates a new StatusChange entity
+func NewStatusChange(
+	reportID uuid.UUID,
+	fromStatus, toStatus Status,
+	changedBy uuid.UUID,
+	changedAt time.Time,
+	reason *Description,
+) *StatusChange {
+	return &StatusChange{
+		ID:         uuid.New(),
+		ReportID:   reportID,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		ChangedBy:  changedBy,
+		ChangedAt:  changedAt,
+		Reason:     reason,
+	}
+}
@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+)
+
+// TextSanitizationMode controls how user-supplied report text is neutralized before storage
+type TextSanitizationMode string
+
+// Text sanitization modes
+const (
+	// TextSanitizationOff stores text verbatim
+	TextSanitizationOff TextSanitizationMode = "off"
+	// TextSanitizationEscape strips control characters and escapes angle brackets
+	TextSanitizationEscape TextSanitizationMode = "escape"
+	// TextSanitizationReject strips control characters and rejects text containing HTML tags
+	TextSanitizationReject TextSanitizationMode = "reject"
+)
+
+// IsValid checks if the sanitization mode is one of the defined modes
+func (m TextSanitizationMode) IsValid() bool {
+	switch m {
+	case TextSanitizationOff, TextSanitizationEscape, TextSanitizationReject:
+		return true
+	}
+	return false
+}
+
+var (
+	htmlTagRegex = regexp.MustCompile(`<\s*/?\s*[a-zA-Z!?][^>]*>`)
+
+	// Only angle brackets are escaped so quotes, apostrophes and ampersands stay readable
+	angleBracketEscaper   = strings.NewReplacer("<", "&lt;", ">", "&gt;")
+	angleBracketUnescaper = strings.NewReplacer("&lt;", "<", "&gt;", ">")
+)
+
+// unescapedLength is the length of text as the user typed it, before angle brackets were
+// escaped, so escaping never pushes text that was within a length limit over it
+func unescapedLength(text string) int {
+	return len(angleBracketUnescaper.Replace(text))
+}
+
+// Sanitize returns the title with control characters removed and markup neutralized per mode
+func (t Title) Sanitize(mode TextSanitizationMode) (Title, error) {
+	sanitized, err := sanitizeText(string(t), mode, false)
+	if err != nil {
+		return "", errors.NewValidationError("title", "cannot contain HTML tags", err)
+	}
+	return NewTitle(sanitized)
+}
+
+// Sanitize returns the description with control characters removed and markup neutralized per mode.
+// Line breaks and tabs are preserved.
+func (d Description) Sanitize(mode TextSanitizationMode) (Description, error) {
+	sanitized, err := sanitizeText(string(d), mode, true)
+	if err != nil {
+		return "", errors.NewValidationError("description", "cannot contain HTML tags", err)
+	}
+	return NewDescription(sanitized)
+}
+
+// sanitizeText applies the sanitization mode to a single piece of user-supplied text
+func sanitizeText(text string, mode TextSanitizationMode, allowLineBreaks bool) (string, error) {
+	if mode == TextSanitizationOff || mode == "" {
+		return text, nil
+	}
+
+	text = strings.Map(func(r rune) rune {
+		if allowLineBreaks && (r == '\n' || r == '\r' || r == '\t') {
+			return r
+		}
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, text)
+
+	if mode == TextSanitizationReject {
+		if htmlTagRegex.MatchString(text) {
+			return "", errors.ErrUnsafeContent
+		}
+		return text, nil
+	}
+
+	return angleBracketEscaper.Replace(text), nil
+}
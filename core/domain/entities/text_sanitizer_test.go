@@ -0,0 +1,134 @@
+package entities
+
+import (
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+)
+
+func TestTitleSanitizeNeutralizesPayloads(t *testing.T) {
+	tests := []struct {
+		name  string
+		mode  TextSanitizationMode
+		input string
+		want  string
+	}{
+		{
+			name:  "escape script tag",
+			mode:  TextSanitizationEscape,
+			input: "<script>alert(1)</script>",
+			want:  "&lt;script&gt;alert(1)&lt;/script&gt;",
+		},
+		{
+			name:  "escape event handler attribute",
+			mode:  TextSanitizationEscape,
+			input: `Jalan <img src=x onerror="alert(1)">`,
+			want:  `Jalan &lt;img src=x onerror="alert(1)"&gt;`,
+		},
+		{
+			name:  "strip control characters",
+			mode:  TextSanitizationEscape,
+			input: "Jalan\x00 rusak\x1b[31m\n",
+			want:  "Jalan rusak[31m",
+		},
+		{
+			name:  "reject mode strips control characters",
+			mode:  TextSanitizationReject,
+			input: "Jalan\x07 rusak",
+			want:  "Jalan rusak",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Title(tt.input).Sanitize(tt.mode)
+			if err != nil {
+				t.Fatalf("Sanitize() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Sanitize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTitleSanitizeRejectsTags(t *testing.T) {
+	for _, input := range []string{
+		"<script>alert(1)</script>",
+		"Jalan <b>rusak</b>",
+		"<!-- comment -->",
+		"< img src=x onerror=alert(1)>",
+	} {
+		_, err := Title(input).Sanitize(TextSanitizationReject)
+		if !stderrors.Is(err, errors.ErrUnsafeContent) {
+			t.Errorf("Sanitize(%q) error = %v, want ErrUnsafeContent", input, err)
+		}
+	}
+}
+
+func TestSanitizePreservesNormalText(t *testing.T) {
+	titles := []string{
+		`Jalan "Merdeka" & Gang Mawar's corner, no. 5 - rusak!?`,
+		"Lubang 2 < 3 meter", // a lone bracket is not a tag
+		"Jl. Sudirman (km 12); aspal retak 50% @ depan SDN #01",
+	}
+	for _, mode := range []TextSanitizationMode{TextSanitizationOff, TextSanitizationReject} {
+		for _, input := range titles {
+			got, err := Title(input).Sanitize(mode)
+			if err != nil {
+				t.Fatalf("Sanitize(%q, %s) error = %v", input, mode, err)
+			}
+			if string(got) != input {
+				t.Errorf("Sanitize(%q, %s) = %q, want it unchanged", input, mode, got)
+			}
+		}
+	}
+
+	got, err := Title(titles[0]).Sanitize(TextSanitizationEscape)
+	if err != nil {
+		t.Fatalf("Sanitize() error = %v", err)
+	}
+	if string(got) != titles[0] {
+		t.Errorf("Sanitize() = %q, want punctuation kept as is", got)
+	}
+
+	description := "Baris pertama\nbaris kedua\r\n\tdengan tab"
+	gotDescription, err := Description(description).Sanitize(TextSanitizationEscape)
+	if err != nil {
+		t.Fatalf("Description.Sanitize() error = %v", err)
+	}
+	if string(gotDescription) != description {
+		t.Errorf("Description.Sanitize() = %q, want line breaks and tabs kept", gotDescription)
+	}
+}
+
+func TestSanitizeEscapingDoesNotBreakLengthLimit(t *testing.T) {
+	// 100 characters, the maximum, which grows past 100 once the brackets are escaped
+	input := strings.Repeat("<>", 10) + strings.Repeat("a", 80)
+
+	title, err := Title(input).Sanitize(TextSanitizationEscape)
+	if err != nil {
+		t.Fatalf("Sanitize() error = %v", err)
+	}
+	if len(title) <= 100 {
+		t.Fatalf("escaped title has %d characters, expected it to grow past 100", len(title))
+	}
+	if err := title.Validate(); err != nil {
+		t.Errorf("Validate() on escaped title error = %v", err)
+	}
+
+	if _, err := Title(input + "a").Sanitize(TextSanitizationEscape); !stderrors.Is(err, errors.ErrInvalidTitle) {
+		t.Errorf("Sanitize() on 101 characters error = %v, want ErrInvalidTitle", err)
+	}
+
+	description := strings.Repeat("<", 500)
+	escaped, err := Description(description).Sanitize(TextSanitizationEscape)
+	if err != nil {
+		t.Fatalf("Description.Sanitize() error = %v", err)
+	}
+	if err := escaped.Validate(); err != nil {
+		t.Errorf("Validate() on escaped description error = %v", err)
+	}
+}
@@ -20,6 +20,18 @@ type User struct {
 	LastLoginAt  *time.Time
 }
 
+// Role constants
+const (
+	RoleUser        = "user"
+	RoleVerificator = "verificator"
+	RoleAdmin       = "admin"
+)
+
+// IsStaffRole reports whether the role belongs to staff who verify and manage reports
+func IsStaffRole(role string) bool {
+	return role == RoleVerificator || role == RoleAdmin
+}
+
 // NewUser creates a new User entity with generated UUID and timestamps
 func NewUser(name, email, passwordHash string) *User {
 	now := time.Now()
@@ -28,7 +40,7 @@ func NewUser(name, email, passwordHash string) *User {
 		Name:         name,
 		Email:        strings.ToLower(strings.TrimSpace(email)),
 		PasswordHash: passwordHash,
-		Role:         "user", // default role
+		Role:         RoleUser, // default role
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}
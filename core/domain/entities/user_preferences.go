@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserPreferences holds a user's notification settings. Notifications are opt-out:
+// users who never changed their preferences get DefaultUserPreferences
+type UserPreferences struct {
+	UserID              uuid.UUID
+	EmailOnStatusChange bool // email the author when one of their reports changes status
+	UpdatedAt           time.Time
+}
+
+// DefaultUserPreferences returns the preferences of a user who has not changed any
+func DefaultUserPreferences(userID uuid.UUID) *UserPreferences {
+	return &UserPreferences{
+		UserID:              userID,
+		EmailOnStatusChange: true,
+	}
+}
+
+// UserPreferencesUpdate is a partial update of UserPreferences; nil fields are left unchanged
+type UserPreferencesUpdate struct {
+	EmailOnStatusChange *bool
+}
+
+// Apply sets the fields present in the update
+func (u UserPreferencesUpdate) Apply(preferences *UserPreferences) {
+	if u.EmailOnStatusChange != nil {
+		preferences.EmailOnStatusChange = *u.EmailOnStatusChange
+	}
+	preferences.UpdatedAt = time.Now()
+}
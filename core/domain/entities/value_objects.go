@@ -1,13 +1,65 @@
 package entities
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"regexp"
 	"strings"
 
 	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
 )
 
+// Indonesian national bounds (FR-005)
+const (
+	NationalMinLat = -11.0
+	NationalMaxLat = 6.0
+	NationalMinLng = 95.0
+	NationalMaxLng = 141.0
+)
+
+// MetersPerDegree approximates the length of one degree of latitude
+const MetersPerDegree = 111320.0
+
+// EarthRadiusMeters is the Earth's mean radius used for great-circle distances
+const EarthRadiusMeters = 6371000.0
+
+// WGS84 ellipsoid parameters used for ellipsoidal distances
+const (
+	WGS84SemiMajorAxisMeters = 6378137.0
+	WGS84Flattening          = 1 / 298.257223563
+)
+
+// DefaultCentroidRadiusMeters is the FR-006 distance from the subdistrict centroid within which
+// at least one path point must fall
+const DefaultCentroidRadiusMeters = 200.0
+
+// MaxBoundaryBufferMeters is the widest offshore tolerance the geometry service can be
+// configured with
+const MaxBoundaryBufferMeters = 50000.0
+
+// boundaryBufferDegrees is how far outside national bounds Point and Geometry validation, and
+// request binding, accept coordinates. Zero keeps the bounds exact; only the "any" boundary
+// mode widens them, through SetBoundaryBuffer. The geometry service applies the mode itself
+var boundaryBufferDegrees float64
+
+// SetBoundaryBuffer lets coordinates fall up to meters outside national bounds, capped at
+// MaxBoundaryBufferMeters. It is called once at startup, before requests are served, when the
+// configured boundary mode accepts offshore points
+func SetBoundaryBuffer(meters float64) {
+	boundaryBufferDegrees = math.Max(0, math.Min(meters, MaxBoundaryBufferMeters)) / MetersPerDegree
+}
+
+// WithinNationalLat checks a latitude against national bounds widened by the configured buffer
+func WithinNationalLat(lat float64) bool {
+	return lat >= NationalMinLat-boundaryBufferDegrees && lat <= NationalMaxLat+boundaryBufferDegrees
+}
+
+// WithinNationalLng checks a longitude against national bounds widened by the configured buffer
+func WithinNationalLng(lng float64) bool {
+	return lng >= NationalMinLng-boundaryBufferDegrees && lng <= NationalMaxLng+boundaryBufferDegrees
+}
+
 // Point represents a geographic coordinate point (latitude, longitude)
 type Point struct {
 	Lat float64 `json:"lat" db:"lat"`
@@ -23,27 +75,133 @@ func NewPoint(lat, lng float64) (*Point, error) {
 	return p, nil
 }
 
+// DistanceMeters computes the Haversine distance in meters to another point.
+// Haversine formula accounts for Earth's curvature and provides accurate results for small distances.
+func (p Point) DistanceMeters(other Point) float64 {
+	lat1Rad := p.Lat * math.Pi / 180.0
+	lat2Rad := other.Lat * math.Pi / 180.0
+	deltaLatRad := (other.Lat - p.Lat) * math.Pi / 180.0
+	deltaLngRad := (other.Lng - p.Lng) * math.Pi / 180.0
+
+	a := math.Sin(deltaLatRad/2)*math.Sin(deltaLatRad/2) +
+		math.Cos(lat1Rad)*math.Cos(lat2Rad)*
+			math.Sin(deltaLngRad/2)*math.Sin(deltaLngRad/2)
+
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return EarthRadiusMeters * c
+}
+
+// VincentyDistanceMeters computes the distance in meters to another point on the WGS84 ellipsoid
+// using Vincenty's inverse formula. It is accurate to well under a meter over long distances,
+// where Haversine can be off by a few tenths of a percent. Nearly antipodal points, for which the
+// iteration does not converge, fall back to Haversine.
+func (p Point) VincentyDistanceMeters(other Point) float64 {
+	const (
+		maxIterations = 200
+		tolerance     = 1e-12
+	)
+	a := WGS84SemiMajorAxisMeters
+	f := WGS84Flattening
+	b := a * (1 - f)
+
+	L := (other.Lng - p.Lng) * math.Pi / 180.0
+	U1 := math.Atan((1 - f) * math.Tan(p.Lat*math.Pi/180.0))
+	U2 := math.Atan((1 - f) * math.Tan(other.Lat*math.Pi/180.0))
+	sinU1, cosU1 := math.Sin(U1), math.Cos(U1)
+	sinU2, cosU2 := math.Sin(U2), math.Cos(U2)
+
+	lambda := L
+	for i := 0; i < maxIterations; i++ {
+		sinLambda, cosLambda := math.Sin(lambda), math.Cos(lambda)
+		sinSigma := math.Sqrt((cosU2*sinLambda)*(cosU2*sinLambda) +
+			(cosU1*sinU2-sinU1*cosU2*cosLambda)*(cosU1*sinU2-sinU1*cosU2*cosLambda))
+		if sinSigma == 0 {
+			return 0 // coincident points
+		}
+		cosSigma := sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma := math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha := 1 - sinAlpha*sinAlpha
+		cos2SigmaM := 0.0
+		if cosSqAlpha != 0 { // both points on the equator otherwise
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+		C := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+		previous := lambda
+		lambda = L + (1-C)*f*sinAlpha*
+			(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+		if math.Abs(lambda-previous) < tolerance {
+			uSq := cosSqAlpha * (a*a - b*b) / (b * b)
+			A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+			B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+			deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+				B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+			return b * A * (sigma - deltaSigma)
+		}
+	}
+
+	return p.DistanceMeters(other)
+}
+
 // Validate validates the point coordinates
 func (p *Point) Validate() error {
-	if p.Lat < -11 || p.Lat > 6 {
-		return errors.NewValidationError("lat", "latitude must be between -11 and 6 (Indonesian boundaries)", errors.ErrCoordinatesOutOfBounds)
+	if !WithinNationalLat(p.Lat) {
+		return errors.NewValidationError("lat", "latitude must be within Indonesian boundaries (-11 to 6)", errors.ErrCoordinatesOutOfBounds)
 	}
-	if p.Lng < 95 || p.Lng > 141 {
-		return errors.NewValidationError("lng", "longitude must be between 95 and 141 (Indonesian boundaries)", errors.ErrCoordinatesOutOfBounds)
+	if !WithinNationalLng(p.Lng) {
+		return errors.NewValidationError("lng", "longitude must be within Indonesian boundaries (95 to 141)", errors.ErrCoordinatesOutOfBounds)
 	}
 	return nil
 }
 
-// Geometry represents a PostGIS geometry object (LineString for paths)
+// Supported geometry types
+const (
+	// GeometryTypePoint is a single location, such as one pothole
+	GeometryTypePoint = "Point"
+	// GeometryTypeLineString is a path along a damaged stretch of road
+	GeometryTypeLineString = "LineString"
+	// GeometryTypeMultiPoint is a set of separate locations
+	GeometryTypeMultiPoint = "MultiPoint"
+	// GeometryTypePolygon is an area bounded by a single closed ring, used for area queries
+	GeometryTypePolygon = "Polygon"
+)
+
+// Spatial reference systems of report geometries
+const (
+	// SRIDWGS84 is longitude/latitude in degrees, the CRS geometries are stored and validated in
+	SRIDWGS84 = 4326
+	// SRIDWebMercator is Web Mercator in meters, used by web map tiles
+	SRIDWebMercator = 3857
+)
+
+// MaxGeometryCoordinates caps the coordinates of a LineString or MultiPoint
+const MaxGeometryCoordinates = 100
+
+// Geometry represents a PostGIS geometry object: a Point, LineString, MultiPoint or Polygon.
+// Coordinates always holds a list of pairs, a Point holding exactly one and a Polygon its
+// closed exterior ring; the GeoJSON encoding of a Point uses a single pair and that of a
+// Polygon a list of rings, as GeoJSON requires
 type Geometry struct {
-	Type        string      `json:"type" db:"type"`               // "LineString"
+	Type        string      `json:"type" db:"type"`               // "Point", "LineString", "MultiPoint" or "Polygon"
 	Coordinates [][]float64 `json:"coordinates" db:"coordinates"` // [[lng, lat], [lng, lat], ...]
 }
 
-// NewGeometry creates a new Geometry from coordinate pairs
+// NewGeometry creates a new Geometry from coordinate pairs: a Point for a single pair,
+// a LineString otherwise
 func NewGeometry(coordinates [][]float64) (*Geometry, error) {
+	geometryType := GeometryTypeLineString
+	if len(coordinates) == 1 {
+		geometryType = GeometryTypePoint
+	}
+	return NewGeometryOfType(geometryType, coordinates)
+}
+
+// NewGeometryOfType creates a new Geometry of the given type from coordinate pairs
+func NewGeometryOfType(geometryType string, coordinates [][]float64) (*Geometry, error) {
 	g := &Geometry{
-		Type:        "LineString",
+		Type:        geometryType,
 		Coordinates: coordinates,
 	}
 	if err := g.Validate(); err != nil {
@@ -57,8 +215,8 @@ func NewGeometryFromPoints(points []Point) (*Geometry, error) {
 	if len(points) == 0 {
 		return nil, errors.NewValidationError("points", "at least 1 point required", errors.ErrInvalidPath)
 	}
-	if len(points) > 100 {
-		return nil, errors.NewValidationError("points", "cannot have more than 100 points", errors.ErrTooManyPathPoints)
+	if len(points) > MaxGeometryCoordinates {
+		return nil, errors.NewValidationError("points", fmt.Sprintf("cannot have more than %d points", MaxGeometryCoordinates), errors.ErrTooManyPathPoints)
 	}
 
 	coordinates := make([][]float64, len(points))
@@ -74,14 +232,28 @@ func NewGeometryFromPoints(points []Point) (*Geometry, error) {
 
 // Validate validates the geometry
 func (g *Geometry) Validate() error {
-	if g.Type != "LineString" {
-		return errors.NewValidationError("type", "geometry type must be LineString", errors.ErrInvalidGeometry)
-	}
-	if len(g.Coordinates) < 1 {
-		return errors.NewValidationError("coordinates", "at least 1 coordinate pair required", errors.ErrInvalidPath)
+	switch g.Type {
+	case GeometryTypePoint:
+		if len(g.Coordinates) != 1 {
+			return errors.NewValidationError("coordinates", "a Point must have exactly 1 coordinate pair", errors.ErrInvalidPath)
+		}
+	case GeometryTypeLineString:
+		if len(g.Coordinates) < 2 {
+			return errors.NewValidationError("coordinates", "a LineString must have at least 2 coordinate pairs", errors.ErrInvalidPath)
+		}
+	case GeometryTypeMultiPoint:
+		if len(g.Coordinates) < 1 {
+			return errors.NewValidationError("coordinates", "at least 1 coordinate pair required", errors.ErrInvalidPath)
+		}
+	case GeometryTypePolygon:
+		if len(g.Coordinates) < 4 {
+			return errors.NewValidationError("coordinates", "a Polygon ring must have at least 4 coordinate pairs", errors.ErrInvalidGeometry)
+		}
+	default:
+		return errors.NewValidationError("type", "geometry type must be Point, LineString, MultiPoint or Polygon", errors.ErrInvalidGeometry)
 	}
-	if len(g.Coordinates) > 100 {
-		return errors.NewValidationError("coordinates", "cannot have more than 100 coordinate pairs", errors.ErrTooManyPathPoints)
+	if len(g.Coordinates) > MaxGeometryCoordinates {
+		return errors.NewValidationError("coordinates", fmt.Sprintf("cannot have more than %d coordinate pairs", MaxGeometryCoordinates), errors.ErrTooManyPathPoints)
 	}
 
 	for i, coord := range g.Coordinates {
@@ -89,17 +261,93 @@ func (g *Geometry) Validate() error {
 			return errors.NewValidationError("coordinates", fmt.Sprintf("coordinate at index %d must have exactly 2 values", i), errors.ErrInvalidGeometry)
 		}
 		lng, lat := coord[0], coord[1]
-		if lat < -11 || lat > 6 {
-			return errors.NewValidationError("coordinates", fmt.Sprintf("latitude at index %d must be between -11 and 6", i), errors.ErrCoordinatesOutOfBounds)
+		if !WithinNationalLat(lat) {
+			return errors.NewValidationError("coordinates", fmt.Sprintf("latitude at index %d must be within Indonesian boundaries (-11 to 6)", i), errors.ErrCoordinatesOutOfBounds)
+		}
+		if !WithinNationalLng(lng) {
+			return errors.NewValidationError("coordinates", fmt.Sprintf("longitude at index %d must be within Indonesian boundaries (95 to 141)", i), errors.ErrCoordinatesOutOfBounds)
 		}
-		if lng < 95 || lng > 141 {
-			return errors.NewValidationError("coordinates", fmt.Sprintf("longitude at index %d must be between 95 and 141", i), errors.ErrCoordinatesOutOfBounds)
+	}
+
+	if g.Type == GeometryTypePolygon {
+		first, last := g.Coordinates[0], g.Coordinates[len(g.Coordinates)-1]
+		if first[0] != last[0] || first[1] != last[1] {
+			return errors.NewValidationError("coordinates", "a Polygon ring must end at its first coordinate pair", errors.ErrInvalidGeometry)
 		}
 	}
 
 	return nil
 }
 
+// GeoJSONCoordinates returns the coordinates in GeoJSON layout: a single [lng, lat] pair
+// for a Point, a list holding the ring for a Polygon, a list of pairs otherwise
+func (g Geometry) GeoJSONCoordinates() interface{} {
+	if g.Type == GeometryTypePoint && len(g.Coordinates) == 1 {
+		return g.Coordinates[0]
+	}
+	if g.Type == GeometryTypePolygon {
+		return [][][]float64{g.Coordinates}
+	}
+	return g.Coordinates
+}
+
+// MarshalJSON encodes the geometry as GeoJSON, as accepted by ST_GeomFromGeoJSON
+func (g Geometry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type        string      `json:"type"`
+		Coordinates interface{} `json:"coordinates"`
+	}{g.Type, g.GeoJSONCoordinates()})
+}
+
+// UnmarshalJSON decodes a GeoJSON geometry, as produced by ST_AsGeoJSON
+func (g *Geometry) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	g.Type = raw.Type
+	switch raw.Type {
+	case GeometryTypePoint:
+		var pair []float64
+		if err := json.Unmarshal(raw.Coordinates, &pair); err != nil {
+			return err
+		}
+		g.Coordinates = [][]float64{pair}
+		return nil
+	case GeometryTypePolygon:
+		// Only the exterior ring is kept
+		var rings [][][]float64
+		if err := json.Unmarshal(raw.Coordinates, &rings); err != nil {
+			return err
+		}
+		g.Coordinates = nil
+		if len(rings) > 0 {
+			g.Coordinates = rings[0]
+		}
+		return nil
+	}
+	return json.Unmarshal(raw.Coordinates, &g.Coordinates)
+}
+
+// LengthMeters returns the length of a LineString, summing the great-circle distance between
+// consecutive coordinates. Points and MultiPoints have no length and return 0
+func (g *Geometry) LengthMeters() float64 {
+	if g.Type != GeometryTypeLineString {
+		return 0
+	}
+
+	points := g.ToPoints()
+	length := 0.0
+	for i := 1; i < len(points); i++ {
+		length += points[i-1].DistanceMeters(points[i])
+	}
+	return length
+}
+
 // ToPoints converts Geometry coordinates to Point objects
 func (g *Geometry) ToPoints() []Point {
 	points := make([]Point, len(g.Coordinates))
@@ -112,6 +360,44 @@ func (g *Geometry) ToPoints() []Point {
 	return points
 }
 
+// BoundingBox is a latitude/longitude aligned rectangle, such as a map viewport
+type BoundingBox struct {
+	MinLat float64
+	MinLng float64
+	MaxLat float64
+	MaxLng float64
+}
+
+// Validate checks that the box is not empty and that both corners are within Indonesian boundaries
+func (b BoundingBox) Validate() error {
+	if b.MinLat >= b.MaxLat {
+		return errors.NewValidationError("min_lat", "min_lat must be less than max_lat", errors.ErrInvalidCoordinates)
+	}
+	if b.MinLng >= b.MaxLng {
+		return errors.NewValidationError("min_lng", "min_lng must be less than max_lng", errors.ErrInvalidCoordinates)
+	}
+	for _, corner := range []Point{{Lat: b.MinLat, Lng: b.MinLng}, {Lat: b.MaxLat, Lng: b.MaxLng}} {
+		if err := corner.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToGeometry returns the box as a Polygon, counter-clockwise from the south-west corner
+func (b BoundingBox) ToGeometry() (*Geometry, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return NewGeometryOfType(GeometryTypePolygon, [][]float64{
+		{b.MinLng, b.MinLat},
+		{b.MaxLng, b.MinLat},
+		{b.MaxLng, b.MaxLat},
+		{b.MinLng, b.MaxLat},
+		{b.MinLng, b.MinLat},
+	})
+}
+
 // SubDistrictCode represents an Indonesian administrative code (Kemendagri format)
 // Format: NN.NN.NN.NNNN (Province.District.Subdistrict.Village)
 type SubDistrictCode string
@@ -184,9 +470,10 @@ func NewTitle(title string) (Title, error) {
 	return t, nil
 }
 
-// Validate validates the title
+// Validate validates the title. The length limit applies to the text as typed, so a title
+// stored with escaped angle brackets stays valid
 func (t Title) Validate() error {
-	length := len(string(t))
+	length := unescapedLength(string(t))
 	if length < 3 {
 		return errors.NewValidationError("title", "must be at least 3 characters", errors.ErrInvalidTitle)
 	}
@@ -216,9 +503,9 @@ func NewDescription(desc string) (Description, error) {
 	return d, nil
 }
 
-// Validate validates the description
+// Validate validates the description. Like the title, its length is measured before escaping
 func (d Description) Validate() error {
-	if len(string(d)) > 500 {
+	if unescapedLength(string(d)) > 500 {
 		return errors.NewValidationError("description", "cannot exceed 500 characters", errors.ErrInvalidDescription)
 	}
 	return nil
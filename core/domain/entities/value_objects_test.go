@@ -0,0 +1,147 @@
+package entities
+
+import (
+	stderrors "errors"
+	"math"
+	"testing"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+)
+
+// offshorePoints lie south of Indonesia's national bounds (-11), about 22km and 56km out
+var (
+	nearOffshorePoint = Point{Lat: -11.2, Lng: 110.0}
+	farOffshorePoint  = Point{Lat: -11.5, Lng: 110.0}
+)
+
+func TestPointValidateUsesExactBoundsByDefault(t *testing.T) {
+	if err := (&Point{Lat: -7.2575, Lng: 112.7521}).Validate(); err != nil {
+		t.Errorf("Validate() inside Indonesia error = %v", err)
+	}
+	for _, point := range []Point{nearOffshorePoint, farOffshorePoint, {Lat: 0.5, Lng: 94.8}} {
+		if err := point.Validate(); !stderrors.Is(err, errors.ErrCoordinatesOutOfBounds) {
+			t.Errorf("Validate(%v) error = %v, want ErrCoordinatesOutOfBounds", point, err)
+		}
+	}
+	if _, err := NewGeometry([][]float64{{112.75, -7.25}, {nearOffshorePoint.Lng, nearOffshorePoint.Lat}}); !stderrors.Is(err, errors.ErrCoordinatesOutOfBounds) {
+		t.Errorf("NewGeometry() with an offshore point error = %v, want ErrCoordinatesOutOfBounds", err)
+	}
+}
+
+func TestPointValidateWidensBoundsByConfiguredBuffer(t *testing.T) {
+	SetBoundaryBuffer(30000)
+	t.Cleanup(func() { SetBoundaryBuffer(0) })
+
+	if err := nearOffshorePoint.Validate(); err != nil {
+		t.Errorf("Validate(%v) within the buffer error = %v", nearOffshorePoint, err)
+	}
+	if _, err := NewGeometry([][]float64{{112.75, -7.25}, {nearOffshorePoint.Lng, nearOffshorePoint.Lat}}); err != nil {
+		t.Errorf("NewGeometry() with a point within the buffer error = %v", err)
+	}
+	if err := farOffshorePoint.Validate(); !stderrors.Is(err, errors.ErrCoordinatesOutOfBounds) {
+		t.Errorf("Validate(%v) beyond the buffer error = %v, want ErrCoordinatesOutOfBounds", farOffshorePoint, err)
+	}
+}
+
+func TestSetBoundaryBufferIsCapped(t *testing.T) {
+	SetBoundaryBuffer(10 * MaxBoundaryBufferMeters)
+	t.Cleanup(func() { SetBoundaryBuffer(0) })
+
+	// About 111km south, well past the 50km maximum buffer
+	if WithinNationalLat(NationalMinLat - 1) {
+		t.Error("WithinNationalLat() accepted a point beyond MaxBoundaryBufferMeters")
+	}
+}
+
+func TestBoundingBoxValidate(t *testing.T) {
+	surabaya := BoundingBox{MinLat: -7.35, MinLng: 112.6, MaxLat: -7.2, MaxLng: 112.8}
+	tests := []struct {
+		name    string
+		box     BoundingBox
+		wantErr error
+	}{
+		{name: "Surabaya viewport", box: surabaya},
+		{name: "inverted latitudes", box: BoundingBox{MinLat: -7.2, MinLng: 112.6, MaxLat: -7.35, MaxLng: 112.8}, wantErr: errors.ErrInvalidCoordinates},
+		{name: "inverted longitudes", box: BoundingBox{MinLat: -7.35, MinLng: 112.8, MaxLat: -7.2, MaxLng: 112.6}, wantErr: errors.ErrInvalidCoordinates},
+		{name: "empty box", box: BoundingBox{MinLat: -7.2, MinLng: 112.6, MaxLat: -7.2, MaxLng: 112.8}, wantErr: errors.ErrInvalidCoordinates},
+		{name: "over Singapore's north", box: BoundingBox{MinLat: 1.0, MinLng: 103.5, MaxLat: 7.5, MaxLng: 104.0}, wantErr: errors.ErrCoordinatesOutOfBounds},
+		{name: "west of Sumatra", box: BoundingBox{MinLat: -1.0, MinLng: 90.0, MaxLat: 1.0, MaxLng: 96.0}, wantErr: errors.ErrCoordinatesOutOfBounds},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.box.Validate()
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	polygon, err := surabaya.ToGeometry()
+	if err != nil {
+		t.Fatalf("ToGeometry() error = %v", err)
+	}
+	if polygon.Type != GeometryTypePolygon {
+		t.Errorf("ToGeometry() type = %s, want a Polygon", polygon.Type)
+	}
+}
+
+func TestVincentyDistanceMetersReferenceLine(t *testing.T) {
+	// Flinders Peak to Buninyong, the test line from Vincenty's 1975 paper: 54972.271 m on WGS84
+	flindersPeak := Point{Lat: -(37 + 57.0/60 + 3.72030/3600), Lng: 144 + 25.0/60 + 29.52440/3600}
+	buninyong := Point{Lat: -(37 + 39.0/60 + 10.15610/3600), Lng: 143 + 55.0/60 + 35.38390/3600}
+
+	if got := flindersPeak.VincentyDistanceMeters(buninyong); math.Abs(got-54972.271) > 0.001 {
+		t.Errorf("VincentyDistanceMeters() = %.4f m, want 54972.271 m", got)
+	}
+	if got := flindersPeak.VincentyDistanceMeters(flindersPeak); got != 0 {
+		t.Errorf("VincentyDistanceMeters() to itself = %v, want 0", got)
+	}
+}
+
+func TestGeometryLengthMeters(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []Point
+		want   float64
+	}{
+		{
+			// 0.01° of latitude is R·π/18000 along a meridian
+			name:   "north along a meridian",
+			points: []Point{{Lat: -7.26, Lng: 112.75}, {Lat: -7.25, Lng: 112.75}, {Lat: -7.24, Lng: 112.75}},
+			want:   2 * 1111.949,
+		},
+		{
+			name:   "Tugu Pahlawan to Monumen Kapal Selam",
+			points: []Point{{Lat: -7.2458, Lng: 112.7378}, {Lat: -7.2654, Lng: 112.7501}},
+			want:   2567.223,
+		},
+		{
+			name:   "there and back",
+			points: []Point{{Lat: -7.2458, Lng: 112.7378}, {Lat: -7.2654, Lng: 112.7501}, {Lat: -7.2458, Lng: 112.7378}},
+			want:   2 * 2567.223,
+		},
+		{
+			name:   "single point",
+			points: []Point{{Lat: -7.2575, Lng: 112.7521}},
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := NewGeometryFromPoints(tt.points)
+			if err != nil {
+				t.Fatalf("NewGeometryFromPoints() error = %v", err)
+			}
+			if got := path.LengthMeters(); math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("LengthMeters() = %.3f, want %.3f", got, tt.want)
+			}
+		})
+	}
+}
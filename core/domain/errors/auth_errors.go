@@ -16,9 +16,15 @@ var (
 	// ErrTokenExpired is returned when a token has expired
 	ErrTokenExpired = errors.New("token has expired")
 
-	// ErrTokenRevoked is returned when a refresh token has been revoked
+	// ErrTokenRevoked is returned when a refresh token has been revoked or an access token denylisted
 	ErrTokenRevoked = errors.New("token has been revoked")
 
+	// ErrTokenReused is returned when an already-rotated refresh token is presented again
+	ErrTokenReused = errors.New("refresh token has already been used")
+
+	// ErrSessionNotFound is returned when a session does not exist, is no longer active or belongs to another user
+	ErrSessionNotFound = errors.New("session not found")
+
 	// ErrWeakPassword is returned when a password doesn't meet strength requirements
 	ErrWeakPassword = errors.New("password must be at least 8 characters and contain uppercase, lowercase, and digit")
 
@@ -3,6 +3,10 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 // Validation errors
@@ -18,6 +22,9 @@ var (
 
 	// ErrInvalidLength is returned when a field exceeds length constraints
 	ErrInvalidLength = errors.New("invalid length")
+
+	// ErrPageTooDeep is returned when a page starts beyond the deepest item a listing can reach
+	ErrPageTooDeep = errors.New("page is too deep")
 )
 
 // Damaged road report errors
@@ -25,6 +32,12 @@ var (
 	// ErrReportNotFound is returned when a damaged road report is not found
 	ErrReportNotFound = errors.New("damaged road report not found")
 
+	// ErrCategoryNotFound is returned when a report references a category missing from the taxonomy
+	ErrCategoryNotFound = errors.New("category not found")
+
+	// ErrCategoryRequired is returned when a report has no category while categories are required
+	ErrCategoryRequired = errors.New("category_id is required")
+
 	// ErrInvalidTitle is returned when report title is invalid
 	ErrInvalidTitle = errors.New("title must be between 3 and 100 characters")
 
@@ -52,9 +65,18 @@ var (
 	// ErrInvalidPhotoURL is returned when photo URL format is invalid
 	ErrInvalidPhotoURL = errors.New("invalid photo URL format")
 
+	// ErrResolutionPhotosRequired is returned when resolving a report without proof-of-repair photos
+	ErrResolutionPhotosRequired = errors.New("resolution photos are required to resolve a report")
+
+	// ErrStatusReasonRequired is returned when moving a report to a status that must be explained without a reason
+	ErrStatusReasonRequired = errors.New("a reason is required for this status")
+
 	// ErrInvalidDescription is returned when description exceeds max length
 	ErrInvalidDescription = errors.New("description cannot exceed 500 characters")
 
+	// ErrUnsafeContent is returned when title or description contains HTML markup
+	ErrUnsafeContent = errors.New("text cannot contain HTML markup")
+
 	// ErrInvalidStatus is returned when status is invalid
 	ErrInvalidStatus = errors.New("invalid status")
 
@@ -63,6 +85,15 @@ var (
 
 	// ErrUnauthorizedAccess is returned when user tries to access unauthorized resource
 	ErrUnauthorizedAccess = errors.New("unauthorized access to resource")
+
+	// ErrReportNotEditable is returned when editing a report that has moved past submitted
+	ErrReportNotEditable = errors.New("report can only be edited while it is submitted")
+
+	// ErrEditWindowExpired is returned when editing a report after the edit window following submission
+	ErrEditWindowExpired = errors.New("report can no longer be edited, the edit window after submission has passed")
+
+	// ErrInvalidNoteBody is returned when a report note is empty or too long
+	ErrInvalidNoteBody = errors.New("note must be between 1 and 2000 characters")
 )
 
 // Geospatial errors
@@ -78,6 +109,15 @@ var (
 
 	// ErrLocationMismatch is returned when coordinate and subdistrict don't match
 	ErrLocationMismatch = errors.New("coordinates do not match the specified subdistrict area")
+
+	// ErrPathDirectionReversal is returned when consecutive path segments turn back sharply, suggesting GPS scatter
+	ErrPathDirectionReversal = errors.New("path doubles back on itself, points may be out of order")
+
+	// ErrTooSoon is returned when a user submits reports faster than the configured minimum interval
+	ErrTooSoon = errors.New("too soon since your previous report")
+
+	// ErrDuplicateReport is returned when an unresolved report already covers the new report's location
+	ErrDuplicateReport = errors.New("an unresolved report already exists at this location")
 )
 
 // Repository errors
@@ -125,6 +165,68 @@ func NewValidationError(field, message string, err error) *ValidationError {
 	}
 }
 
+// CoordinateViolation describes a single coordinate that falls outside an allowed bound
+type CoordinateViolation struct {
+	Index int     // position of the coordinate in the submitted path
+	Lat   float64 // submitted latitude
+	Lng   float64 // submitted longitude
+	Axis  string  // "lat" or "lng"
+	Bound string  // "min" or "max"
+	Limit float64 // the bound that was violated
+}
+
+// CoordinateBoundsError lists every coordinate of a path that is out of bounds
+type CoordinateBoundsError struct {
+	Violations []CoordinateViolation
+}
+
+func (e *CoordinateBoundsError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		comparison := "below minimum"
+		if v.Bound == "max" {
+			comparison = "above maximum"
+		}
+		value := v.Lat
+		if v.Axis == "lng" {
+			value = v.Lng
+		}
+		parts[i] = fmt.Sprintf("coordinate %d %s %.6f is %s %.1f", v.Index, v.Axis, value, comparison, v.Limit)
+	}
+	return fmt.Sprintf("%s: %s", ErrCoordinatesOutOfBounds.Error(), strings.Join(parts, "; "))
+}
+
+func (e *CoordinateBoundsError) Unwrap() error {
+	return ErrCoordinatesOutOfBounds
+}
+
+// TooSoonError reports how long a user must wait before submitting another report
+type TooSoonError struct {
+	RetryAfter time.Duration
+}
+
+func (e *TooSoonError) Error() string {
+	return fmt.Sprintf("%s, try again in %s", ErrTooSoon.Error(), e.RetryAfter.Round(time.Second))
+}
+
+func (e *TooSoonError) Unwrap() error {
+	return ErrTooSoon
+}
+
+// DuplicateReportError identifies the unresolved report a new submission duplicates
+type DuplicateReportError struct {
+	ExistingReportID uuid.UUID
+	RadiusMeters     float64
+}
+
+func (e *DuplicateReportError) Error() string {
+	return fmt.Sprintf("%s: report %s is within %.0f meters", ErrDuplicateReport.Error(), e.ExistingReportID, e.RadiusMeters)
+}
+
+func (e *DuplicateReportError) Unwrap() error {
+	return ErrDuplicateReport
+}
+
 // DatabaseError wraps a database error with context
 type DatabaseError struct {
 	Operation string
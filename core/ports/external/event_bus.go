@@ -0,0 +1,21 @@
+package external
+
+import (
+	"context"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+// EventHandler reacts to a published domain event. Errors are reported by the bus
+// but never propagate back to the publisher.
+type EventHandler func(ctx context.Context, event entities.DomainEvent) error
+
+// EventBus dispatches domain events to subscribers so side effects such as
+// notifications, webhooks or analytics stay out of the core use cases
+type EventBus interface {
+	// Subscribe registers a handler for events with the given name
+	Subscribe(eventName string, handler EventHandler)
+
+	// Publish delivers the event to every handler subscribed to its name
+	Publish(ctx context.Context, event entities.DomainEvent)
+}
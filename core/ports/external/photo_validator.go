@@ -1,5 +1,7 @@
 package external
 
+import "context"
+
 // PhotoValidationResult represents the result of validating a photo URL
 type PhotoValidationResult struct {
 	URL         string `json:"url"`
@@ -7,6 +9,12 @@ type PhotoValidationResult struct {
 	Error       string `json:"error,omitempty"`
 	ContentType string `json:"content_type,omitempty"`
 	SizeBytes   int64  `json:"size_bytes,omitempty"`
+	// Warning flags concerns about a valid photo, e.g. an unknown size or an old Last-Modified
+	// in advisory mode. Several concerns are joined with "; "
+	Warning string `json:"warning,omitempty"`
+	// NotChecked marks an invalid result for a URL that was never fetched because the
+	// per-request fetch cap was reached; it says nothing about the photo itself
+	NotChecked bool `json:"not_checked,omitempty"`
 }
 
 // PhotoValidator defines the interface for validating photo URLs with SSRF protection.
@@ -15,16 +23,22 @@ type PhotoValidationResult struct {
 // - No localhost, private IP ranges, or link-local addresses
 // - 5 second timeout for accessibility checks
 // - Only image content types (image/jpeg, image/png, image/webp)
+// - Photos no larger than the configured maximum size, when the size is known
+// - Optionally, photos no older than a configured age, when Last-Modified is sent
+// - Optionally, only hosts on a configured allowlist, checked before any network call
 type PhotoValidator interface {
 	// ValidateURL checks if a single photo URL is valid, accessible, and secure.
-	// Returns validation result with details about the check.
-	ValidateURL(url string) PhotoValidationResult
+	// Returns validation result with details about the check. The check stops when ctx is done.
+	ValidateURL(ctx context.Context, url string) PhotoValidationResult
 
 	// ValidateURLs checks multiple photo URLs and returns results for each.
-	// Validates 1-10 URLs per FR-004 requirement.
-	ValidateURLs(urls []string) []PhotoValidationResult
+	// Validates 1-10 URLs per FR-004 requirement. URLs beyond the implementation's
+	// per-batch fetch cap are returned invalid without being fetched. URLs may be checked
+	// concurrently; results are in the order of urls. URLs not checked before ctx is done
+	// are returned invalid.
+	ValidateURLs(ctx context.Context, urls []string) []PhotoValidationResult
 
 	// IsSecureURL checks if URL passes SSRF protection without making HTTP requests.
 	// Returns error if URL uses non-HTTP(S) protocol, points to private IPs, or localhost.
-	IsSecureURL(url string) error
+	IsSecureURL(ctx context.Context, url string) error
 }
@@ -2,6 +2,7 @@ package external
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
@@ -36,6 +37,9 @@ type RefreshTokenRepository interface {
 	// FindByTokenHash retrieves a refresh token by its hash
 	FindByTokenHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error)
 
+	// FindByID retrieves a refresh token by its ID
+	FindByID(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error)
+
 	// FindByUserID retrieves all refresh tokens for a user
 	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.RefreshToken, error)
 
@@ -45,11 +49,28 @@ type RefreshTokenRepository interface {
 	// RevokeByUserID revokes all refresh tokens for a user
 	RevokeByUserID(ctx context.Context, userID uuid.UUID) error
 
+	// Rotate atomically revokes the presented token and stores its replacement.
+	// Returns errors.ErrTokenRevoked if the presented token is no longer active, so that
+	// only one of several concurrent rotations of the same token can succeed
+	Rotate(ctx context.Context, presentedID uuid.UUID, replacement *entities.RefreshToken) error
+
+	// IsRotated reports whether the token has already been replaced by a successor,
+	// distinguishing a rotated token from one revoked at logout
+	IsRotated(ctx context.Context, tokenID uuid.UUID) (bool, error)
+
 	// RevokeByTokenHash revokes a specific refresh token
 	RevokeByTokenHash(ctx context.Context, tokenHash string) error
 
-	// DeleteExpired deletes all expired refresh tokens
-	DeleteExpired(ctx context.Context) error
+	// RevokeByID revokes a specific refresh token by its ID
+	RevokeByID(ctx context.Context, id uuid.UUID) error
+
+	// RevokeByUserIDAndDeviceID revokes all refresh tokens a user holds on one device
+	// Returns the number of tokens revoked
+	RevokeByUserIDAndDeviceID(ctx context.Context, userID uuid.UUID, deviceID string) (int64, error)
+
+	// DeleteExpired deletes all expired refresh tokens, at most batchSize per statement
+	// (0 or less deletes them in one statement). Returns the number of tokens removed
+	DeleteExpired(ctx context.Context, batchSize int) (int64, error)
 }
 
 // PasswordResetTokenRepository defines the interface for password reset token persistence
@@ -66,8 +87,9 @@ type PasswordResetTokenRepository interface {
 	// DeleteByUserID deletes all password reset tokens for a user
 	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
 
-	// DeleteExpired deletes all expired password reset tokens
-	DeleteExpired(ctx context.Context) error
+	// DeleteExpired deletes all expired password reset tokens, at most batchSize per statement
+	// (0 or less deletes them in one statement). Returns the number of tokens removed
+	DeleteExpired(ctx context.Context, batchSize int) (int64, error)
 }
 
 // AuthEventLogRepository defines the interface for auth event log persistence
@@ -78,35 +100,122 @@ type AuthEventLogRepository interface {
 	// FindByUserID retrieves auth event logs for a user
 	FindByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]*entities.AuthEventLog, error)
 
+	// StreamByUserID iterates over all auth event logs for a user, oldest first, invoking fn for each.
+	// Iteration stops at the first error returned by fn
+	StreamByUserID(ctx context.Context, userID uuid.UUID, fn func(*entities.AuthEventLog) error) error
+
 	// FindFailedLoginAttempts retrieves recent failed login attempts by IP or email
 	FindFailedLoginAttempts(ctx context.Context, ipAddress string, limit int) ([]*entities.AuthEventLog, error)
 }
 
-// DamagedRoadRepository defines the interface for damaged road report persistence
+// AdminAuditLogRepository defines the interface for admin audit trail persistence
+type AdminAuditLogRepository interface {
+	// Create creates a new admin audit log entry
+	Create(ctx context.Context, entry *entities.AdminAuditEntry) error
+}
+
+// DamagedRoadRepository defines the interface for damaged road report persistence.
+// Deleted reports are skipped by every read and update unless filters.IncludeDeleted is set
 type DamagedRoadRepository interface {
 	// Create creates a new damaged road report
 	Create(ctx context.Context, road *entities.DamagedRoad) error
 
+	// CreateAfterInterval creates a report unless the author's latest report, deleted or not, is
+	// more recent than minInterval, in which case it returns a *errors.TooSoonError. The check
+	// and the insert are atomic, so concurrent submissions cannot both pass it
+	CreateAfterInterval(ctx context.Context, road *entities.DamagedRoad, minInterval time.Duration) error
+
 	// FindByID retrieves a damaged road report by ID
 	FindByID(ctx context.Context, id uuid.UUID) (*entities.DamagedRoad, error)
 
+	// FindByIDInSRID retrieves a damaged road report by ID, also setting ProjectedPath to its path
+	// reprojected to srid unless srid is WGS84
+	FindByIDInSRID(ctx context.Context, id uuid.UUID, srid int) (*entities.DamagedRoad, error)
+
 	// FindByAuthor retrieves damaged road reports by author with pagination
 	FindByAuthor(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]*entities.DamagedRoad, int, error)
 
 	// List retrieves damaged road reports with filters and pagination
 	List(ctx context.Context, filters *entities.DamagedRoadFilters) ([]*entities.DamagedRoad, int, error)
 
-	// UpdateStatus updates the status of a damaged road report
-	UpdateStatus(ctx context.Context, id uuid.UUID, status entities.Status) error
+	// Count returns the total number of reports that are not deleted, ignoring any filters
+	Count(ctx context.Context) (int, error)
+
+	// StreamList iterates over all reports matching the filters without pagination,
+	// calling fn for each report as it is read from the database cursor
+	StreamList(ctx context.Context, filters *entities.DamagedRoadFilters, fn func(*entities.DamagedRoad) error) error
+
+	// UpdateStatus applies a status change to its report, recording it in the status history and
+	// storing the given proof-of-repair photos alongside when resolving, all in one transaction
+	UpdateStatus(ctx context.Context, change *entities.StatusChange, resolutionPhotoURLs []string) error
 
-	// Update updates an existing damaged road report
+	// Update saves an author's edit of a report's title, subdistrict, path, description and photos.
+	// The status is left alone. Returns errors.ErrReportNotEditable if the stored report is no
+	// longer submitted, e.g. because staff changed its status after it was loaded
 	Update(ctx context.Context, road *entities.DamagedRoad) error
 
-	// Delete deletes a damaged road report by ID
+	// UpdatePath updates only the path and updated_at of a report, leaving its photos untouched.
+	// Like Update, it returns errors.ErrReportNotEditable once the report is past submitted
+	UpdatePath(ctx context.Context, id uuid.UUID, path entities.Geometry, updatedAt time.Time) error
+
+	// Delete soft-deletes a damaged road report by ID, keeping the row for auditing and recovery
 	Delete(ctx context.Context, id uuid.UUID) error
 
-	// FindByGeometry finds damaged road reports within a geographic boundary
-	FindByGeometry(ctx context.Context, bounds entities.Geometry) ([]*entities.DamagedRoad, error)
+	// FindByGeometry finds damaged road reports within a geographic boundary, newest first.
+	// At most limit reports are returned; zero or less means no limit
+	FindByGeometry(ctx context.Context, bounds entities.Geometry, limit int) ([]*entities.DamagedRoad, error)
+
+	// FindWithinRadius finds reports whose path comes within radiusMeters of center,
+	// closest first, with DistanceMeters populated. At most limit reports are returned
+	FindWithinRadius(ctx context.Context, center entities.Point, radiusMeters float64, limit int) ([]*entities.DamagedRoad, error)
+
+	// FindNearestUnresolvedDistance returns the distance in meters from path to the closest
+	// report that is not resolved or archived, searching within radiusMeters.
+	// Returns nil when no such report exists within the radius.
+	FindNearestUnresolvedDistance(ctx context.Context, path entities.Geometry, radiusMeters float64) (*float64, error)
+
+	// FindNearestUnresolvedID returns the ID of the closest report that is not resolved or
+	// archived within radiusMeters of path, or nil when there is none
+	FindNearestUnresolvedID(ctx context.Context, path entities.Geometry, radiusMeters float64) (*uuid.UUID, error)
+
+	// FindLatestCreatedAtByAuthor returns when the author last submitted a report, deleted or not,
+	// or nil if never
+	FindLatestCreatedAtByAuthor(ctx context.Context, authorID uuid.UUID) (*time.Time, error)
+}
+
+// UserPreferencesRepository defines the interface for user preference persistence
+type UserPreferencesRepository interface {
+	// FindByUserID retrieves a user's stored preferences, or nil if they never changed any
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserPreferences, error)
+
+	// Upsert creates or replaces a user's preferences
+	Upsert(ctx context.Context, preferences *entities.UserPreferences) error
+}
+
+// CategoryRepository defines the interface for reading the report category taxonomy
+type CategoryRepository interface {
+	// FindAll retrieves every category in display order
+	FindAll(ctx context.Context) ([]*entities.Category, error)
+
+	// Exists checks whether a category with the given ID exists
+	Exists(ctx context.Context, id string) (bool, error)
+}
+
+// ReportNoteRepository defines the interface for report note persistence
+type ReportNoteRepository interface {
+	// Create creates a new report note
+	Create(ctx context.Context, note *entities.ReportNote) error
+
+	// FindByReport retrieves the notes of a report, oldest first.
+	// Internal notes are only returned when includeInternal is true
+	FindByReport(ctx context.Context, reportID uuid.UUID, includeInternal bool) ([]*entities.ReportNote, error)
+}
+
+// ReportStatusHistoryRepository defines the interface for reading report status transitions.
+// Transitions are written by DamagedRoadRepository.UpdateStatus
+type ReportStatusHistoryRepository interface {
+	// FindByReport retrieves the status transitions of a report, oldest first
+	FindByReport(ctx context.Context, reportID uuid.UUID) ([]*entities.StatusChange, error)
 }
 
 // BoundaryRepository defines the interface for administrative boundary and centroid data.
@@ -114,11 +223,18 @@ type DamagedRoadRepository interface {
 type BoundaryRepository interface {
 	// GetCentroid retrieves the geographic centroid for a given subdistrict code.
 	// Returns error if subdistrict code is not found in the boundary dataset.
-	GetCentroid(subDistrictCode entities.SubDistrictCode) (entities.Point, error)
+	GetCentroid(ctx context.Context, subDistrictCode entities.SubDistrictCode) (entities.Point, error)
 
 	// CheckSubDistrictExists verifies if a subdistrict code exists in the official dataset.
-	CheckSubDistrictExists(subDistrictCode entities.SubDistrictCode) (bool, error)
+	CheckSubDistrictExists(ctx context.Context, subDistrictCode entities.SubDistrictCode) (bool, error)
+
+	// FindExistingSubDistricts returns which of the given codes exist in the dataset, in one query.
+	FindExistingSubDistricts(ctx context.Context, subDistrictCodes []entities.SubDistrictCode) ([]entities.SubDistrictCode, error)
 
 	// StoreCentroid stores centroid data for a subdistrict (for data seeding/updates).
-	StoreCentroid(subDistrictCode entities.SubDistrictCode, centroid entities.Point) error
+	StoreCentroid(ctx context.Context, subDistrictCode entities.SubDistrictCode, centroid entities.Point) error
+
+	// HasBoundaryData reports whether the boundary dataset contains any centroids.
+	// A fresh deploy has an empty dataset until it is seeded.
+	HasBoundaryData(ctx context.Context) (bool, error)
 }
@@ -1,22 +1,44 @@
 package external
 
-import "context"
+import (
+	"context"
+	"time"
+)
+
+// AccessTokenClaims holds the identity carried by a validated access token
+type AccessTokenClaims struct {
+	UserID string
+	// Role is empty for tokens issued before roles were embedded
+	Role string
+	// TokenID is the unique JWT ID (jti), empty for tokens issued before it was added
+	TokenID   string
+	ExpiresAt time.Time
+}
 
 // TokenGenerator defines the interface for JWT token generation and validation
 type TokenGenerator interface {
-	// GenerateAccessToken creates a new JWT access token for the given user ID
-	GenerateAccessToken(ctx context.Context, userID string) (string, error)
+	// GenerateAccessToken creates a new JWT access token for the given user ID and role
+	GenerateAccessToken(ctx context.Context, userID, role string) (string, error)
 
 	// GenerateRefreshToken creates a new refresh token
 	GenerateRefreshToken(ctx context.Context) (string, error)
 
-	// ValidateAccessToken validates an access token and returns the user ID
-	ValidateAccessToken(ctx context.Context, token string) (userID string, err error)
+	// ValidateAccessToken validates an access token's signature and expiry and returns its claims
+	ValidateAccessToken(ctx context.Context, token string) (*AccessTokenClaims, error)
 
 	// HashToken creates a hash of the token for secure storage
 	HashToken(ctx context.Context, token string) (string, error)
 }
 
+// TokenDenylist records access tokens revoked before they expire, keyed by JWT ID
+type TokenDenylist interface {
+	// Add denylists a token until its expiry, after which it is rejected anyway
+	Add(ctx context.Context, tokenID string, expiresAt time.Time) error
+
+	// Contains reports whether a token has been denylisted
+	Contains(ctx context.Context, tokenID string) (bool, error)
+}
+
 // PasswordHasher defines the interface for password hashing and verification
 type PasswordHasher interface {
 	// Hash creates a bcrypt hash from a plain text password
@@ -36,4 +58,7 @@ type EmailService interface {
 
 	// SendPasswordChangedEmail sends a notification email after password change
 	SendPasswordChangedEmail(ctx context.Context, to, name string) error
+
+	// SendReportStatusChangedEmail notifies a report's author that its status changed
+	SendReportStatusChangedEmail(ctx context.Context, to, name, reportTitle, status, reportID string) error
 }
@@ -0,0 +1,21 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+// MaxActivityItems is how deep the activity timeline can be paged. Every page merges the
+// newest items of both sources up to its end, so deeper pages would load ever more rows
+const MaxActivityItems = 1000
+
+// ActivityService defines the use case interface for a user's activity timeline
+type ActivityService interface {
+	// GetUserActivity returns the user's auth events and report submissions merged
+	// into a single timeline, newest first.
+	// Returns the requested page and whether more items exist beyond it, or errors.ErrPageTooDeep
+	// when the page ends beyond the first MaxActivityItems items
+	GetUserActivity(ctx context.Context, userID uuid.UUID, limit, offset int) (items []*entities.ActivityItem, hasMore bool, err error)
+}
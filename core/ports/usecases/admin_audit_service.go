@@ -0,0 +1,13 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+// AdminAuditService defines the admin accountability use case interface
+type AdminAuditService interface {
+	// RecordAction stores an audit entry for an admin mutation
+	RecordAction(ctx context.Context, entry *entities.AdminAuditEntry) error
+}
@@ -9,18 +9,37 @@ import (
 // AuthService defines the authentication use case interface
 type AuthService interface {
 	// Login authenticates a user with email and password
+	// deviceID is optional and binds the issued refresh token to a client device
 	// Returns access token, refresh token, and error
-	Login(ctx context.Context, email, password, ipAddress, userAgent string) (accessToken, refreshToken string, err error)
+	Login(ctx context.Context, email, password, ipAddress, userAgent, deviceID string) (accessToken, refreshToken string, err error)
 
-	// RefreshToken generates a new access token using a valid refresh token
-	// Returns new access token and error
-	RefreshToken(ctx context.Context, refreshToken, ipAddress, userAgent string) (accessToken string, err error)
+	// RefreshToken generates a new access token using a valid refresh token and rotates the
+	// refresh token: the presented one is revoked and a new one is issued in its place
+	// Returns new access token, new refresh token and error
+	RefreshToken(ctx context.Context, refreshToken, ipAddress, userAgent string) (accessToken, newRefreshToken string, err error)
 
-	// Logout invalidates the user's refresh token
-	Logout(ctx context.Context, userID string, refreshToken string) error
+	// Logout invalidates the user's refresh token and denylists the access token used for the request
+	Logout(ctx context.Context, userID, accessToken, refreshToken string) error
 
-	// VerifyAccessToken validates an access token and returns the user ID
-	VerifyAccessToken(ctx context.Context, accessToken string) (userID string, err error)
+	// LogoutAll revokes every refresh token the user holds and denylists the access token
+	// used for the request
+	LogoutAll(ctx context.Context, userID, accessToken string) error
+
+	// ListSessions returns the user's active (non-revoked, unexpired) refresh tokens, newest first
+	ListSessions(ctx context.Context, userID string) ([]*entities.RefreshToken, error)
+
+	// RevokeSession revokes one of the user's active refresh tokens by its ID.
+	// Returns ErrSessionNotFound if the session is not active or belongs to another user
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+
+	// RevokeDeviceSessions revokes every refresh token the user holds on the given device
+	// Returns the number of sessions revoked
+	RevokeDeviceSessions(ctx context.Context, userID, deviceID string) (int, error)
+
+	// VerifyAccessToken validates an access token and returns the user ID and the role
+	// embedded at issue time (empty for tokens issued before roles were embedded).
+	// Returns ErrTokenRevoked for denylisted tokens
+	VerifyAccessToken(ctx context.Context, accessToken string) (userID, role string, err error)
 }
 
 // UserService defines the user management use case interface
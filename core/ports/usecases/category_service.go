@@ -0,0 +1,13 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+// CategoryService defines the use case interface for the report category taxonomy
+type CategoryService interface {
+	// ListCategories retrieves every category in display order
+	ListCategories(ctx context.Context) ([]*entities.Category, error)
+}
@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+// UserDataSink receives the sections of a user data export as they are read.
+// The profile is written first, then every report, then every auth event
+type UserDataSink interface {
+	WriteProfile(user *entities.User) error
+	WriteReport(road *entities.DamagedRoad) error
+	WriteAuthEvent(event *entities.AuthEventLog) error
+}
+
+// DataExportService defines the use case interface for data-portability exports
+type DataExportService interface {
+	// ExportUserData streams the user's profile, reports and auth event history into sink.
+	// Only records belonging to the user are included
+	ExportUserData(ctx context.Context, userID uuid.UUID, sink UserDataSink) error
+}
@@ -1,24 +1,55 @@
 package usecases
 
-import "github.com/nicklaros/jalanrusak-be/core/domain/entities"
+import (
+	"context"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
 
 // GeometryService provides geospatial validation operations for damaged road reports.
 // It validates coordinates against Indonesian boundaries and subdistrict centroids.
 type GeometryService interface {
-	// ValidateCoordinatesInBoundary checks if all coordinates fall within Indonesian national boundaries.
-	// Returns error if any coordinate is outside bounds (lat: -11 to 6, lng: 95 to 141).
+	// ValidateCoordinatesInBoundary checks coordinates against Indonesian national boundaries
+	// (lat: -11 to 6, lng: 95 to 141). Depending on the configured mode, either all coordinates must
+	// be inside, or at least one inside with the rest within a small buffer.
+	// Returns *errors.CoordinateBoundsError listing every offending coordinate.
 	ValidateCoordinatesInBoundary(points []entities.Point) error
 
 	// ValidateCoordinatesNearCentroid checks if at least one coordinate from the path
 	// falls within the specified radius (in meters) of the subdistrict's centroid.
-	// Returns error if subdistrict code not found or all coordinates are too far.
-	ValidateCoordinatesNearCentroid(points []entities.Point, subDistrictCode entities.SubDistrictCode, radiusMeters float64) error
+	// Returns an error wrapping ErrSubDistrictNotFound when the centroid is unknown, or
+	// ErrLocationNotInBoundary when all coordinates are too far.
+	// Passes without a centroid check while the boundary dataset is empty.
+	ValidateCoordinatesNearCentroid(ctx context.Context, points []entities.Point, subDistrictCode entities.SubDistrictCode, radiusMeters float64) error
 
-	// CalculateDistance computes the Haversine distance in meters between two points.
+	// CalculateDistance computes the distance in meters between two points, with Haversine by
+	// default or Vincenty on the WGS84 ellipsoid when configured.
 	// Used for proximity validation and reporting.
 	CalculateDistance(point1, point2 entities.Point) float64
 
+	// CalculateBearing computes the initial great-circle bearing in degrees (0-360, clockwise
+	// from north) from one point to another.
+	CalculateBearing(from, to entities.Point) float64
+
+	// FindDirectionReversals returns the indices of path vertices where the direction of travel
+	// turns by more than maxTurnDegrees, which usually indicates scattered or unordered points.
+	// Zero-length segments are skipped.
+	FindDirectionReversals(points []entities.Point, maxTurnDegrees float64) []int
+
+	// ValidateSubDistrictExists checks that the subdistrict code is present in the boundary dataset.
+	// Returns an error wrapping errors.ErrSubDistrictNotFound for unknown codes.
+	// Passes while the boundary dataset is empty.
+	ValidateSubDistrictExists(ctx context.Context, subDistrictCode entities.SubDistrictCode) error
+
+	// CheckSubDistrictsExist reports for each code whether it is present in the boundary dataset,
+	// looking all of them up at once. Codes with an invalid format are reported as missing.
+	CheckSubDistrictsExist(ctx context.Context, subDistrictCodes []string) (map[string]bool, error)
+
+	// IsBoundaryDataAvailable reports whether the subdistrict boundary dataset has been seeded.
+	// While it is empty, centroid validation degrades to national bounds only.
+	IsBoundaryDataAvailable(ctx context.Context) bool
+
 	// GetSubDistrictCentroid retrieves the geographic centroid for a given subdistrict code.
 	// Returns error if subdistrict not found in the boundary dataset.
-	GetSubDistrictCentroid(subDistrictCode entities.SubDistrictCode) (entities.Point, error)
+	GetSubDistrictCentroid(ctx context.Context, subDistrictCode entities.SubDistrictCode) (entities.Point, error)
 }
@@ -0,0 +1,16 @@
+package usecases
+
+import "context"
+
+// TokenCleanupResult reports how many expired tokens a cleanup run removed
+type TokenCleanupResult struct {
+	RefreshTokensRemoved       int64
+	PasswordResetTokensRemoved int64
+}
+
+// MaintenanceService defines operational maintenance use cases
+type MaintenanceService interface {
+	// CleanupExpiredTokens deletes expired refresh and password reset tokens
+	// Returns the number of tokens removed from each store
+	CleanupExpiredTokens(ctx context.Context) (*TokenCleanupResult, error)
+}
@@ -0,0 +1,24 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+// ReportNoteService defines the use case interface for verifier notes on reports
+type ReportNoteService interface {
+	// AddNote adds a note to a report. Callers are expected to be staff (verificators or admins)
+	AddNote(
+		ctx context.Context,
+		reportID uuid.UUID,
+		authorID uuid.UUID,
+		body string,
+		internal bool,
+	) (*entities.ReportNote, error)
+
+	// ListNotes retrieves the notes of a report visible to a user with viewerRole, oldest first.
+	// Internal notes are only included for staff roles
+	ListNotes(ctx context.Context, reportID uuid.UUID, viewerRole string) ([]*entities.ReportNote, error)
+}
@@ -10,7 +10,8 @@ import (
 // ReportService defines the use case interface for damaged road report operations
 type ReportService interface {
 	// CreateReport creates a new damaged road report
-	// Returns the created report or an error if validation fails
+	// Returns the created report or an error if validation fails. Unless force is set, a
+	// *errors.DuplicateReportError is returned when an unresolved report already exists nearby
 	CreateReport(
 		ctx context.Context,
 		title entities.Title,
@@ -19,10 +20,13 @@ type ReportService interface {
 		photoURLs []string,
 		authorID uuid.UUID,
 		description *entities.Description,
+		categoryID *string,
+		force bool,
 	) (*entities.DamagedRoad, error)
 
-	// GetReport retrieves a damaged road report by ID
-	GetReport(ctx context.Context, id uuid.UUID) (*entities.DamagedRoad, error)
+	// GetReport retrieves a damaged road report by ID, with its path also reprojected to srid
+	// unless srid is 0 or WGS84
+	GetReport(ctx context.Context, id uuid.UUID, srid int) (*entities.DamagedRoad, error)
 
 	// ListReportsByAuthor retrieves all reports created by a specific author
 	ListReportsByAuthor(
@@ -37,16 +41,75 @@ type ReportService interface {
 		filters *entities.DamagedRoadFilters,
 	) ([]*entities.DamagedRoad, int, error)
 
+	// FindNearbyReports retrieves reports within radiusMeters of center, closest first.
+	// The radius must be positive and at most entities.MaxNearbyRadiusMeters
+	FindNearbyReports(
+		ctx context.Context,
+		center entities.Point,
+		radiusMeters float64,
+		limit int,
+	) ([]*entities.DamagedRoad, error)
+
+	// FindReportsInBoundingBox retrieves the reports inside a map viewport, newest first.
+	// At most the configured map result limit is returned; truncated reports whether more matched
+	FindReportsInBoundingBox(
+		ctx context.Context,
+		box entities.BoundingBox,
+	) (roads []*entities.DamagedRoad, truncated bool, err error)
+
+	// CountReports returns the total number of reports regardless of filters,
+	// so clients can tell "nothing matches" apart from "nothing exists"
+	CountReports(ctx context.Context) (int, error)
+
+	// StreamReports calls fn for every report matching the filters, ignoring pagination,
+	// so large result sets never have to be held in memory at once
+	StreamReports(
+		ctx context.Context,
+		filters *entities.DamagedRoadFilters,
+		fn func(*entities.DamagedRoad) error,
+	) error
+
 	// UpdateReportStatus updates the status of a damaged road report
-	// Only authorized users (verificators/admins) can update status
+	// Only authorized users (verificators/admins) can update status.
+	// resolutionPhotoURLs are proof-of-repair photos, only accepted when resolving.
+	// reason explains the change and is recorded in the status history; it may be required per status
 	UpdateReportStatus(
 		ctx context.Context,
 		id uuid.UUID,
 		newStatus entities.Status,
+		reason string,
+		resolutionPhotoURLs []string,
+		requesterID uuid.UUID,
+	) (*entities.DamagedRoad, error)
+
+	// GetStatusHistory retrieves the status transitions of a report, oldest first,
+	// including who made each change
+	GetStatusHistory(ctx context.Context, id uuid.UUID) ([]*entities.StatusChange, error)
+
+	// UpdateReportPath replaces only the path of a report, re-running geometry validation
+	// and keeping its photos. Same author, status and edit window rules as UpdateReport
+	UpdateReportPath(
+		ctx context.Context,
+		id uuid.UUID,
+		requesterID uuid.UUID,
+		pathPoints []entities.Point,
+	) (*entities.DamagedRoad, error)
+
+	// UpdateReport replaces the title, description, photos and path of a report,
+	// re-running photo and geometry validation.
+	// Only the author can edit, and only while the report is still submitted and
+	// within the configured edit window after creation
+	UpdateReport(
+		ctx context.Context,
+		id uuid.UUID,
 		requesterID uuid.UUID,
+		title entities.Title,
+		description *entities.Description,
+		photoURLs []string,
+		pathPoints []entities.Point,
 	) (*entities.DamagedRoad, error)
 
-	// DeleteReport deletes a damaged road report
+	// DeleteReport soft-deletes a damaged road report; it is kept for auditing but hidden from reads
 	// Only the author can delete their own report
 	DeleteReport(ctx context.Context, id uuid.UUID, requesterID uuid.UUID) error
 }
@@ -0,0 +1,17 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+)
+
+// UserPreferencesService defines the use case interface for a user's notification preferences
+type UserPreferencesService interface {
+	// GetPreferences retrieves a user's preferences, falling back to the defaults
+	GetPreferences(ctx context.Context, userID uuid.UUID) (*entities.UserPreferences, error)
+
+	// UpdatePreferences applies a partial update and returns the resulting preferences
+	UpdatePreferences(ctx context.Context, userID uuid.UUID, update entities.UserPreferencesUpdate) (*entities.UserPreferences, error)
+}
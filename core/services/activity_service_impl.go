@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// ActivityServiceImpl implements the ActivityService use case
+type ActivityServiceImpl struct {
+	eventLogRepo external.AuthEventLogRepository
+	reportRepo   external.DamagedRoadRepository
+}
+
+// NewActivityService creates a new ActivityService instance
+func NewActivityService(
+	eventLogRepo external.AuthEventLogRepository,
+	reportRepo external.DamagedRoadRepository,
+) usecases.ActivityService {
+	return &ActivityServiceImpl{
+		eventLogRepo: eventLogRepo,
+		reportRepo:   reportRepo,
+	}
+}
+
+// GetUserActivity merges the user's auth events and report submissions into one timeline
+func (s *ActivityServiceImpl) GetUserActivity(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit, offset int,
+) ([]*entities.ActivityItem, bool, error) {
+	logger.DebugContext(ctx, "Retrieving user activity", map[string]interface{}{
+		"user_id": userID.String(),
+		"limit":   limit,
+		"offset":  offset,
+	})
+
+	// Set default pagination values
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if offset+limit > usecases.MaxActivityItems {
+		return nil, false, errors.ErrPageTooDeep
+	}
+
+	// Both sources are sorted newest first, so the first offset+limit+1 items of
+	// each are enough to build the requested page and detect a following one.
+	// The depth cap above bounds this window
+	window := offset + limit + 1
+
+	events, err := s.eventLogRepo.FindByUserID(ctx, userID, window)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to retrieve auth events for activity", map[string]interface{}{
+			"user_id": userID.String(),
+			"error":   err.Error(),
+		})
+		return nil, false, fmt.Errorf("failed to get auth events: %w", err)
+	}
+
+	reports, _, err := s.reportRepo.FindByAuthor(ctx, userID, window, 0)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to retrieve reports for activity", map[string]interface{}{
+			"user_id": userID.String(),
+			"error":   err.Error(),
+		})
+		return nil, false, fmt.Errorf("failed to get reports: %w", err)
+	}
+
+	items := make([]*entities.ActivityItem, 0, len(events)+len(reports))
+	for _, event := range events {
+		items = append(items, entities.NewAuthEventActivity(event))
+	}
+	for _, report := range reports {
+		items = append(items, entities.NewReportActivity(report))
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].OccurredAt.After(items[j].OccurredAt)
+	})
+
+	if offset >= len(items) {
+		return []*entities.ActivityItem{}, false, nil
+	}
+
+	end := offset + limit
+	hasMore := len(items) > end
+	if end > len(items) {
+		end = len(items)
+	}
+
+	return items[offset:end], hasMore, nil
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+)
+
+func TestGetUserActivityMergesPagesInOrder(t *testing.T) {
+	userID := uuid.New()
+	start := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	// Events and reports interleave unevenly: a run of logins, then alternating items
+	eventLog := &fakeExportEventLogRepo{}
+	reportRepo := &fakeReportRepo{}
+	for i, kind := range []string{"e", "e", "e", "r", "e", "r", "r", "e", "r", "r", "e"} {
+		at := start.Add(time.Duration(i) * time.Hour)
+		if kind == "e" {
+			eventLog.events = append(eventLog.events, &entities.AuthEventLog{ID: uuid.New(), UserID: &userID, CreatedAt: at})
+		} else {
+			reportRepo.reports = append(reportRepo.reports, &entities.DamagedRoad{ID: uuid.New(), AuthorID: userID, CreatedAt: at})
+		}
+	}
+	service := NewActivityService(eventLog, reportRepo)
+
+	const limit = 3
+	var timeline []*entities.ActivityItem
+	for offset := 0; ; offset += limit {
+		items, hasMore, err := service.GetUserActivity(context.Background(), userID, limit, offset)
+		if err != nil {
+			t.Fatalf("GetUserActivity(offset %d) error = %v", offset, err)
+		}
+		timeline = append(timeline, items...)
+		if !hasMore {
+			break
+		}
+	}
+
+	if len(timeline) != 11 {
+		t.Fatalf("pages returned %d items, want all 11", len(timeline))
+	}
+	for i := range timeline {
+		want := start.Add(time.Duration(10-i) * time.Hour)
+		if !timeline[i].OccurredAt.Equal(want) {
+			t.Errorf("item %d occurred at %s, want %s", i, timeline[i].OccurredAt.Format(time.Kitchen), want.Format(time.Kitchen))
+		}
+	}
+}
+
+func TestGetUserActivityRejectsPagesBeyondCap(t *testing.T) {
+	service := NewActivityService(&fakeExportEventLogRepo{}, &fakeReportRepo{})
+
+	if _, _, err := service.GetUserActivity(context.Background(), uuid.New(), 20, usecases.MaxActivityItems-20); err != nil {
+		t.Errorf("GetUserActivity(last page within the cap) error = %v", err)
+	}
+	_, _, err := service.GetUserActivity(context.Background(), uuid.New(), 20, usecases.MaxActivityItems)
+	if !stderrors.Is(err, errors.ErrPageTooDeep) {
+		t.Errorf("GetUserActivity(beyond the cap) error = %v, want ErrPageTooDeep", err)
+	}
+}
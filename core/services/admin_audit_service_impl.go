@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// AdminAuditServiceImpl implements the AdminAuditService use case
+type AdminAuditServiceImpl struct {
+	auditRepo external.AdminAuditLogRepository
+}
+
+// NewAdminAuditService creates a new AdminAuditService instance
+func NewAdminAuditService(auditRepo external.AdminAuditLogRepository) usecases.AdminAuditService {
+	return &AdminAuditServiceImpl{
+		auditRepo: auditRepo,
+	}
+}
+
+// RecordAction stores the audit entry, also writing it to the application log so the
+// action is traceable even if the insert fails
+func (s *AdminAuditServiceImpl) RecordAction(ctx context.Context, entry *entities.AdminAuditEntry) error {
+	fields := map[string]interface{}{
+		"admin_id":    entry.AdminID.String(),
+		"action":      entry.Action,
+		"target":      entry.Target,
+		"method":      entry.Method,
+		"path":        entry.Path,
+		"status_code": entry.StatusCode,
+	}
+
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		fields["error"] = err.Error()
+		logger.ErrorContext(ctx, "Failed to store admin audit entry", fields)
+		return fmt.Errorf("failed to store admin audit entry: %w", err)
+	}
+
+	logger.InfoContext(ctx, "Admin action recorded", fields)
+	return nil
+}
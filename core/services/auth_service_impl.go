@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -17,6 +18,7 @@ type AuthServiceImpl struct {
 	tokenRepo       external.RefreshTokenRepository
 	passwordHasher  external.PasswordHasher
 	tokenGenerator  external.TokenGenerator
+	tokenDenylist   external.TokenDenylist
 	eventLogRepo    external.AuthEventLogRepository
 	refreshTokenTTL int // TTL in days
 }
@@ -27,6 +29,7 @@ func NewAuthService(
 	tokenRepo external.RefreshTokenRepository,
 	passwordHasher external.PasswordHasher,
 	tokenGenerator external.TokenGenerator,
+	tokenDenylist external.TokenDenylist,
 	eventLogRepo external.AuthEventLogRepository,
 	refreshTokenTTL int,
 ) usecases.AuthService {
@@ -35,13 +38,14 @@ func NewAuthService(
 		tokenRepo:       tokenRepo,
 		passwordHasher:  passwordHasher,
 		tokenGenerator:  tokenGenerator,
+		tokenDenylist:   tokenDenylist,
 		eventLogRepo:    eventLogRepo,
 		refreshTokenTTL: refreshTokenTTL,
 	}
 }
 
 // Login authenticates a user with email and password
-func (s *AuthServiceImpl) Login(ctx context.Context, email, password, ipAddress, userAgent string) (accessToken, refreshToken string, err error) {
+func (s *AuthServiceImpl) Login(ctx context.Context, email, password, ipAddress, userAgent, deviceID string) (accessToken, refreshToken string, err error) {
 	// Find user by email
 	user, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil {
@@ -61,7 +65,7 @@ func (s *AuthServiceImpl) Login(ctx context.Context, email, password, ipAddress,
 	}
 
 	// Generate access token
-	accessToken, err = s.tokenGenerator.GenerateAccessToken(ctx, user.ID.String())
+	accessToken, err = s.tokenGenerator.GenerateAccessToken(ctx, user.ID.String(), user.Role)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to generate access token: %w", err)
 	}
@@ -80,6 +84,8 @@ func (s *AuthServiceImpl) Login(ctx context.Context, email, password, ipAddress,
 
 	// Save refresh token to repository
 	tokenEntity := entities.NewRefreshToken(user.ID, refreshTokenHash, s.refreshTokenTTL)
+	tokenEntity.BindDevice(deviceID)
+	tokenEntity.BindClient(ipAddress, userAgent)
 	if err := s.tokenRepo.Create(ctx, tokenEntity); err != nil {
 		return "", "", fmt.Errorf("failed to save refresh token: %w", err)
 	}
@@ -97,58 +103,99 @@ func (s *AuthServiceImpl) Login(ctx context.Context, email, password, ipAddress,
 	return accessToken, refreshTokenRaw, nil
 }
 
-// RefreshToken generates a new access token using a valid refresh token
-func (s *AuthServiceImpl) RefreshToken(ctx context.Context, refreshToken, ipAddress, userAgent string) (accessToken string, err error) {
+// RefreshToken generates a new access token using a valid refresh token and rotates the refresh token
+func (s *AuthServiceImpl) RefreshToken(ctx context.Context, refreshToken, ipAddress, userAgent string) (accessToken, newRefreshToken string, err error) {
 	// Hash the provided refresh token
 	tokenHash, err := s.tokenGenerator.HashToken(ctx, refreshToken)
 	if err != nil {
-		return "", fmt.Errorf("failed to hash token: %w", err)
+		return "", "", fmt.Errorf("failed to hash token: %w", err)
 	}
 
 	// Find refresh token in repository
 	tokenEntity, err := s.tokenRepo.FindByTokenHash(ctx, tokenHash)
 	if err != nil {
-		return "", fmt.Errorf("failed to find refresh token: %w", err)
+		return "", "", fmt.Errorf("failed to find refresh token: %w", err)
 	}
 	if tokenEntity == nil {
-		return "", errors.ErrInvalidToken
+		return "", "", errors.ErrInvalidToken
 	}
 
 	// Validate token
 	if !tokenEntity.IsValid() {
 		s.logAuthEvent(ctx, &tokenEntity.UserID, entities.EventTypeTokenRefresh, ipAddress, userAgent, false)
+
+		// A rotated token presented again means it leaked: end every session of the user
+		if tokenEntity.Revoked {
+			rotated, err := s.tokenRepo.IsRotated(ctx, tokenEntity.ID)
+			if err != nil {
+				return "", "", fmt.Errorf("failed to check token rotation: %w", err)
+			}
+			if rotated {
+				if err := s.tokenRepo.RevokeByUserID(ctx, tokenEntity.UserID); err != nil {
+					return "", "", fmt.Errorf("failed to revoke user tokens: %w", err)
+				}
+				return "", "", errors.ErrTokenReused
+			}
+		}
+
 		if tokenEntity.IsExpired() {
-			return "", errors.ErrTokenExpired
+			return "", "", errors.ErrTokenExpired
 		}
-		return "", errors.ErrInvalidToken
+		return "", "", errors.ErrInvalidToken
 	}
 
-	// Generate new access token
-	accessToken, err = s.tokenGenerator.GenerateAccessToken(ctx, tokenEntity.UserID.String())
+	// Generate replacement refresh token in the same family, bound to the same device
+	newRefreshToken, err = s.tokenGenerator.GenerateRefreshToken(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate access token: %w", err)
+		return "", "", fmt.Errorf("failed to generate refresh token: %w", err)
 	}
+	newTokenHash, err := s.tokenGenerator.HashToken(ctx, newRefreshToken)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to hash refresh token: %w", err)
+	}
+	replacement := tokenEntity.Successor(newTokenHash, s.refreshTokenTTL)
+	replacement.BindClient(ipAddress, userAgent)
 
-	// Update last used time
-	tokenEntity.UpdateLastUsed()
-	if err := s.tokenRepo.Update(ctx, tokenEntity); err != nil {
-		// Log error but don't fail the refresh
-		fmt.Printf("Warning: failed to update token last used time: %v\n", err)
+	// Revoke the presented token and store the replacement atomically; a concurrent
+	// refresh with the same token loses the race and is rejected
+	if err := s.tokenRepo.Rotate(ctx, tokenEntity.ID, replacement); err != nil {
+		if stderrors.Is(err, errors.ErrTokenRevoked) {
+			s.logAuthEvent(ctx, &tokenEntity.UserID, entities.EventTypeTokenRefresh, ipAddress, userAgent, false)
+			return "", "", errors.ErrInvalidToken
+		}
+		return "", "", fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	// Generate new access token carrying the user's current role
+	user, err := s.userRepo.FindByID(ctx, tokenEntity.UserID)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return "", "", errors.ErrUserNotFound
+	}
+	accessToken, err = s.tokenGenerator.GenerateAccessToken(ctx, user.ID.String(), user.Role)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate access token: %w", err)
 	}
 
 	// Log successful token refresh
 	s.logAuthEvent(ctx, &tokenEntity.UserID, entities.EventTypeTokenRefresh, ipAddress, userAgent, true)
 
-	return accessToken, nil
+	return accessToken, newRefreshToken, nil
 }
 
-// Logout invalidates the user's refresh token
-func (s *AuthServiceImpl) Logout(ctx context.Context, userID string, refreshToken string) error {
+// Logout invalidates the user's refresh token and the access token used for the request
+func (s *AuthServiceImpl) Logout(ctx context.Context, userID, accessToken, refreshToken string) error {
 	uid, err := uuid.Parse(userID)
 	if err != nil {
 		return fmt.Errorf("invalid user ID: %w", err)
 	}
 
+	if err := s.denylistAccessToken(ctx, accessToken); err != nil {
+		return err
+	}
+
 	// If refresh token provided, revoke specific token
 	if refreshToken != "" {
 		tokenHash, err := s.tokenGenerator.HashToken(ctx, refreshToken)
@@ -171,13 +218,133 @@ func (s *AuthServiceImpl) Logout(ctx context.Context, userID string, refreshToke
 	return nil
 }
 
-// VerifyAccessToken validates an access token and returns the user ID
-func (s *AuthServiceImpl) VerifyAccessToken(ctx context.Context, accessToken string) (userID string, err error) {
-	userID, err = s.tokenGenerator.ValidateAccessToken(ctx, accessToken)
+// LogoutAll revokes every refresh token the user holds and the access token used for the request.
+// Access tokens issued to other sessions stay valid until they expire.
+func (s *AuthServiceImpl) LogoutAll(ctx context.Context, userID, accessToken string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	if err := s.denylistAccessToken(ctx, accessToken); err != nil {
+		return err
+	}
+
+	if err := s.tokenRepo.RevokeByUserID(ctx, uid); err != nil {
+		return fmt.Errorf("failed to revoke user tokens: %w", err)
+	}
+
+	s.logAuthEvent(ctx, &uid, entities.EventTypeLogout, "", "", true)
+
+	return nil
+}
+
+// denylistAccessToken rejects an access token for the rest of its lifetime.
+// Tokens issued before JWT IDs were added cannot be denylisted and are skipped.
+func (s *AuthServiceImpl) denylistAccessToken(ctx context.Context, accessToken string) error {
+	if accessToken == "" {
+		return nil
+	}
+
+	claims, err := s.tokenGenerator.ValidateAccessToken(ctx, accessToken)
+	if err != nil || claims.TokenID == "" {
+		return nil
+	}
+
+	if err := s.tokenDenylist.Add(ctx, claims.TokenID, claims.ExpiresAt); err != nil {
+		return fmt.Errorf("failed to denylist access token: %w", err)
+	}
+	return nil
+}
+
+// ListSessions returns the user's active refresh tokens, newest first
+func (s *AuthServiceImpl) ListSessions(ctx context.Context, userID string) ([]*entities.RefreshToken, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	tokens, err := s.tokenRepo.FindByUserID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user tokens: %w", err)
+	}
+
+	sessions := make([]*entities.RefreshToken, 0, len(tokens))
+	for _, token := range tokens {
+		if token.IsValid() {
+			sessions = append(sessions, token)
+		}
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession revokes a single active refresh token owned by the user
+func (s *AuthServiceImpl) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+	sid, err := uuid.Parse(sessionID)
+	if err != nil {
+		return errors.ErrSessionNotFound
+	}
+
+	token, err := s.tokenRepo.FindByID(ctx, sid)
+	if err != nil {
+		return fmt.Errorf("failed to find session: %w", err)
+	}
+	// Sessions of other users are reported as missing so their IDs cannot be probed
+	if token == nil || token.UserID != uid || !token.IsValid() {
+		return errors.ErrSessionNotFound
+	}
+
+	if err := s.tokenRepo.RevokeByID(ctx, sid); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	s.logAuthEvent(ctx, &uid, entities.EventTypeLogout, "", "", true)
+
+	return nil
+}
+
+// RevokeDeviceSessions revokes all refresh tokens the user holds on a specific device
+func (s *AuthServiceImpl) RevokeDeviceSessions(ctx context.Context, userID, deviceID string) (int, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	revoked, err := s.tokenRepo.RevokeByUserIDAndDeviceID(ctx, uid, deviceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke device tokens: %w", err)
+	}
+
+	// Log logout event for the device
+	s.logAuthEvent(ctx, &uid, entities.EventTypeLogout, "", "", true)
+
+	return int(revoked), nil
+}
+
+// VerifyAccessToken validates an access token and returns the user ID and role
+func (s *AuthServiceImpl) VerifyAccessToken(ctx context.Context, accessToken string) (userID, role string, err error) {
+	claims, err := s.tokenGenerator.ValidateAccessToken(ctx, accessToken)
 	if err != nil {
-		return "", errors.ErrInvalidToken
+		return "", "", errors.ErrInvalidToken
 	}
-	return userID, nil
+
+	if claims.TokenID != "" {
+		revoked, err := s.tokenDenylist.Contains(ctx, claims.TokenID)
+		if err != nil {
+			// Fail closed: a token that might be revoked is not accepted
+			return "", "", fmt.Errorf("failed to check token denylist: %w", err)
+		}
+		if revoked {
+			return "", "", errors.ErrTokenRevoked
+		}
+	}
+
+	return claims.UserID, claims.Role, nil
 }
 
 // logAuthEvent is a helper to log authentication events
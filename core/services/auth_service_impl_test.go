@@ -0,0 +1,270 @@
+package services
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+)
+
+// fakeUserRepo serves users from memory. Methods the tests don't need panic through the
+// embedded nil interface
+type fakeUserRepo struct {
+	external.UserRepository
+	users map[uuid.UUID]*entities.User
+}
+
+func (r *fakeUserRepo) FindByID(ctx context.Context, id uuid.UUID) (*entities.User, error) {
+	return r.users[id], nil
+}
+
+// fakeRefreshTokenRepo keeps refresh tokens in memory and honors the Rotate contract: only one
+// rotation of an active token succeeds. When findBarrier is set, FindByTokenHash waits on it so
+// concurrent refreshes all read the token before any of them rotates it
+type fakeRefreshTokenRepo struct {
+	external.RefreshTokenRepository
+	mu          sync.Mutex
+	tokens      map[uuid.UUID]*entities.RefreshToken
+	findBarrier *sync.WaitGroup
+}
+
+func newFakeRefreshTokenRepo(tokens ...*entities.RefreshToken) *fakeRefreshTokenRepo {
+	repo := &fakeRefreshTokenRepo{tokens: make(map[uuid.UUID]*entities.RefreshToken)}
+	for _, token := range tokens {
+		repo.tokens[token.ID] = token
+	}
+	return repo
+}
+
+func (r *fakeRefreshTokenRepo) FindByTokenHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	r.mu.Lock()
+	var found *entities.RefreshToken
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash {
+			copied := *token
+			found = &copied
+		}
+	}
+	r.mu.Unlock()
+
+	if r.findBarrier != nil {
+		r.findBarrier.Done()
+		r.findBarrier.Wait()
+	}
+	return found, nil
+}
+
+func (r *fakeRefreshTokenRepo) Rotate(ctx context.Context, presentedID uuid.UUID, replacement *entities.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	presented, ok := r.tokens[presentedID]
+	if !ok || !presented.IsValid() {
+		return errors.ErrTokenRevoked
+	}
+	presented.Revoke()
+	r.tokens[replacement.ID] = replacement
+	return nil
+}
+
+func (r *fakeRefreshTokenRepo) IsRotated(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, token := range r.tokens {
+		if token.ParentID != nil && *token.ParentID == tokenID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeRefreshTokenRepo) RevokeByUserID(ctx context.Context, userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, token := range r.tokens {
+		if token.UserID == userID {
+			token.Revoke()
+		}
+	}
+	return nil
+}
+
+// activeTokens counts the user's tokens that can still be refreshed
+func (r *fakeRefreshTokenRepo) activeTokens(userID uuid.UUID) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	active := 0
+	for _, token := range r.tokens {
+		if token.UserID == userID && token.IsValid() {
+			active++
+		}
+	}
+	return active
+}
+
+// fakeTokenGenerator issues predictable, unique tokens and hashes them by prefixing. Access
+// tokens carry the user ID and role in plain text
+type fakeTokenGenerator struct {
+	external.TokenGenerator
+	issued atomic.Int64
+}
+
+func (g *fakeTokenGenerator) GenerateAccessToken(ctx context.Context, userID, role string) (string, error) {
+	return "access-" + userID + ":" + role, nil
+}
+
+func (g *fakeTokenGenerator) ValidateAccessToken(ctx context.Context, token string) (*external.AccessTokenClaims, error) {
+	userID, role, ok := strings.Cut(strings.TrimPrefix(token, "access-"), ":")
+	if !ok {
+		return nil, errors.ErrInvalidToken
+	}
+	return &external.AccessTokenClaims{UserID: userID, Role: role}, nil
+}
+
+func (g *fakeTokenGenerator) GenerateRefreshToken(ctx context.Context) (string, error) {
+	return fmt.Sprintf("refresh-%d", g.issued.Add(1)), nil
+}
+
+func (g *fakeTokenGenerator) HashToken(ctx context.Context, token string) (string, error) {
+	return "hash-" + token, nil
+}
+
+// fakeAuthEventLogRepo discards auth events
+type fakeAuthEventLogRepo struct {
+	external.AuthEventLogRepository
+}
+
+func (r *fakeAuthEventLogRepo) Create(ctx context.Context, log *entities.AuthEventLog) error {
+	return nil
+}
+
+// newRefreshTestService returns an auth service holding one user with one active refresh
+// token, whose plain value is "login-token"
+func newRefreshTestService() (*AuthServiceImpl, *fakeRefreshTokenRepo, *entities.User) {
+	user := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	token := entities.NewRefreshToken(user.ID, "hash-login-token", 7)
+	tokenRepo := newFakeRefreshTokenRepo(token)
+
+	service := NewAuthService(
+		&fakeUserRepo{users: map[uuid.UUID]*entities.User{user.ID: user}},
+		tokenRepo,
+		nil,
+		&fakeTokenGenerator{},
+		nil,
+		&fakeAuthEventLogRepo{},
+		7,
+	).(*AuthServiceImpl)
+	return service, tokenRepo, user
+}
+
+func TestRefreshTokenConcurrentRefreshesOnlyOneSucceeds(t *testing.T) {
+	const attempts = 8
+	service, tokenRepo, user := newRefreshTestService()
+
+	barrier := &sync.WaitGroup{}
+	barrier.Add(attempts)
+	tokenRepo.findBarrier = barrier
+
+	var wg sync.WaitGroup
+	results := make([]error, attempts)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, _, results[i] = service.RefreshToken(context.Background(), "login-token", "203.0.113.10", "test")
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range results {
+		switch {
+		case err == nil:
+			succeeded++
+		case !stderrors.Is(err, errors.ErrInvalidToken):
+			t.Errorf("losing refresh error = %v, want ErrInvalidToken", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("%d of %d concurrent refreshes succeeded, want exactly 1", succeeded, attempts)
+	}
+	if active := tokenRepo.activeTokens(user.ID); active != 1 {
+		t.Errorf("user has %d active refresh tokens, want only the one replacement", active)
+	}
+}
+
+func TestRefreshTokenReuseRevokesEverySession(t *testing.T) {
+	service, tokenRepo, user := newRefreshTestService()
+	ctx := context.Background()
+
+	// A second session of the same user, e.g. another device, which reuse must end as well
+	otherSession := entities.NewRefreshToken(user.ID, "hash-other-device", 7)
+	tokenRepo.tokens[otherSession.ID] = otherSession
+
+	_, rotated, err := service.RefreshToken(ctx, "login-token", "203.0.113.10", "test")
+	if err != nil {
+		t.Fatalf("first refresh error = %v", err)
+	}
+	if active := tokenRepo.activeTokens(user.ID); active != 2 {
+		t.Fatalf("user has %d active refresh tokens after rotation, want 2", active)
+	}
+
+	// Presenting the rotated token again means it leaked
+	_, _, err = service.RefreshToken(ctx, "login-token", "198.51.100.7", "attacker")
+	if !stderrors.Is(err, errors.ErrTokenReused) {
+		t.Fatalf("reused token error = %v, want ErrTokenReused", err)
+	}
+	if active := tokenRepo.activeTokens(user.ID); active != 0 {
+		t.Errorf("user has %d active refresh tokens after reuse, want every session revoked", active)
+	}
+
+	// The legitimate successor is revoked too, so the session has to log in again
+	if _, _, err := service.RefreshToken(ctx, rotated, "203.0.113.10", "test"); err == nil {
+		t.Error("refresh with the successor token succeeded after reuse was detected")
+	}
+}
+
+func TestRoleChangeAfterLoginReachesTokenOnlyOnRefresh(t *testing.T) {
+	authService, _, user := newRefreshTestService()
+	userService := NewUserService(authService.userRepo, nil, nil, nil)
+	ctx := context.Background()
+
+	accessToken, refreshToken, err := authService.RefreshToken(ctx, "login-token", "203.0.113.10", "test")
+	if err != nil {
+		t.Fatalf("RefreshToken() error = %v", err)
+	}
+
+	// An admin promotes the user after the token was issued
+	user.Role = entities.RoleVerificator
+
+	profile, err := userService.GetUserByID(ctx, user.ID.String())
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if profile.Role != entities.RoleVerificator {
+		t.Errorf("profile role = %q, want the new role from the database", profile.Role)
+	}
+
+	if _, role, err := authService.VerifyAccessToken(ctx, accessToken); err != nil || role != entities.RoleUser {
+		t.Errorf("issued token role = %q (error %v), want the snapshot %q until refresh", role, err, entities.RoleUser)
+	}
+
+	refreshedToken, _, err := authService.RefreshToken(ctx, refreshToken, "203.0.113.10", "test")
+	if err != nil {
+		t.Fatalf("second RefreshToken() error = %v", err)
+	}
+	if _, role, err := authService.VerifyAccessToken(ctx, refreshedToken); err != nil || role != entities.RoleVerificator {
+		t.Errorf("refreshed token role = %q (error %v), want %q", role, err, entities.RoleVerificator)
+	}
+}
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// CategoryServiceImpl implements the CategoryService use case
+type CategoryServiceImpl struct {
+	categoryRepo external.CategoryRepository
+}
+
+// NewCategoryService creates a new CategoryService implementation
+func NewCategoryService(categoryRepo external.CategoryRepository) usecases.CategoryService {
+	return &CategoryServiceImpl{categoryRepo: categoryRepo}
+}
+
+// ListCategories retrieves every category in display order
+func (s *CategoryServiceImpl) ListCategories(ctx context.Context) ([]*entities.Category, error) {
+	categories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list categories", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	return categories, nil
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// DataExportServiceImpl implements the DataExportService use case
+type DataExportServiceImpl struct {
+	userRepo     external.UserRepository
+	reportRepo   external.DamagedRoadRepository
+	eventLogRepo external.AuthEventLogRepository
+}
+
+// NewDataExportService creates a new DataExportService instance
+func NewDataExportService(
+	userRepo external.UserRepository,
+	reportRepo external.DamagedRoadRepository,
+	eventLogRepo external.AuthEventLogRepository,
+) usecases.DataExportService {
+	return &DataExportServiceImpl{
+		userRepo:     userRepo,
+		reportRepo:   reportRepo,
+		eventLogRepo: eventLogRepo,
+	}
+}
+
+// ExportUserData streams the user's profile, authored reports and auth events into sink
+func (s *DataExportServiceImpl) ExportUserData(ctx context.Context, userID uuid.UUID, sink usecases.UserDataSink) error {
+	logger.InfoContext(ctx, "Exporting user data", map[string]interface{}{
+		"user_id": userID.String(),
+	})
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return errors.ErrUserNotFound
+	}
+
+	if err := sink.WriteProfile(user); err != nil {
+		return fmt.Errorf("failed to write profile: %w", err)
+	}
+
+	// Scope reports to the author so no other user's data can leak into the export
+	filters := &entities.DamagedRoadFilters{AuthorID: &userID}
+	if err := s.reportRepo.StreamList(ctx, filters, sink.WriteReport); err != nil {
+		logger.ErrorContext(ctx, "Failed to export reports", map[string]interface{}{
+			"user_id": userID.String(),
+			"error":   err.Error(),
+		})
+		return fmt.Errorf("failed to export reports: %w", err)
+	}
+
+	if err := s.eventLogRepo.StreamByUserID(ctx, userID, sink.WriteAuthEvent); err != nil {
+		logger.ErrorContext(ctx, "Failed to export auth events", map[string]interface{}{
+			"user_id": userID.String(),
+			"error":   err.Error(),
+		})
+		return fmt.Errorf("failed to export auth events: %w", err)
+	}
+
+	return nil
+}
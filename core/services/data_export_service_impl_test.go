@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+)
+
+// fakeExportEventLogRepo streams auth events from memory
+type fakeExportEventLogRepo struct {
+	external.AuthEventLogRepository
+	events []*entities.AuthEventLog
+}
+
+func (r *fakeExportEventLogRepo) StreamByUserID(ctx context.Context, userID uuid.UUID, fn func(*entities.AuthEventLog) error) error {
+	for _, event := range r.events {
+		if event.UserID == nil || *event.UserID != userID {
+			continue
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindByUserID returns the user's newest events first, like the Postgres repository
+func (r *fakeExportEventLogRepo) FindByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]*entities.AuthEventLog, error) {
+	var events []*entities.AuthEventLog
+	for _, event := range r.events {
+		if event.UserID != nil && *event.UserID == userID {
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].CreatedAt.After(events[j].CreatedAt) })
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+// recordingSink collects everything written to it
+type recordingSink struct {
+	profile *entities.User
+	reports []*entities.DamagedRoad
+	events  []*entities.AuthEventLog
+}
+
+func (s *recordingSink) WriteProfile(user *entities.User) error {
+	s.profile = user
+	return nil
+}
+
+func (s *recordingSink) WriteReport(road *entities.DamagedRoad) error {
+	s.reports = append(s.reports, road)
+	return nil
+}
+
+func (s *recordingSink) WriteAuthEvent(event *entities.AuthEventLog) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestExportUserDataOnlyContainsTheCallersData(t *testing.T) {
+	caller := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	other := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+
+	callerReports := []*entities.DamagedRoad{
+		{ID: uuid.New(), AuthorID: caller.ID, Title: "Jalan berlubang"},
+		{ID: uuid.New(), AuthorID: caller.ID, Title: "Aspal retak"},
+	}
+	otherReport := &entities.DamagedRoad{ID: uuid.New(), AuthorID: other.ID, Title: "Laporan orang lain"}
+
+	service := NewDataExportService(
+		&fakeUserRepo{users: map[uuid.UUID]*entities.User{caller.ID: caller, other.ID: other}},
+		&fakeReportRepo{reports: []*entities.DamagedRoad{callerReports[0], otherReport, callerReports[1]}},
+		&fakeExportEventLogRepo{events: []*entities.AuthEventLog{
+			entities.NewAuthEventLog(&caller.ID, entities.EventTypeLogin, "203.0.113.10", "test", true),
+			entities.NewAuthEventLog(&other.ID, entities.EventTypeLogin, "198.51.100.7", "test", true),
+		}},
+	)
+
+	sink := &recordingSink{}
+	if err := service.ExportUserData(context.Background(), caller.ID, sink); err != nil {
+		t.Fatalf("ExportUserData() error = %v", err)
+	}
+
+	if sink.profile == nil || sink.profile.ID != caller.ID {
+		t.Errorf("exported profile = %+v, want the caller's", sink.profile)
+	}
+
+	if len(sink.reports) != len(callerReports) {
+		t.Fatalf("exported %d reports, want the caller's %d", len(sink.reports), len(callerReports))
+	}
+	for i, road := range sink.reports {
+		if road.ID != callerReports[i].ID {
+			t.Errorf("report %d = %s, want %s", i, road.ID, callerReports[i].ID)
+		}
+		if road.AuthorID != caller.ID {
+			t.Errorf("exported report %s of another user", road.ID)
+		}
+	}
+
+	if len(sink.events) != 1 || *sink.events[0].UserID != caller.ID {
+		t.Errorf("exported auth events = %+v, want only the caller's login", sink.events)
+	}
+}
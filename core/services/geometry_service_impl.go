@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"math"
 
@@ -8,51 +9,135 @@ import (
 	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
 	"github.com/nicklaros/jalanrusak-be/core/ports/external"
 	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
 )
 
+// BoundaryMode selects how strictly path coordinates must fall within national bounds.
+type BoundaryMode string
+
+const (
+	// BoundaryModeAll requires every coordinate to be within national bounds.
+	BoundaryModeAll BoundaryMode = "all"
+	// BoundaryModeAny requires at least one coordinate within national bounds and the rest
+	// within the configured buffer, for paths such as coastal roads that clip offshore.
+	BoundaryModeAny BoundaryMode = "any"
+)
+
+// DistanceMethod selects the formula CalculateDistance uses.
+type DistanceMethod string
+
+const (
+	// DistanceHaversine treats the Earth as a sphere; fast and close enough for short urban stretches.
+	DistanceHaversine DistanceMethod = "haversine"
+	// DistanceVincenty measures on the WGS84 ellipsoid, for sub-meter accuracy over kilometers.
+	DistanceVincenty DistanceMethod = "vincenty"
+)
+
+// GeometryServiceConfig holds tunable behavior for the geometry service.
+type GeometryServiceConfig struct {
+	BoundaryMode BoundaryMode
+	// BufferMeters is how far outside national bounds non-anchor points may fall in BoundaryModeAny.
+	// Capped at entities.MaxBoundaryBufferMeters.
+	BufferMeters float64
+	// DistanceMethod defaults to DistanceHaversine.
+	DistanceMethod DistanceMethod
+}
+
 // geometryServiceImpl implements GeometryService for geospatial validation operations.
 type geometryServiceImpl struct {
 	boundaryRepo external.BoundaryRepository
+	config       GeometryServiceConfig
 }
 
 // NewGeometryService creates a new GeometryService instance with the provided boundary repository.
-func NewGeometryService(boundaryRepo external.BoundaryRepository) usecases.GeometryService {
+func NewGeometryService(boundaryRepo external.BoundaryRepository, config GeometryServiceConfig) usecases.GeometryService {
+	if config.BoundaryMode == "" {
+		config.BoundaryMode = BoundaryModeAll
+	}
+	if config.DistanceMethod == "" {
+		config.DistanceMethod = DistanceHaversine
+	}
+	config.BufferMeters = math.Max(0, math.Min(config.BufferMeters, entities.MaxBoundaryBufferMeters))
+
 	return &geometryServiceImpl{
 		boundaryRepo: boundaryRepo,
+		config:       config,
 	}
 }
 
-// ValidateCoordinatesInBoundary checks if all coordinates fall within Indonesian national boundaries.
+// ValidateCoordinatesInBoundary checks coordinates against Indonesian national boundaries.
 // Indonesian bounds: latitude -11 to 6, longitude 95 to 141.
+// In BoundaryModeAll every coordinate must be inside; in BoundaryModeAny at least one must be
+// inside and the rest within the configured buffer.
+// Every offending coordinate is reported in the returned *errors.CoordinateBoundsError.
 func (s *geometryServiceImpl) ValidateCoordinatesInBoundary(points []entities.Point) error {
-	const (
-		minLat = -11.0
-		maxLat = 6.0
-		minLng = 95.0
-		maxLng = 141.0
-	)
+	strict := boundsViolations(points, 0)
+	if len(strict) == 0 {
+		return nil
+	}
 
-	for i, point := range points {
-		if point.Lat < minLat || point.Lat > maxLat {
-			return fmt.Errorf("%w: coordinate %d latitude %.6f is outside Indonesian bounds [%.1f, %.1f]",
-				errors.ErrCoordinatesOutOfBounds, i, point.Lat, minLat, maxLat)
+	if s.config.BoundaryMode == BoundaryModeAny {
+		outside := make(map[int]bool, len(strict))
+		for _, violation := range strict {
+			outside[violation.Index] = true
 		}
-		if point.Lng < minLng || point.Lng > maxLng {
-			return fmt.Errorf("%w: coordinate %d longitude %.6f is outside Indonesian bounds [%.1f, %.1f]",
-				errors.ErrCoordinatesOutOfBounds, i, point.Lng, minLng, maxLng)
+		// At least one anchor point inside: the rest only need to be within the buffer
+		if len(outside) < len(points) {
+			buffered := boundsViolations(points, s.config.BufferMeters/entities.MetersPerDegree)
+			if len(buffered) == 0 {
+				return nil
+			}
+			return &errors.CoordinateBoundsError{Violations: buffered}
 		}
 	}
 
-	return nil
+	return &errors.CoordinateBoundsError{Violations: strict}
+}
+
+// boundsViolations lists coordinates outside national bounds widened by bufferDegrees.
+func boundsViolations(points []entities.Point, bufferDegrees float64) []errors.CoordinateViolation {
+	minLat := entities.NationalMinLat - bufferDegrees
+	maxLat := entities.NationalMaxLat + bufferDegrees
+	minLng := entities.NationalMinLng - bufferDegrees
+	maxLng := entities.NationalMaxLng + bufferDegrees
+
+	var violations []errors.CoordinateViolation
+	for i, point := range points {
+		violation := errors.CoordinateViolation{Index: i, Lat: point.Lat, Lng: point.Lng}
+		switch {
+		case point.Lat < minLat:
+			violation.Axis, violation.Bound, violation.Limit = "lat", "min", minLat
+			violations = append(violations, violation)
+		case point.Lat > maxLat:
+			violation.Axis, violation.Bound, violation.Limit = "lat", "max", maxLat
+			violations = append(violations, violation)
+		}
+		switch {
+		case point.Lng < minLng:
+			violation.Axis, violation.Bound, violation.Limit = "lng", "min", minLng
+			violations = append(violations, violation)
+		case point.Lng > maxLng:
+			violation.Axis, violation.Bound, violation.Limit = "lng", "max", maxLng
+			violations = append(violations, violation)
+		}
+	}
+	return violations
 }
 
 // ValidateCoordinatesNearCentroid checks if at least one coordinate from the path
 // falls within the specified radius (in meters) of the subdistrict's centroid.
 // Implements FR-006 requirement: "at least one coordinate must fall within 200 meters of centroid".
-func (s *geometryServiceImpl) ValidateCoordinatesNearCentroid(points []entities.Point, subDistrictCode entities.SubDistrictCode, radiusMeters float64) error {
+func (s *geometryServiceImpl) ValidateCoordinatesNearCentroid(ctx context.Context, points []entities.Point, subDistrictCode entities.SubDistrictCode, radiusMeters float64) error {
 	// Retrieve centroid from repository
-	centroid, err := s.boundaryRepo.GetCentroid(subDistrictCode)
+	centroid, err := s.boundaryRepo.GetCentroid(ctx, subDistrictCode)
 	if err != nil {
+		// Degrade to national bounds only until the boundary dataset is seeded
+		if !s.IsBoundaryDataAvailable(ctx) {
+			logger.WarnContext(ctx, "Boundary dataset is empty, skipping centroid proximity check", map[string]interface{}{
+				"subdistrict_code": string(subDistrictCode),
+			})
+			return nil
+		}
 		return fmt.Errorf("%w: %v", errors.ErrSubDistrictNotFound, err)
 	}
 
@@ -69,30 +154,107 @@ func (s *geometryServiceImpl) ValidateCoordinatesNearCentroid(points []entities.
 		errors.ErrLocationNotInBoundary, radiusMeters, string(subDistrictCode), centroid.Lat, centroid.Lng)
 }
 
-// CalculateDistance computes the Haversine distance in meters between two geographic points.
-// Haversine formula accounts for Earth's curvature and provides accurate results for small distances.
+// CalculateDistance computes the distance in meters between two geographic points with the
+// configured method.
 func (s *geometryServiceImpl) CalculateDistance(point1, point2 entities.Point) float64 {
-	const earthRadiusMeters = 6371000.0 // Earth's mean radius in meters
+	if s.config.DistanceMethod == DistanceVincenty {
+		return point1.VincentyDistanceMeters(point2)
+	}
+	return point1.DistanceMeters(point2)
+}
+
+// CalculateBearing computes the initial great-circle bearing in degrees from one point to another.
+func (s *geometryServiceImpl) CalculateBearing(from, to entities.Point) float64 {
+	lat1Rad := degreesToRadians(from.Lat)
+	lat2Rad := degreesToRadians(to.Lat)
+	deltaLngRad := degreesToRadians(to.Lng - from.Lng)
 
-	// Convert degrees to radians
-	lat1Rad := degreesToRadians(point1.Lat)
-	lat2Rad := degreesToRadians(point2.Lat)
-	deltaLatRad := degreesToRadians(point2.Lat - point1.Lat)
-	deltaLngRad := degreesToRadians(point2.Lng - point1.Lng)
+	y := math.Sin(deltaLngRad) * math.Cos(lat2Rad)
+	x := math.Cos(lat1Rad)*math.Sin(lat2Rad) - math.Sin(lat1Rad)*math.Cos(lat2Rad)*math.Cos(deltaLngRad)
 
-	// Haversine formula
-	a := math.Sin(deltaLatRad/2)*math.Sin(deltaLatRad/2) +
-		math.Cos(lat1Rad)*math.Cos(lat2Rad)*
-			math.Sin(deltaLngRad/2)*math.Sin(deltaLngRad/2)
+	bearing := math.Atan2(y, x) * 180.0 / math.Pi
+	return math.Mod(bearing+360, 360)
+}
+
+// FindDirectionReversals returns the vertices where consecutive segments turn by more than maxTurnDegrees.
+// The turn at a vertex is the absolute bearing change between the incoming and outgoing segment (0-180).
+func (s *geometryServiceImpl) FindDirectionReversals(points []entities.Point, maxTurnDegrees float64) []int {
+	var reversals []int
+	previousBearing := -1.0
+	for i := 1; i < len(points); i++ {
+		if points[i] == points[i-1] {
+			continue
+		}
+
+		bearing := s.CalculateBearing(points[i-1], points[i])
+		if previousBearing >= 0 {
+			turn := math.Abs(bearing - previousBearing)
+			if turn > 180 {
+				turn = 360 - turn
+			}
+			if turn > maxTurnDegrees {
+				reversals = append(reversals, i-1)
+			}
+		}
+		previousBearing = bearing
+	}
+	return reversals
+}
+
+// ValidateSubDistrictExists checks that the subdistrict code is present in the boundary dataset.
+func (s *geometryServiceImpl) ValidateSubDistrictExists(ctx context.Context, subDistrictCode entities.SubDistrictCode) error {
+	exists, err := s.boundaryRepo.CheckSubDistrictExists(ctx, subDistrictCode)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	// Every code is unknown until the boundary dataset is seeded
+	if !s.IsBoundaryDataAvailable(ctx) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", errors.ErrSubDistrictNotFound, string(subDistrictCode))
+}
+
+// CheckSubDistrictsExist reports for each code whether it is present in the boundary dataset.
+func (s *geometryServiceImpl) CheckSubDistrictsExist(ctx context.Context, subDistrictCodes []string) (map[string]bool, error) {
+	results := make(map[string]bool, len(subDistrictCodes))
+	var lookup []entities.SubDistrictCode
+	for _, raw := range subDistrictCodes {
+		results[raw] = false
+		if code, err := entities.NewSubDistrictCode(raw); err == nil {
+			lookup = append(lookup, code)
+		}
+	}
+	if len(lookup) == 0 {
+		return results, nil
+	}
 
-	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+	existing, err := s.boundaryRepo.FindExistingSubDistricts(ctx, lookup)
+	if err != nil {
+		return nil, err
+	}
+	for _, code := range existing {
+		results[string(code)] = true
+	}
+	return results, nil
+}
 
-	return earthRadiusMeters * c
+// IsBoundaryDataAvailable reports whether the subdistrict boundary dataset has been seeded.
+// Lookup failures are treated as available so that real errors are not masked as degradation.
+func (s *geometryServiceImpl) IsBoundaryDataAvailable(ctx context.Context) bool {
+	available, err := s.boundaryRepo.HasBoundaryData(ctx)
+	if err != nil {
+		return true
+	}
+	return available
 }
 
 // GetSubDistrictCentroid retrieves the geographic centroid for a given subdistrict code.
-func (s *geometryServiceImpl) GetSubDistrictCentroid(subDistrictCode entities.SubDistrictCode) (entities.Point, error) {
-	centroid, err := s.boundaryRepo.GetCentroid(subDistrictCode)
+func (s *geometryServiceImpl) GetSubDistrictCentroid(ctx context.Context, subDistrictCode entities.SubDistrictCode) (entities.Point, error) {
+	centroid, err := s.boundaryRepo.GetCentroid(ctx, subDistrictCode)
 	if err != nil {
 		return entities.Point{}, fmt.Errorf("%w: %v", errors.ErrSubDistrictNotFound, err)
 	}
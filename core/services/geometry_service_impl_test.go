@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+)
+
+// fakeBoundaryRepo serves centroids from memory and counts bulk lookups
+type fakeBoundaryRepo struct {
+	external.BoundaryRepository
+	centroids   map[entities.SubDistrictCode]entities.Point
+	bulkLookups int
+	seenCtx     []context.Context
+}
+
+func (r *fakeBoundaryRepo) GetCentroid(ctx context.Context, subDistrictCode entities.SubDistrictCode) (entities.Point, error) {
+	r.seenCtx = append(r.seenCtx, ctx)
+	centroid, ok := r.centroids[subDistrictCode]
+	if !ok {
+		return entities.Point{}, fmt.Errorf("%w: no centroid for %s", errors.ErrSubDistrictNotFound, subDistrictCode)
+	}
+	return centroid, nil
+}
+
+func (r *fakeBoundaryRepo) CheckSubDistrictExists(ctx context.Context, subDistrictCode entities.SubDistrictCode) (bool, error) {
+	r.seenCtx = append(r.seenCtx, ctx)
+	_, ok := r.centroids[subDistrictCode]
+	return ok, nil
+}
+
+func (r *fakeBoundaryRepo) FindExistingSubDistricts(ctx context.Context, subDistrictCodes []entities.SubDistrictCode) ([]entities.SubDistrictCode, error) {
+	r.seenCtx = append(r.seenCtx, ctx)
+	r.bulkLookups++
+	var existing []entities.SubDistrictCode
+	for _, code := range subDistrictCodes {
+		if _, ok := r.centroids[code]; ok {
+			existing = append(existing, code)
+		}
+	}
+	return existing, nil
+}
+
+func (r *fakeBoundaryRepo) HasBoundaryData(ctx context.Context) (bool, error) {
+	r.seenCtx = append(r.seenCtx, ctx)
+	return len(r.centroids) > 0, nil
+}
+
+// surabayaCentroids holds the centroid of one Surabaya subdistrict
+var surabayaCentroids = map[entities.SubDistrictCode]entities.Point{
+	"35.78.01.1001": {Lat: -7.2575, Lng: 112.7521},
+}
+
+func TestCheckSubDistrictsExistMixedCodes(t *testing.T) {
+	repo := &fakeBoundaryRepo{centroids: surabayaCentroids}
+	service := NewGeometryService(repo, GeometryServiceConfig{})
+
+	results, err := service.CheckSubDistrictsExist(context.Background(), []string{"35.78.01.1001", "35.78.99.9999", "not-a-code"})
+	if err != nil {
+		t.Fatalf("CheckSubDistrictsExist() error = %v", err)
+	}
+
+	want := map[string]bool{"35.78.01.1001": true, "35.78.99.9999": false, "not-a-code": false}
+	if len(results) != len(want) {
+		t.Errorf("got %d results, want one per requested code", len(results))
+	}
+	for code, exists := range want {
+		if got, ok := results[code]; !ok || got != exists {
+			t.Errorf("results[%q] = %v (present %v), want %v", code, got, ok, exists)
+		}
+	}
+	if repo.bulkLookups != 1 {
+		t.Errorf("%d repository lookups, want the codes checked in one", repo.bulkLookups)
+	}
+}
+
+func TestCheckSubDistrictsExistSkipsLookupForMalformedCodes(t *testing.T) {
+	repo := &fakeBoundaryRepo{centroids: surabayaCentroids}
+	service := NewGeometryService(repo, GeometryServiceConfig{})
+
+	results, err := service.CheckSubDistrictsExist(context.Background(), []string{"35.78", ""})
+	if err != nil {
+		t.Fatalf("CheckSubDistrictsExist() error = %v", err)
+	}
+	if results["35.78"] || results[""] || repo.bulkLookups != 0 {
+		t.Errorf("results = %v after %d lookups, want both missing without a query", results, repo.bulkLookups)
+	}
+}
+
+type requestKey struct{}
+
+func TestGeometryServicePassesRequestContextToRepository(t *testing.T) {
+	repo := &fakeBoundaryRepo{centroids: surabayaCentroids}
+	service := NewGeometryService(repo, GeometryServiceConfig{})
+	ctx := context.WithValue(context.Background(), requestKey{}, "req-1")
+
+	calls := map[string]func() error{
+		"ValidateSubDistrictExists": func() error {
+			return service.ValidateSubDistrictExists(ctx, "35.78.99.9999")
+		},
+		"CheckSubDistrictsExist": func() error {
+			_, err := service.CheckSubDistrictsExist(ctx, []string{"35.78.01.1001"})
+			return err
+		},
+		"GetSubDistrictCentroid": func() error {
+			_, err := service.GetSubDistrictCentroid(ctx, "35.78.01.1001")
+			return err
+		},
+		"ValidateCoordinatesNearCentroid": func() error {
+			return service.ValidateCoordinatesNearCentroid(ctx, []entities.Point{{Lat: -7.2576, Lng: 112.7522}}, "35.78.01.1001", 1000)
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			repo.seenCtx = nil
+			_ = call()
+			if len(repo.seenCtx) == 0 {
+				t.Fatal("repository was not queried")
+			}
+			for _, seen := range repo.seenCtx {
+				if seen.Value(requestKey{}) != "req-1" {
+					t.Errorf("repository got a context without the request value")
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateDistanceMethods(t *testing.T) {
+	jakarta := entities.Point{Lat: -6.1754, Lng: 106.8272}
+	surabaya := entities.Point{Lat: -7.2575, Lng: 112.7521}
+	// Geodesic length on the WGS84 ellipsoid, from numerically integrating the geodesic equations
+	const geodesic = 665887.604
+
+	tests := []struct {
+		method    DistanceMethod
+		tolerance float64
+	}{
+		{DistanceVincenty, 0.01},
+		// The spherical model comes up about 0.1% short over this line
+		{DistanceHaversine, 700},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.method), func(t *testing.T) {
+			service := NewGeometryService(&fakeBoundaryRepo{}, GeometryServiceConfig{DistanceMethod: tt.method})
+			got := service.CalculateDistance(jakarta, surabaya)
+			if math.Abs(got-geodesic) > tt.tolerance {
+				t.Errorf("CalculateDistance() = %.3f m, want %.3f ± %.2f m", got, geodesic, tt.tolerance)
+			}
+		})
+	}
+
+	haversine := NewGeometryService(&fakeBoundaryRepo{}, GeometryServiceConfig{}).CalculateDistance(jakarta, surabaya)
+	if math.Abs(haversine-geodesic) < 100 {
+		t.Errorf("default method = %.3f m, want Haversine's spherical error against %.3f m", haversine, geodesic)
+	}
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// MaintenanceServiceConfig holds tunable behavior for maintenance jobs
+type MaintenanceServiceConfig struct {
+	// TokenCleanupBatchSize is how many expired tokens are deleted per statement, so a large
+	// backlog doesn't lock the token tables for long. Zero deletes them in one statement
+	TokenCleanupBatchSize int
+}
+
+// MaintenanceServiceImpl implements the MaintenanceService use case
+type MaintenanceServiceImpl struct {
+	refreshTokenRepo       external.RefreshTokenRepository
+	passwordResetTokenRepo external.PasswordResetTokenRepository
+	config                 MaintenanceServiceConfig
+}
+
+// NewMaintenanceService creates a new MaintenanceService instance
+func NewMaintenanceService(
+	refreshTokenRepo external.RefreshTokenRepository,
+	passwordResetTokenRepo external.PasswordResetTokenRepository,
+	config MaintenanceServiceConfig,
+) usecases.MaintenanceService {
+	return &MaintenanceServiceImpl{
+		refreshTokenRepo:       refreshTokenRepo,
+		passwordResetTokenRepo: passwordResetTokenRepo,
+		config:                 config,
+	}
+}
+
+// CleanupExpiredTokens deletes expired refresh and password reset tokens
+func (s *MaintenanceServiceImpl) CleanupExpiredTokens(ctx context.Context) (*usecases.TokenCleanupResult, error) {
+	refreshRemoved, err := s.refreshTokenRepo.DeleteExpired(ctx, s.config.TokenCleanupBatchSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to delete expired refresh tokens", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to delete expired refresh tokens: %w", err)
+	}
+
+	resetRemoved, err := s.passwordResetTokenRepo.DeleteExpired(ctx, s.config.TokenCleanupBatchSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to delete expired password reset tokens", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to delete expired password reset tokens: %w", err)
+	}
+
+	logger.InfoContext(ctx, "Expired token cleanup completed", map[string]interface{}{
+		"refresh_tokens_removed":        refreshRemoved,
+		"password_reset_tokens_removed": resetRemoved,
+	})
+
+	return &usecases.TokenCleanupResult{
+		RefreshTokensRemoved:       refreshRemoved,
+		PasswordResetTokensRemoved: resetRemoved,
+	}, nil
+}
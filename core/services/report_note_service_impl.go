@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// ReportNoteServiceImpl implements the ReportNoteService use case
+type ReportNoteServiceImpl struct {
+	noteRepo   external.ReportNoteRepository
+	reportRepo external.DamagedRoadRepository
+}
+
+// NewReportNoteService creates a new ReportNoteService implementation
+func NewReportNoteService(
+	noteRepo external.ReportNoteRepository,
+	reportRepo external.DamagedRoadRepository,
+) usecases.ReportNoteService {
+	return &ReportNoteServiceImpl{
+		noteRepo:   noteRepo,
+		reportRepo: reportRepo,
+	}
+}
+
+// AddNote adds a note to a report
+func (s *ReportNoteServiceImpl) AddNote(
+	ctx context.Context,
+	reportID uuid.UUID,
+	authorID uuid.UUID,
+	body string,
+	internal bool,
+) (*entities.ReportNote, error) {
+	if err := s.ensureReportExists(ctx, reportID); err != nil {
+		return nil, err
+	}
+
+	note, err := entities.NewReportNote(reportID, authorID, body, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.noteRepo.Create(ctx, note); err != nil {
+		logger.ErrorContext(ctx, "Failed to save report note", map[string]interface{}{
+			"report_id": reportID.String(),
+			"author_id": authorID.String(),
+			"error":     err.Error(),
+		})
+		return nil, fmt.Errorf("failed to save note: %w", err)
+	}
+
+	logger.InfoContext(ctx, "Report note added", map[string]interface{}{
+		"report_id": reportID.String(),
+		"note_id":   note.ID.String(),
+		"author_id": authorID.String(),
+		"internal":  internal,
+	})
+
+	return note, nil
+}
+
+// ListNotes retrieves the notes of a report visible to viewerRole
+func (s *ReportNoteServiceImpl) ListNotes(ctx context.Context, reportID uuid.UUID, viewerRole string) ([]*entities.ReportNote, error) {
+	if err := s.ensureReportExists(ctx, reportID); err != nil {
+		return nil, err
+	}
+
+	notes, err := s.noteRepo.FindByReport(ctx, reportID, entities.IsStaffRole(viewerRole))
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list report notes", map[string]interface{}{
+			"report_id": reportID.String(),
+			"error":     err.Error(),
+		})
+		return nil, fmt.Errorf("failed to list notes: %w", err)
+	}
+
+	return notes, nil
+}
+
+// ensureReportExists returns errors.ErrReportNotFound when the report does not exist
+func (s *ReportNoteServiceImpl) ensureReportExists(ctx context.Context, reportID uuid.UUID) error {
+	road, err := s.reportRepo.FindByID(ctx, reportID)
+	if err != nil {
+		return fmt.Errorf("failed to get report: %w", err)
+	}
+	if road == nil {
+		return errors.ErrReportNotFound
+	}
+	return nil
+}
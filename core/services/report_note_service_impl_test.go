@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+)
+
+// fakeNoteRepo keeps notes in memory and honors the includeInternal flag like the Postgres
+// repository does
+type fakeNoteRepo struct {
+	external.ReportNoteRepository
+	notes []*entities.ReportNote
+}
+
+func (r *fakeNoteRepo) FindByReport(ctx context.Context, reportID uuid.UUID, includeInternal bool) ([]*entities.ReportNote, error) {
+	var notes []*entities.ReportNote
+	for _, note := range r.notes {
+		if note.ReportID != reportID || (note.Internal && !includeInternal) {
+			continue
+		}
+		notes = append(notes, note)
+	}
+	return notes, nil
+}
+
+func TestListNotesHidesInternalNotesFromNonStaff(t *testing.T) {
+	report := &entities.DamagedRoad{ID: uuid.New()}
+	staffID := uuid.New()
+	public, _ := entities.NewReportNote(report.ID, staffID, "Tim sudah dijadwalkan", false)
+	internal, _ := entities.NewReportNote(report.ID, staffID, "Kontraktor lama, cek ulang anggaran", true)
+
+	service := NewReportNoteService(
+		&fakeNoteRepo{notes: []*entities.ReportNote{public, internal}},
+		&fakeReportRepo{reports: []*entities.DamagedRoad{report}},
+	)
+
+	for _, role := range []string{entities.RoleUser, ""} {
+		notes, err := service.ListNotes(context.Background(), report.ID, role)
+		if err != nil {
+			t.Fatalf("ListNotes(%q) error = %v", role, err)
+		}
+		if len(notes) != 1 || notes[0].ID != public.ID {
+			t.Errorf("ListNotes(%q) returned %d notes, want only the public note", role, len(notes))
+		}
+		for _, note := range notes {
+			if note.Internal {
+				t.Errorf("ListNotes(%q) returned internal note %s", role, note.ID)
+			}
+		}
+	}
+
+	for _, role := range []string{entities.RoleVerificator, entities.RoleAdmin} {
+		notes, err := service.ListNotes(context.Background(), report.ID, role)
+		if err != nil {
+			t.Fatalf("ListNotes(%q) error = %v", role, err)
+		}
+		if len(notes) != 2 {
+			t.Errorf("ListNotes(%q) returned %d notes, want the public and internal notes", role, len(notes))
+		}
+	}
+}
@@ -2,8 +2,11 @@ package services
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
@@ -13,19 +16,114 @@ import (
 	"github.com/nicklaros/jalanrusak-be/pkg/logger"
 )
 
+// PathOrderingMode selects what happens when a path doubles back on itself.
+type PathOrderingMode string
+
+const (
+	// PathOrderingOff skips the direction reversal check.
+	PathOrderingOff PathOrderingMode = "off"
+	// PathOrderingAdvisory logs direction reversals but accepts the report.
+	PathOrderingAdvisory PathOrderingMode = "advisory"
+	// PathOrderingEnforce rejects reports whose path has direction reversals.
+	PathOrderingEnforce PathOrderingMode = "enforce"
+)
+
+// ReportServiceConfig holds tunable behavior for the report service
+type ReportServiceConfig struct {
+	// LenientPhotoValidation drops invalid photos instead of rejecting the report,
+	// as long as at least entities.MinPhotoURLs photos remain valid
+	LenientPhotoValidation bool
+
+	// StrictCentroidCheck requires at least one path point within CentroidRadiusMeters of the
+	// subdistrict centroid (FR-006)
+	StrictCentroidCheck bool
+
+	// CentroidRadiusMeters is the FR-006 radius; zero uses entities.DefaultCentroidRadiusMeters
+	CentroidRadiusMeters float64
+
+	// RequireKnownSubDistrict rejects reports whose subdistrict code is missing from the
+	// boundary dataset. When off, unknown codes are only logged
+	RequireKnownSubDistrict bool
+
+	// TextSanitization controls how title and description are neutralized before storage
+	TextSanitization entities.TextSanitizationMode
+
+	// DuplicateRadiusMeters rejects a new report whose path comes within this distance of an
+	// unresolved report, unless creation is forced. Zero disables the check
+	DuplicateRadiusMeters float64
+
+	// NearestReportRadiusMeters is the search radius for the advisory distance to the
+	// nearest unresolved report returned on create. Zero disables the lookup
+	NearestReportRadiusMeters float64
+
+	// SLAPolicy holds the maximum time a report may stay in each status
+	SLAPolicy entities.SLAPolicy
+
+	// RequireResolutionPhotos rejects resolving a report without proof-of-repair photos
+	RequireResolutionPhotos bool
+
+	// PhotoURLNormalization is applied to evidence and resolution photo URLs before storage
+	PhotoURLNormalization entities.PhotoURLNormalization
+
+	// MinReportInterval is the minimum time between consecutive reports by the same user.
+	// Admins are exempt. Zero disables the check
+	MinReportInterval time.Duration
+
+	// EditWindow is how long after creation the author may edit a report, on top of it
+	// still being submitted. Later changes go through an admin. Zero disables the limit
+	EditWindow time.Duration
+
+	// RequireCategory rejects reports created without a category_id
+	RequireCategory bool
+
+	// RequireReasonStatuses lists the statuses a report can only be moved to with a reason,
+	// e.g. resolved, so terminal states are always explained
+	RequireReasonStatuses []entities.Status
+
+	// MapResultLimit caps the reports returned for one map viewport
+	MapResultLimit int
+
+	// PathOrdering controls the check for paths that turn back by more than PathMaxTurnDegrees
+	// between consecutive segments, a sign of GPS scatter
+	PathOrdering       PathOrderingMode
+	PathMaxTurnDegrees float64
+}
+
 // ReportServiceImpl implements the ReportService use case
 type ReportServiceImpl struct {
 	repo           external.DamagedRoadRepository
+	historyRepo    external.ReportStatusHistoryRepository
+	categoryRepo   external.CategoryRepository
+	userRepo       external.UserRepository
 	geometrySvc    usecases.GeometryService
 	photoValidator external.PhotoValidator
+	eventBus       external.EventBus
+	config         ReportServiceConfig
 }
 
 // NewReportService creates a new ReportService implementation
-func NewReportService(repo external.DamagedRoadRepository, geometrySvc usecases.GeometryService, photoValidator external.PhotoValidator) usecases.ReportService {
+func NewReportService(
+	repo external.DamagedRoadRepository,
+	historyRepo external.ReportStatusHistoryRepository,
+	categoryRepo external.CategoryRepository,
+	userRepo external.UserRepository,
+	geometrySvc usecases.GeometryService,
+	photoValidator external.PhotoValidator,
+	eventBus external.EventBus,
+	config ReportServiceConfig,
+) usecases.ReportService {
+	if config.CentroidRadiusMeters <= 0 {
+		config.CentroidRadiusMeters = entities.DefaultCentroidRadiusMeters
+	}
 	return &ReportServiceImpl{
 		repo:           repo,
+		historyRepo:    historyRepo,
+		categoryRepo:   categoryRepo,
+		userRepo:       userRepo,
 		geometrySvc:    geometrySvc,
 		photoValidator: photoValidator,
+		eventBus:       eventBus,
+		config:         config,
 	}
 }
 
@@ -38,6 +136,8 @@ func (s *ReportServiceImpl) CreateReport(
 	photoURLs []string,
 	authorID uuid.UUID,
 	description *entities.Description,
+	categoryID *string,
+	force bool,
 ) (*entities.DamagedRoad, error) {
 	logger.InfoContext(ctx, "Creating new damaged road report", map[string]interface{}{
 		"author_id":        authorID.String(),
@@ -47,39 +147,41 @@ func (s *ReportServiceImpl) CreateReport(
 		"photo_urls":       len(photoURLs),
 	})
 
-	// Validate photo URLs with SSRF protection (FR-004)
-	photoResults := s.photoValidator.ValidateURLs(photoURLs)
-	var invalidPhotos []string
-	for _, result := range photoResults {
-		if !result.Valid {
-			invalidPhotos = append(invalidPhotos, fmt.Sprintf("%s: %s", result.URL, result.Error))
-		}
+	// Curb rapid-fire submissions before doing any expensive validation. The insert below
+	// enforces the interval again atomically; this only fails fast
+	minInterval, err := s.reportInterval(ctx, authorID)
+	if err != nil {
+		return nil, err
 	}
-	if len(invalidPhotos) > 0 {
-		logger.WarnContext(ctx, "Invalid photo URLs detected", map[string]interface{}{
-			"invalid_count": len(invalidPhotos),
-			"errors":        invalidPhotos,
-		})
-		return nil, fmt.Errorf("%w: %v", errors.ErrInvalidPhotoURLs, strings.Join(invalidPhotos, "; "))
+	if err := s.checkReportInterval(ctx, authorID, minInterval); err != nil {
+		return nil, err
 	}
 
-	// Validate coordinates are within Indonesian boundaries (FR-005)
-	if err := s.geometrySvc.ValidateCoordinatesInBoundary(pathPoints); err != nil {
-		logger.WarnContext(ctx, "Coordinates outside Indonesian boundaries", map[string]interface{}{
-			"error": err.Error(),
-		})
+	// Neutralize markup in user-supplied text before it is stored and echoed back
+	title, description, err = s.sanitizeText(ctx, title, description)
+	if err != nil {
+		return nil, err
+	}
+
+	// Catch typo'd subdistrict codes and unknown categories before fetching photos
+	if err := s.checkSubDistrictExists(ctx, subdistrictCode); err != nil {
+		return nil, err
+	}
+	if err := s.checkCategory(ctx, categoryID); err != nil {
 		return nil, err
 	}
 
-	// Validate coordinates are near subdistrict centroid (FR-006)
-	// At least one coordinate must be within 200 meters per spec
-	// if err := s.geometrySvc.ValidateCoordinatesNearCentroid(pathPoints, subdistrictCode, 200.0); err != nil {
-	// 	logger.WarnContext(ctx, "Coordinates do not match subdistrict location", map[string]interface{}{
-	// 		"error":            err.Error(),
-	// 		"subdistrict_code": subdistrictCode.String(),
-	// 	})
-	// 	return nil, err
-	// }
+	// Validate photo URLs with SSRF protection (FR-004)
+	photoURLs, droppedPhotos, photoWarnings, err := s.validatePhotoURLs(ctx, photoURLs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate coordinates against national bounds (FR-005) and the subdistrict (FR-006)
+	pathWarnings, err := s.validatePath(ctx, pathPoints, subdistrictCode)
+	if err != nil {
+		return nil, err
+	}
 
 	// Convert path points to geometry
 	geometry, err := entities.NewGeometryFromPoints(pathPoints)
@@ -90,6 +192,13 @@ func (s *ReportServiceImpl) CreateReport(
 		return nil, fmt.Errorf("invalid path points: %w", err)
 	}
 
+	// Citizens often report the same pothole again; point them to the existing report
+	if !force {
+		if err := s.checkDuplicate(ctx, *geometry); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create the damaged road entity
 	road, err := entities.NewDamagedRoad(
 		title,
@@ -98,6 +207,7 @@ func (s *ReportServiceImpl) CreateReport(
 		photoURLs,
 		authorID,
 		description,
+		categoryID,
 	)
 	if err != nil {
 		logger.ErrorContext(ctx, "Failed to create damaged road entity", map[string]interface{}{
@@ -105,9 +215,31 @@ func (s *ReportServiceImpl) CreateReport(
 		})
 		return nil, fmt.Errorf("failed to create report: %w", err)
 	}
+	road.DroppedPhotos = droppedPhotos
+	road.Warnings = append(photoWarnings, pathWarnings...)
+
+	// Advisory duplicate hint: distance to the nearest unresolved report (never blocks creation)
+	if s.config.NearestReportRadiusMeters > 0 {
+		distance, err := s.repo.FindNearestUnresolvedDistance(ctx, road.Path, s.config.NearestReportRadiusMeters)
+		if err != nil {
+			logger.WarnContext(ctx, "Failed to look up nearest report distance", map[string]interface{}{
+				"error": err.Error(),
+			})
+		} else {
+			road.NearestReportDistanceMeters = distance
+		}
+	}
 
 	// Save to repository
-	if err := s.repo.Create(ctx, road); err != nil {
+	if err := s.repo.CreateAfterInterval(ctx, road, minInterval); err != nil {
+		var tooSoonErr *errors.TooSoonError
+		if stderrors.As(err, &tooSoonErr) {
+			logger.WarnContext(ctx, "Report submitted too soon after previous one", map[string]interface{}{
+				"author_id":   authorID.String(),
+				"retry_after": tooSoonErr.RetryAfter.String(),
+			})
+			return nil, err
+		}
 		logger.ErrorContext(ctx, "Failed to save damaged road report", map[string]interface{}{
 			"error": err.Error(),
 		})
@@ -115,19 +247,302 @@ func (s *ReportServiceImpl) CreateReport(
 	}
 
 	logger.InfoContext(ctx, "Successfully created damaged road report", map[string]interface{}{
-		"report_id": road.ID.String(),
+		"report_id":      road.ID.String(),
+		"dropped_photos": len(road.DroppedPhotos),
+		"warnings":       len(road.Warnings),
 	})
 
+	// Side effects (notifications, webhooks, analytics) subscribe to this event
+	s.eventBus.Publish(ctx, entities.NewReportCreatedEvent(road))
+
 	return road, nil
 }
 
+// sanitizeText neutralizes markup in user-supplied text before it is stored and echoed back
+func (s *ReportServiceImpl) sanitizeText(
+	ctx context.Context,
+	title entities.Title,
+	description *entities.Description,
+) (entities.Title, *entities.Description, error) {
+	title, err := title.Sanitize(s.config.TextSanitization)
+	if err != nil {
+		logger.WarnContext(ctx, "Title rejected by sanitization", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return "", nil, err
+	}
+	if description != nil {
+		sanitized, err := description.Sanitize(s.config.TextSanitization)
+		if err != nil {
+			logger.WarnContext(ctx, "Description rejected by sanitization", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return "", nil, err
+		}
+		description = &sanitized
+	}
+	return title, description, nil
+}
+
+// checkSubDistrictExists looks the subdistrict code up in the boundary dataset. Unknown codes
+// are rejected with ErrSubDistrictNotFound when RequireKnownSubDistrict is set and logged otherwise.
+// Lookup failures never block the report.
+func (s *ReportServiceImpl) checkSubDistrictExists(ctx context.Context, subdistrictCode entities.SubDistrictCode) error {
+	err := s.geometrySvc.ValidateSubDistrictExists(ctx, subdistrictCode)
+	if err == nil {
+		return nil
+	}
+
+	if !stderrors.Is(err, errors.ErrSubDistrictNotFound) {
+		logger.WarnContext(ctx, "Failed to check subdistrict existence", map[string]interface{}{
+			"subdistrict_code": subdistrictCode.String(),
+			"error":            err.Error(),
+		})
+		return nil
+	}
+
+	logger.WarnContext(ctx, "Report references unknown subdistrict code", map[string]interface{}{
+		"subdistrict_code": subdistrictCode.String(),
+		"enforced":         s.config.RequireKnownSubDistrict,
+	})
+	if s.config.RequireKnownSubDistrict {
+		return err
+	}
+	return nil
+}
+
+// checkCategory validates the report category against the taxonomy. A missing category is
+// only rejected when RequireCategory is set
+func (s *ReportServiceImpl) checkCategory(ctx context.Context, categoryID *string) error {
+	if categoryID == nil {
+		if s.config.RequireCategory {
+			return errors.NewValidationError("category_id", errors.ErrCategoryRequired.Error(), errors.ErrCategoryRequired)
+		}
+		return nil
+	}
+
+	exists, err := s.categoryRepo.Exists(ctx, *categoryID)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to check category", map[string]interface{}{
+			"category_id": *categoryID,
+			"error":       err.Error(),
+		})
+		return fmt.Errorf("failed to check category: %w", err)
+	}
+	if !exists {
+		return errors.NewValidationError("category_id", fmt.Sprintf("unknown category %q", *categoryID), errors.ErrCategoryNotFound)
+	}
+	return nil
+}
+
+// validatePhotoURLs normalizes and validates evidence photo URLs (FR-004).
+// In lenient mode invalid photos are returned as dropped instead of failing, as long as
+// the minimum number of valid photos remains. URLs left unchecked by the validator's fetch
+// cap are not known to be invalid, so they fail the request in either mode. Warnings about
+// accepted photos are returned alongside.
+func (s *ReportServiceImpl) validatePhotoURLs(
+	ctx context.Context,
+	photoURLs []string,
+) ([]string, []entities.DroppedPhoto, []string, error) {
+	// Collapse repeated and equivalent photo URLs before validation and storage
+	photoURLs = s.config.PhotoURLNormalization.NormalizeAll(photoURLs)
+
+	// Validate photo URLs with SSRF protection
+	photoResults := s.photoValidator.ValidateURLs(ctx, photoURLs)
+	var invalidPhotos []string
+	var uncheckedPhotos []string
+	var validPhotoURLs []string
+	var droppedPhotos []entities.DroppedPhoto
+	var warnings []string
+	for _, result := range photoResults {
+		if result.NotChecked {
+			uncheckedPhotos = append(uncheckedPhotos, result.URL)
+		}
+		if !result.Valid {
+			invalidPhotos = append(invalidPhotos, fmt.Sprintf("%s: %s", result.URL, result.Error))
+			droppedPhotos = append(droppedPhotos, entities.DroppedPhoto{URL: result.URL, Reason: result.Error})
+			continue
+		}
+		validPhotoURLs = append(validPhotoURLs, result.URL)
+		if result.Warning != "" {
+			warnings = append(warnings, fmt.Sprintf("%s: %s", result.URL, result.Warning))
+		}
+	}
+	if len(invalidPhotos) == 0 {
+		return photoURLs, nil, warnings, nil
+	}
+
+	logger.WarnContext(ctx, "Invalid photo URLs detected", map[string]interface{}{
+		"invalid_count": len(invalidPhotos),
+		"errors":        invalidPhotos,
+		"lenient":       s.config.LenientPhotoValidation,
+	})
+
+	if !s.config.LenientPhotoValidation {
+		return nil, nil, nil, fmt.Errorf("%w: %v", errors.ErrInvalidPhotoURLs, strings.Join(invalidPhotos, "; "))
+	}
+	if len(uncheckedPhotos) > 0 {
+		return nil, nil, nil, fmt.Errorf("%w: %d photo URLs were not checked, send fewer photos: %s",
+			errors.ErrInvalidPhotoURLs, len(uncheckedPhotos), strings.Join(uncheckedPhotos, ", "))
+	}
+
+	// Lenient mode: keep the valid photos as long as the minimum is still met
+	if len(validPhotoURLs) < entities.MinPhotoURLs {
+		return nil, nil, nil, errors.NewValidationError(
+			"photo_urls",
+			fmt.Sprintf("at least %d valid photo URL required: %s", entities.MinPhotoURLs, strings.Join(invalidPhotos, "; ")),
+			errors.ErrInvalidPhotoURLs,
+		)
+	}
+	return validPhotoURLs, droppedPhotos, warnings, nil
+}
+
+// validatePath runs the geometry checks on a report path: national bounds (FR-005),
+// direction reversals and proximity to the subdistrict centroid (FR-006). Checks that are
+// not enforced are returned as warnings instead
+func (s *ReportServiceImpl) validatePath(
+	ctx context.Context,
+	pathPoints []entities.Point,
+	subdistrictCode entities.SubDistrictCode,
+) ([]string, error) {
+	// Validate coordinates are within Indonesian boundaries
+	if err := s.geometrySvc.ValidateCoordinatesInBoundary(pathPoints); err != nil {
+		logger.WarnContext(ctx, "Coordinates outside Indonesian boundaries", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	var warnings []string
+
+	// Flag paths that jump back and forth instead of progressing along the road
+	if s.config.PathOrdering == PathOrderingAdvisory || s.config.PathOrdering == PathOrderingEnforce {
+		if reversals := s.geometrySvc.FindDirectionReversals(pathPoints, s.config.PathMaxTurnDegrees); len(reversals) > 0 {
+			logger.WarnContext(ctx, "Path has direction reversals", map[string]interface{}{
+				"vertices":         reversals,
+				"max_turn_degrees": s.config.PathMaxTurnDegrees,
+				"enforced":         s.config.PathOrdering == PathOrderingEnforce,
+			})
+			message := fmt.Sprintf("path turns back by more than %.0f degrees at points %v", s.config.PathMaxTurnDegrees, reversals)
+			if s.config.PathOrdering == PathOrderingEnforce {
+				return nil, errors.NewValidationError("path_points", message, errors.ErrPathDirectionReversal)
+			}
+			warnings = append(warnings, message)
+		}
+	}
+
+	// At least one coordinate must be within CentroidRadiusMeters of the centroid per spec. When
+	// the check is not strict, a path that is only far from the centroid is accepted with a warning
+	radius := s.config.CentroidRadiusMeters
+	if err := s.geometrySvc.ValidateCoordinatesNearCentroid(ctx, pathPoints, subdistrictCode, radius); err != nil {
+		// Without a seeded centroid there is nothing to compare against; unknown codes are
+		// handled by checkSubDistrictExists, so valid reports are not rejected here
+		if stderrors.Is(err, errors.ErrSubDistrictNotFound) {
+			logger.WarnContext(ctx, "Subdistrict centroid unknown, skipping centroid check", map[string]interface{}{
+				"subdistrict_code": subdistrictCode.String(),
+			})
+			return warnings, nil
+		}
+
+		logger.WarnContext(ctx, "Coordinates do not match subdistrict location", map[string]interface{}{
+			"error":            err.Error(),
+			"subdistrict_code": subdistrictCode.String(),
+			"enforced":         s.config.StrictCentroidCheck,
+		})
+		if s.config.StrictCentroidCheck {
+			return nil, err
+		}
+		warnings = append(warnings, fmt.Sprintf("path is more than %.0f meters from the subdistrict centroid", radius))
+	}
+
+	return warnings, nil
+}
+
+// checkDuplicate rejects a new report whose path is within DuplicateRadiusMeters of an unresolved
+// report. Lookup failures never block the report.
+func (s *ReportServiceImpl) checkDuplicate(ctx context.Context, path entities.Geometry) error {
+	if s.config.DuplicateRadiusMeters <= 0 {
+		return nil
+	}
+
+	existingID, err := s.repo.FindNearestUnresolvedID(ctx, path, s.config.DuplicateRadiusMeters)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to check for duplicate reports", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil
+	}
+	if existingID == nil {
+		return nil
+	}
+
+	logger.InfoContext(ctx, "Rejected duplicate report", map[string]interface{}{
+		"existing_report_id": existingID.String(),
+		"radius_meters":      s.config.DuplicateRadiusMeters,
+	})
+	return &errors.DuplicateReportError{ExistingReportID: *existingID, RadiusMeters: s.config.DuplicateRadiusMeters}
+}
+
+// reportInterval returns the minimum time the author must leave between reports: 0 when
+// MinReportInterval is disabled or the author is an admin
+func (s *ReportServiceImpl) reportInterval(ctx context.Context, authorID uuid.UUID) (time.Duration, error) {
+	if s.config.MinReportInterval <= 0 {
+		return 0, nil
+	}
+
+	user, err := s.userRepo.FindByID(ctx, authorID)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to look up report author", map[string]interface{}{
+			"author_id": authorID.String(),
+			"error":     err.Error(),
+		})
+		return 0, fmt.Errorf("failed to check report interval: %w", err)
+	}
+	if user != nil && user.Role == entities.RoleAdmin {
+		return 0, nil
+	}
+	return s.config.MinReportInterval, nil
+}
+
+// checkReportInterval rejects a new report when the author's previous one is more recent
+// than minInterval. A failed lookup rejects the report rather than skipping the check.
+func (s *ReportServiceImpl) checkReportInterval(ctx context.Context, authorID uuid.UUID, minInterval time.Duration) error {
+	if minInterval <= 0 {
+		return nil
+	}
+
+	latest, err := s.repo.FindLatestCreatedAtByAuthor(ctx, authorID)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to look up latest report time", map[string]interface{}{
+			"author_id": authorID.String(),
+			"error":     err.Error(),
+		})
+		return fmt.Errorf("failed to check report interval: %w", err)
+	}
+	if latest == nil {
+		return nil
+	}
+
+	wait := minInterval - time.Since(*latest)
+	if wait <= 0 {
+		return nil
+	}
+
+	logger.WarnContext(ctx, "Report submitted too soon after previous one", map[string]interface{}{
+		"author_id":   authorID.String(),
+		"retry_after": wait.String(),
+	})
+	return &errors.TooSoonError{RetryAfter: wait}
+}
+
 // GetReport retrieves a damaged road report by ID
-func (s *ReportServiceImpl) GetReport(ctx context.Context, id uuid.UUID) (*entities.DamagedRoad, error) {
+func (s *ReportServiceImpl) GetReport(ctx context.Context, id uuid.UUID, srid int) (*entities.DamagedRoad, error) {
 	logger.DebugContext(ctx, "Retrieving damaged road report", map[string]interface{}{
 		"report_id": id.String(),
+		"srid":      srid,
 	})
 
-	road, err := s.repo.FindByID(ctx, id)
+	road, err := s.repo.FindByIDInSRID(ctx, id, srid)
 	if err != nil {
 		logger.ErrorContext(ctx, "Failed to retrieve damaged road report", map[string]interface{}{
 			"report_id": id.String(),
@@ -140,9 +555,40 @@ func (s *ReportServiceImpl) GetReport(ctx context.Context, id uuid.UUID) (*entit
 		return nil, errors.ErrReportNotFound
 	}
 
+	s.applySLA(road)
+	s.applyCentroidDistance(ctx, road)
+
 	return road, nil
 }
 
+// applyCentroidDistance sets the distance from the report's closest path point to its subdistrict
+// centroid. It is left unset when the subdistrict has no centroid data
+func (s *ReportServiceImpl) applyCentroidDistance(ctx context.Context, road *entities.DamagedRoad) {
+	centroid, err := s.geometrySvc.GetSubDistrictCentroid(ctx, road.SubDistrictCode)
+	if err != nil {
+		if !stderrors.Is(err, errors.ErrSubDistrictNotFound) {
+			logger.WarnContext(ctx, "Failed to look up subdistrict centroid", map[string]interface{}{
+				"report_id":        road.ID.String(),
+				"subdistrict_code": string(road.SubDistrictCode),
+				"error":            err.Error(),
+			})
+		}
+		return
+	}
+
+	points := road.Path.ToPoints()
+	if len(points) == 0 {
+		return
+	}
+	closest := s.geometrySvc.CalculateDistance(points[0], centroid)
+	for _, point := range points[1:] {
+		if distance := s.geometrySvc.CalculateDistance(point, centroid); distance < closest {
+			closest = distance
+		}
+	}
+	road.DistanceToCentroidMeters = &closest
+}
+
 // ListReportsByAuthor retrieves all reports created by a specific author
 func (s *ReportServiceImpl) ListReportsByAuthor(
 	ctx context.Context,
@@ -172,6 +618,8 @@ func (s *ReportServiceImpl) ListReportsByAuthor(
 		return nil, 0, fmt.Errorf("failed to list reports: %w", err)
 	}
 
+	s.applySLA(roads...)
+
 	return roads, total, nil
 }
 
@@ -192,6 +640,7 @@ func (s *ReportServiceImpl) ListReports(
 	if filters.Offset < 0 {
 		filters.Offset = 0
 	}
+	filters.SLAPolicy = s.config.SLAPolicy
 
 	roads, total, err := s.repo.List(ctx, filters)
 	if err != nil {
@@ -201,20 +650,131 @@ func (s *ReportServiceImpl) ListReports(
 		return nil, 0, fmt.Errorf("failed to list reports: %w", err)
 	}
 
+	s.applySLA(roads...)
+
 	return roads, total, nil
 }
 
+// FindNearbyReports retrieves reports within radiusMeters of center, closest first
+func (s *ReportServiceImpl) FindNearbyReports(
+	ctx context.Context,
+	center entities.Point,
+	radiusMeters float64,
+	limit int,
+) ([]*entities.DamagedRoad, error) {
+	if err := center.Validate(); err != nil {
+		return nil, err
+	}
+	if radiusMeters <= 0 || radiusMeters > entities.MaxNearbyRadiusMeters {
+		return nil, errors.NewValidationError("radius",
+			fmt.Sprintf("radius must be greater than 0 and at most %.0f meters", entities.MaxNearbyRadiusMeters),
+			errors.ErrInvalidInput)
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	roads, err := s.repo.FindWithinRadius(ctx, center, radiusMeters, limit)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to find nearby reports", map[string]interface{}{
+			"error":  err.Error(),
+			"lat":    center.Lat,
+			"lng":    center.Lng,
+			"radius": radiusMeters,
+		})
+		return nil, fmt.Errorf("failed to find nearby reports: %w", err)
+	}
+
+	s.applySLA(roads...)
+
+	return roads, nil
+}
+
+// FindReportsInBoundingBox retrieves up to MapResultLimit reports inside the box
+func (s *ReportServiceImpl) FindReportsInBoundingBox(
+	ctx context.Context,
+	box entities.BoundingBox,
+) ([]*entities.DamagedRoad, bool, error) {
+	bounds, err := box.ToGeometry()
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Fetch one extra row to tell whether the viewport holds more than the limit
+	limit := s.config.MapResultLimit
+	queryLimit := 0
+	if limit > 0 {
+		queryLimit = limit + 1
+	}
+
+	roads, err := s.repo.FindByGeometry(ctx, *bounds, queryLimit)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to find reports in bounding box", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, false, fmt.Errorf("failed to find reports in bounding box: %w", err)
+	}
+
+	truncated := limit > 0 && len(roads) > limit
+	if truncated {
+		roads = roads[:limit]
+	}
+
+	s.applySLA(roads...)
+
+	return roads, truncated, nil
+}
+
+// CountReports returns the total number of reports regardless of filters
+func (s *ReportServiceImpl) CountReports(ctx context.Context) (int, error) {
+	total, err := s.repo.Count(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to count reports", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, fmt.Errorf("failed to count reports: %w", err)
+	}
+	return total, nil
+}
+
+// StreamReports calls fn for every report matching the filters, ignoring pagination
+func (s *ReportServiceImpl) StreamReports(
+	ctx context.Context,
+	filters *entities.DamagedRoadFilters,
+	fn func(*entities.DamagedRoad) error,
+) error {
+	logger.DebugContext(ctx, "Streaming reports with filters", nil)
+
+	filters.SLAPolicy = s.config.SLAPolicy
+
+	err := s.repo.StreamList(ctx, filters, func(road *entities.DamagedRoad) error {
+		s.applySLA(road)
+		return fn(road)
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to stream reports", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return fmt.Errorf("failed to stream reports: %w", err)
+	}
+
+	return nil
+}
+
 // UpdateReportStatus updates the status of a damaged road report
 func (s *ReportServiceImpl) UpdateReportStatus(
 	ctx context.Context,
 	id uuid.UUID,
 	newStatus entities.Status,
+	reason string,
+	resolutionPhotoURLs []string,
 	requesterID uuid.UUID,
 ) (*entities.DamagedRoad, error) {
 	logger.InfoContext(ctx, "Updating report status", map[string]interface{}{
-		"report_id":    id.String(),
-		"new_status":   newStatus.String(),
-		"requester_id": requesterID.String(),
+		"report_id":         id.String(),
+		"new_status":        newStatus.String(),
+		"resolution_photos": len(resolutionPhotoURLs),
+		"requester_id":      requesterID.String(),
 	})
 
 	// Get the existing report
@@ -231,7 +791,17 @@ func (s *ReportServiceImpl) UpdateReportStatus(
 		return nil, errors.ErrReportNotFound
 	}
 
+	// Only staff may move a report through the workflow; the route checks this too
+	if err := s.requireStaff(ctx, requesterID); err != nil {
+		logger.WarnContext(ctx, "Unauthorized status update attempt", map[string]interface{}{
+			"report_id":    id.String(),
+			"requester_id": requesterID.String(),
+		})
+		return nil, err
+	}
+
 	// Update the status (entity validates transition)
+	fromStatus := road.Status
 	if err := road.UpdateStatus(newStatus); err != nil {
 		logger.WarnContext(ctx, "Invalid status transition attempted", map[string]interface{}{
 			"report_id":   id.String(),
@@ -242,8 +812,42 @@ func (s *ReportServiceImpl) UpdateReportStatus(
 		return nil, err
 	}
 
+	statusReason, err := s.checkStatusReason(ctx, newStatus, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	// Proof-of-repair photos are only accepted, and optionally required, when resolving
+	resolutionPhotoURLs = s.config.PhotoURLNormalization.NormalizeAll(resolutionPhotoURLs)
+	if err := road.AttachResolutionPhotos(resolutionPhotoURLs, s.config.RequireResolutionPhotos); err != nil {
+		logger.WarnContext(ctx, "Resolution photos rejected", map[string]interface{}{
+			"report_id": id.String(),
+			"error":     err.Error(),
+		})
+		return nil, err
+	}
+
+	// Resolution photos get the same checks as evidence photos, without lenient dropping
+	if len(road.ResolutionPhotoURLs) > 0 {
+		var invalidPhotos []string
+		for _, result := range s.photoValidator.ValidateURLs(ctx, road.ResolutionPhotoURLs) {
+			if !result.Valid {
+				invalidPhotos = append(invalidPhotos, fmt.Sprintf("%s: %s", result.URL, result.Error))
+			}
+		}
+		if len(invalidPhotos) > 0 {
+			logger.WarnContext(ctx, "Invalid resolution photo URLs detected", map[string]interface{}{
+				"report_id":     id.String(),
+				"invalid_count": len(invalidPhotos),
+				"errors":        invalidPhotos,
+			})
+			return nil, fmt.Errorf("%w: %v", errors.ErrInvalidPhotoURLs, strings.Join(invalidPhotos, "; "))
+		}
+	}
+
 	// Save the updated status
-	if err := s.repo.UpdateStatus(ctx, id, newStatus); err != nil {
+	change := entities.NewStatusChange(id, fromStatus, newStatus, requesterID, road.StatusChangedAt, statusReason)
+	if err := s.repo.UpdateStatus(ctx, change, road.ResolutionPhotoURLs); err != nil {
 		logger.ErrorContext(ctx, "Failed to save status update", map[string]interface{}{
 			"report_id": id.String(),
 			"error":     err.Error(),
@@ -256,10 +860,267 @@ func (s *ReportServiceImpl) UpdateReportStatus(
 		"new_status": newStatus.String(),
 	})
 
+	s.eventBus.Publish(ctx, entities.NewReportStatusChangedEvent(road, change))
+
+	s.applySLA(road)
+
+	return road, nil
+}
+
+// requireStaff returns errors.ErrUnauthorizedAccess unless the requester is a verificator or admin.
+// The role is read from the user record, so a demoted user loses access at once
+func (s *ReportServiceImpl) requireStaff(ctx context.Context, requesterID uuid.UUID) error {
+	user, err := s.userRepo.FindByID(ctx, requesterID)
+	if err != nil {
+		return fmt.Errorf("failed to get requester: %w", err)
+	}
+	if user == nil || !entities.IsStaffRole(user.Role) {
+		return errors.ErrUnauthorizedAccess
+	}
+	return nil
+}
+
+// checkStatusReason validates and sanitizes the reason for a status change, rejecting an empty
+// one when the new status is in RequireReasonStatuses. Returns nil when no reason was given
+func (s *ReportServiceImpl) checkStatusReason(
+	ctx context.Context,
+	newStatus entities.Status,
+	reason string,
+) (*entities.Description, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		if slices.Contains(s.config.RequireReasonStatuses, newStatus) {
+			logger.WarnContext(ctx, "Status change rejected without reason", map[string]interface{}{
+				"to_status": newStatus.String(),
+			})
+			return nil, errors.NewValidationError(
+				"reason",
+				fmt.Sprintf("a reason is required to move a report to %s", newStatus),
+				errors.ErrStatusReasonRequired,
+			)
+		}
+		return nil, nil
+	}
+
+	description, err := entities.NewDescription(reason)
+	if err != nil {
+		return nil, errors.NewValidationError("reason", "cannot exceed 500 characters", errors.ErrInvalidDescription)
+	}
+	description, err = description.Sanitize(s.config.TextSanitization)
+	if err != nil {
+		return nil, err
+	}
+	return &description, nil
+}
+
+// GetStatusHistory retrieves the status transitions of a report, oldest first
+func (s *ReportServiceImpl) GetStatusHistory(ctx context.Context, id uuid.UUID) ([]*entities.StatusChange, error) {
+	road, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+	if road == nil {
+		return nil, errors.ErrReportNotFound
+	}
+
+	changes, err := s.historyRepo.FindByReport(ctx, id)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to retrieve status history", map[string]interface{}{
+			"report_id": id.String(),
+			"error":     err.Error(),
+		})
+		return nil, fmt.Errorf("failed to get status history: %w", err)
+	}
+
+	return changes, nil
+}
+
+// UpdateReport replaces the title, description, photos and path of a report.
+// Only the author may edit, and only while the report is still submitted
+func (s *ReportServiceImpl) UpdateReport(
+	ctx context.Context,
+	id uuid.UUID,
+	requesterID uuid.UUID,
+	title entities.Title,
+	description *entities.Description,
+	photoURLs []string,
+	pathPoints []entities.Point,
+) (*entities.DamagedRoad, error) {
+	logger.InfoContext(ctx, "Updating damaged road report", map[string]interface{}{
+		"report_id":    id.String(),
+		"requester_id": requesterID.String(),
+		"path_points":  len(pathPoints),
+		"photo_urls":   len(photoURLs),
+	})
+
+	road, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to retrieve report for update", map[string]interface{}{
+			"report_id": id.String(),
+			"error":     err.Error(),
+		})
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	if road == nil {
+		return nil, errors.ErrReportNotFound
+	}
+
+	if !road.CanBeEditedBy(requesterID) {
+		logger.WarnContext(ctx, "Unauthorized edit attempt", map[string]interface{}{
+			"report_id":    id.String(),
+			"requester_id": requesterID.String(),
+			"author_id":    road.AuthorID.String(),
+		})
+		return nil, errors.ErrUnauthorizedAccess
+	}
+
+	// Check before validating so frozen reports fail fast without fetching photos
+	if err := s.checkEditable(road); err != nil {
+		return nil, err
+	}
+
+	title, description, err = s.sanitizeText(ctx, title, description)
+	if err != nil {
+		return nil, err
+	}
+
+	photoURLs, droppedPhotos, photoWarnings, err := s.validatePhotoURLs(ctx, photoURLs)
+	if err != nil {
+		return nil, err
+	}
+
+	pathWarnings, err := s.validatePath(ctx, pathPoints, road.SubDistrictCode)
+	if err != nil {
+		return nil, err
+	}
+
+	geometry, err := entities.NewGeometryFromPoints(pathPoints)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path points: %w", err)
+	}
+
+	if err := road.Edit(title, description, *geometry, photoURLs); err != nil {
+		return nil, err
+	}
+	road.DroppedPhotos = droppedPhotos
+	road.Warnings = append(photoWarnings, pathWarnings...)
+
+	if err := s.repo.Update(ctx, road); err != nil {
+		if stderrors.Is(err, errors.ErrReportNotEditable) {
+			logger.WarnContext(ctx, "Report left submitted before the edit was saved", map[string]interface{}{
+				"report_id": id.String(),
+			})
+			return nil, err
+		}
+		logger.ErrorContext(ctx, "Failed to update report", map[string]interface{}{
+			"report_id": id.String(),
+			"error":     err.Error(),
+		})
+		return nil, fmt.Errorf("failed to update report: %w", err)
+	}
+
+	logger.InfoContext(ctx, "Successfully updated damaged road report", map[string]interface{}{
+		"report_id":      id.String(),
+		"dropped_photos": len(road.DroppedPhotos),
+	})
+
+	s.applySLA(road)
+
 	return road, nil
 }
 
-// DeleteReport deletes a damaged road report
+// UpdateReportPath corrects the path of a report without re-validating or rewriting its photos.
+// Only the author may edit, and only while the report is still submitted
+func (s *ReportServiceImpl) UpdateReportPath(
+	ctx context.Context,
+	id uuid.UUID,
+	requesterID uuid.UUID,
+	pathPoints []entities.Point,
+) (*entities.DamagedRoad, error) {
+	logger.InfoContext(ctx, "Updating damaged road report path", map[string]interface{}{
+		"report_id":    id.String(),
+		"requester_id": requesterID.String(),
+		"path_points":  len(pathPoints),
+	})
+
+	road, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to retrieve report for path update", map[string]interface{}{
+			"report_id": id.String(),
+			"error":     err.Error(),
+		})
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	if road == nil {
+		return nil, errors.ErrReportNotFound
+	}
+
+	if !road.CanBeEditedBy(requesterID) {
+		logger.WarnContext(ctx, "Unauthorized path edit attempt", map[string]interface{}{
+			"report_id":    id.String(),
+			"requester_id": requesterID.String(),
+			"author_id":    road.AuthorID.String(),
+		})
+		return nil, errors.ErrUnauthorizedAccess
+	}
+
+	if err := s.checkEditable(road); err != nil {
+		return nil, err
+	}
+
+	warnings, err := s.validatePath(ctx, pathPoints, road.SubDistrictCode)
+	if err != nil {
+		return nil, err
+	}
+
+	geometry, err := entities.NewGeometryFromPoints(pathPoints)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path points: %w", err)
+	}
+
+	if err := road.EditPath(*geometry); err != nil {
+		return nil, err
+	}
+	road.Warnings = warnings
+
+	if err := s.repo.UpdatePath(ctx, road.ID, road.Path, road.UpdatedAt); err != nil {
+		if stderrors.Is(err, errors.ErrReportNotEditable) {
+			logger.WarnContext(ctx, "Report left submitted before the path edit was saved", map[string]interface{}{
+				"report_id": id.String(),
+			})
+			return nil, err
+		}
+		logger.ErrorContext(ctx, "Failed to update report path", map[string]interface{}{
+			"report_id": id.String(),
+			"error":     err.Error(),
+		})
+		return nil, fmt.Errorf("failed to update report path: %w", err)
+	}
+
+	logger.InfoContext(ctx, "Successfully updated damaged road report path", map[string]interface{}{
+		"report_id": id.String(),
+	})
+
+	s.applySLA(road)
+
+	return road, nil
+}
+
+// checkEditable applies the author edit gate: the report must still be submitted and
+// within the configured edit window after creation
+func (s *ReportServiceImpl) checkEditable(road *entities.DamagedRoad) error {
+	if !road.IsEditable() {
+		return errors.ErrReportNotEditable
+	}
+	if !road.IsWithinEditWindow(s.config.EditWindow, time.Now()) {
+		return errors.ErrEditWindowExpired
+	}
+	return nil
+}
+
+// DeleteReport soft-deletes a damaged road report, hiding it from all reads
 func (s *ReportServiceImpl) DeleteReport(ctx context.Context, id uuid.UUID, requesterID uuid.UUID) error {
 	logger.InfoContext(ctx, "Deleting damaged road report", map[string]interface{}{
 		"report_id":    id.String(),
@@ -303,5 +1164,15 @@ func (s *ReportServiceImpl) DeleteReport(ctx context.Context, id uuid.UUID, requ
 		"report_id": id.String(),
 	})
 
+	s.eventBus.Publish(ctx, entities.NewReportDeletedEvent(road, requesterID))
+
 	return nil
 }
+
+// applySLA marks each road whose time in its current status exceeds the configured SLA
+func (s *ReportServiceImpl) applySLA(roads ...*entities.DamagedRoad) {
+	now := time.Now()
+	for _, road := range roads {
+		road.SLABreached = s.config.SLAPolicy.IsBreached(road, now)
+	}
+}
@@ -0,0 +1,996 @@
+package services
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+)
+
+// fakeReportRepo keeps reports in memory. Methods the tests don't need panic through the
+// embedded nil interface. afterFind, when set, runs after FindByID has copied a report out,
+// to simulate another request changing it in between; latestErr fails the interval lookup
+// and nearbyErr the duplicate lookup
+type fakeReportRepo struct {
+	external.DamagedRoadRepository
+	mu             sync.Mutex
+	reports        []*entities.DamagedRoad
+	statusChanges  []*entities.StatusChange
+	afterFind      func()
+	latestErr      error
+	nearbyErr      error
+	radiusSearches []float64
+}
+
+// CreateAfterInterval checks the interval and stores the report under one lock, like the
+// Postgres advisory lock
+func (r *fakeReportRepo) CreateAfterInterval(ctx context.Context, road *entities.DamagedRoad, minInterval time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if latest := r.latestCreatedAt(road.AuthorID); latest != nil {
+		if wait := minInterval - time.Since(*latest); wait > 0 {
+			return &errors.TooSoonError{RetryAfter: wait}
+		}
+	}
+	r.reports = append(r.reports, road)
+	return nil
+}
+
+func (r *fakeReportRepo) FindLatestCreatedAtByAuthor(ctx context.Context, authorID uuid.UUID) (*time.Time, error) {
+	if r.latestErr != nil {
+		return nil, r.latestErr
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.latestCreatedAt(authorID), nil
+}
+
+// latestCreatedAt must be called with mu held
+func (r *fakeReportRepo) latestCreatedAt(authorID uuid.UUID) *time.Time {
+	var latest *time.Time
+	for _, road := range r.reports {
+		if road.AuthorID == authorID && (latest == nil || road.CreatedAt.After(*latest)) {
+			createdAt := road.CreatedAt
+			latest = &createdAt
+		}
+	}
+	return latest
+}
+
+func (r *fakeReportRepo) FindByID(ctx context.Context, id uuid.UUID) (*entities.DamagedRoad, error) {
+	r.mu.Lock()
+	var found *entities.DamagedRoad
+	for _, road := range r.reports {
+		if road.ID == id {
+			copied := *road
+			found = &copied
+		}
+	}
+	r.mu.Unlock()
+
+	if r.afterFind != nil {
+		r.afterFind()
+	}
+	return found, nil
+}
+
+// FindByIDInSRID ignores srid; only WGS84 paths are kept in memory
+func (r *fakeReportRepo) FindByIDInSRID(ctx context.Context, id uuid.UUID, srid int) (*entities.DamagedRoad, error) {
+	return r.FindByID(ctx, id)
+}
+
+// stored returns the repository's own copy of a report
+func (r *fakeReportRepo) stored(id uuid.UUID) *entities.DamagedRoad {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, road := range r.reports {
+		if road.ID == id {
+			return road
+		}
+	}
+	return nil
+}
+
+// Update honors the repository contract: the edit only lands while the stored report is submitted
+func (r *fakeReportRepo) Update(ctx context.Context, road *entities.DamagedRoad) error {
+	stored := r.stored(road.ID)
+	if stored == nil {
+		return errors.ErrRecordNotFound
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored.Status != entities.StatusSubmitted {
+		return errors.ErrReportNotEditable
+	}
+	stored.Title = road.Title
+	stored.Description = road.Description
+	stored.Path = road.Path
+	stored.PhotoURLs = road.PhotoURLs
+	stored.UpdatedAt = road.UpdatedAt
+	return nil
+}
+
+func (r *fakeReportRepo) UpdatePath(ctx context.Context, id uuid.UUID, path entities.Geometry, updatedAt time.Time) error {
+	stored := r.stored(id)
+	if stored == nil {
+		return errors.ErrRecordNotFound
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored.Status != entities.StatusSubmitted {
+		return errors.ErrReportNotEditable
+	}
+	stored.Path = path
+	stored.UpdatedAt = updatedAt
+	return nil
+}
+
+// setStatus changes a stored report's status, as a verificator's request would
+func (r *fakeReportRepo) setStatus(id uuid.UUID, status entities.Status) {
+	stored := r.stored(id)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored.Status = status
+}
+
+// StreamList streams reports from memory, honoring the author filter
+func (r *fakeReportRepo) StreamList(ctx context.Context, filters *entities.DamagedRoadFilters, fn func(*entities.DamagedRoad) error) error {
+	for _, road := range r.reports {
+		if filters.AuthorID != nil && road.AuthorID != *filters.AuthorID {
+			continue
+		}
+		if err := fn(road); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakeReportRepo) UpdateStatus(ctx context.Context, change *entities.StatusChange, resolutionPhotoURLs []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.statusChanges = append(r.statusChanges, change)
+	for _, road := range r.reports {
+		if road.ID == change.ReportID {
+			road.Status = change.ToStatus
+			road.StatusChangedAt = change.ChangedAt
+		}
+	}
+	return nil
+}
+
+// fakeHistoryRepo reads the status changes recorded by a fakeReportRepo
+type fakeHistoryRepo struct {
+	reports *fakeReportRepo
+}
+
+func (r *fakeHistoryRepo) FindByReport(ctx context.Context, reportID uuid.UUID) ([]*entities.StatusChange, error) {
+	r.reports.mu.Lock()
+	defer r.reports.mu.Unlock()
+
+	var changes []*entities.StatusChange
+	for _, change := range r.reports.statusChanges {
+		if change.ReportID == reportID {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+// FindByAuthor returns the author's newest reports first, like the Postgres repository
+func (r *fakeReportRepo) FindByAuthor(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]*entities.DamagedRoad, int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var reports []*entities.DamagedRoad
+	for _, road := range r.reports {
+		if road.AuthorID == authorID {
+			reports = append(reports, road)
+		}
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].CreatedAt.After(reports[j].CreatedAt) })
+	total := len(reports)
+	if offset > len(reports) {
+		offset = len(reports)
+	}
+	reports = reports[offset:]
+	if len(reports) > limit {
+		reports = reports[:limit]
+	}
+	return reports, total, nil
+}
+
+// FindByGeometry returns the newest reports up to limit, treating every report as inside bounds
+func (r *fakeReportRepo) FindByGeometry(ctx context.Context, bounds entities.Geometry, limit int) ([]*entities.DamagedRoad, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reports := append([]*entities.DamagedRoad(nil), r.reports...)
+	sort.Slice(reports, func(i, j int) bool { return reports[i].CreatedAt.After(reports[j].CreatedAt) })
+	if limit > 0 && len(reports) > limit {
+		reports = reports[:limit]
+	}
+	return reports, nil
+}
+
+// FindWithinRadius records the radius and returns no reports
+func (r *fakeReportRepo) FindWithinRadius(ctx context.Context, center entities.Point, radiusMeters float64, limit int) ([]*entities.DamagedRoad, error) {
+	r.radiusSearches = append(r.radiusSearches, radiusMeters)
+	return nil, nil
+}
+
+// FindNearestUnresolvedID returns the first unresolved report, treating every report as within
+// the radius
+func (r *fakeReportRepo) FindNearestUnresolvedID(ctx context.Context, path entities.Geometry, radiusMeters float64) (*uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.nearbyErr != nil {
+		return nil, r.nearbyErr
+	}
+	for _, report := range r.reports {
+		if report.Status != entities.StatusResolved && report.Status != entities.StatusArchived && report.DeletedAt == nil {
+			id := report.ID
+			return &id, nil
+		}
+	}
+	return nil, nil
+}
+
+// fakePhotoValidator accepts every URL except those listed in invalid, and attaches the
+// warnings listed in warnings
+type fakePhotoValidator struct {
+	external.PhotoValidator
+	invalid    map[string]string
+	warnings   map[string]string
+	notChecked map[string]bool
+}
+
+func (v *fakePhotoValidator) ValidateURLs(ctx context.Context, urls []string) []external.PhotoValidationResult {
+	results := make([]external.PhotoValidationResult, len(urls))
+	for i, url := range urls {
+		results[i] = external.PhotoValidationResult{URL: url, Valid: true, Warning: v.warnings[url]}
+		if reason, ok := v.invalid[url]; ok {
+			results[i] = external.PhotoValidationResult{URL: url, Error: reason}
+		}
+		if v.notChecked[url] {
+			results[i] = external.PhotoValidationResult{URL: url, Error: "not validated: fetch cap reached", NotChecked: true}
+		}
+	}
+	return results
+}
+
+// fakeGeometryService passes every geometry check unless an error is set for it
+type fakeGeometryService struct {
+	usecases.GeometryService
+	boundaryErr    error
+	centroidErr    error
+	subDistrictErr error
+}
+
+func (g *fakeGeometryService) ValidateCoordinatesInBoundary(points []entities.Point) error {
+	return g.boundaryErr
+}
+
+func (g *fakeGeometryService) ValidateCoordinatesNearCentroid(ctx context.Context, points []entities.Point, subDistrictCode entities.SubDistrictCode, radiusMeters float64) error {
+	return g.centroidErr
+}
+
+func (g *fakeGeometryService) ValidateSubDistrictExists(ctx context.Context, subDistrictCode entities.SubDistrictCode) error {
+	return g.subDistrictErr
+}
+
+func (g *fakeGeometryService) FindDirectionReversals(points []entities.Point, maxTurnDegrees float64) []int {
+	return nil
+}
+
+// recordingEventBus collects published events without delivering them
+type recordingEventBus struct {
+	mu     sync.Mutex
+	events []entities.DomainEvent
+}
+
+func (b *recordingEventBus) Subscribe(eventName string, handler external.EventHandler) {}
+
+func (b *recordingEventBus) Publish(ctx context.Context, event entities.DomainEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.events = append(b.events, event)
+}
+
+// newReportTestService returns a report service backed by in-memory fakes holding reports and users
+func newReportTestService(config ReportServiceConfig, reports []*entities.DamagedRoad, users ...*entities.User) (*ReportServiceImpl, *fakeReportRepo, *recordingEventBus) {
+	repo := &fakeReportRepo{reports: reports}
+	userRepo := &fakeUserRepo{users: make(map[uuid.UUID]*entities.User)}
+	for _, user := range users {
+		userRepo.users[user.ID] = user
+	}
+	bus := &recordingEventBus{}
+
+	service := NewReportService(repo, &fakeHistoryRepo{reports: repo}, nil, userRepo, &fakeGeometryService{}, &fakePhotoValidator{}, bus, config).(*ReportServiceImpl)
+	return service, repo, bus
+}
+
+// testPath is a short path in Surabaya
+var testPath = []entities.Point{{Lat: -7.2575, Lng: 112.7521}, {Lat: -7.2580, Lng: 112.7530}}
+
+// newTestReport returns a valid submitted report by author, created at createdAt
+func newTestReport(t *testing.T, authorID uuid.UUID, createdAt time.Time) *entities.DamagedRoad {
+	t.Helper()
+	path, err := entities.NewGeometryFromPoints(testPath)
+	if err != nil {
+		t.Fatalf("NewGeometryFromPoints() error = %v", err)
+	}
+	road, err := entities.NewDamagedRoad("Jalan berlubang", "35.78.01.1001", *path,
+		[]string{"https://photos.example.com/1.jpg"}, authorID, nil, nil)
+	if err != nil {
+		t.Fatalf("NewDamagedRoad() error = %v", err)
+	}
+	road.CreatedAt = createdAt
+	road.UpdatedAt = createdAt
+	road.StatusChangedAt = createdAt
+	return road
+}
+
+func TestUpdateReportStatusRequiresStaff(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	citizen := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	verificator := &entities.User{ID: uuid.New(), Role: entities.RoleVerificator}
+	report := &entities.DamagedRoad{ID: uuid.New(), AuthorID: author.ID, Status: entities.StatusSubmitted}
+	service, repo, bus := newReportTestService(ReportServiceConfig{}, []*entities.DamagedRoad{report}, author, citizen, verificator)
+	ctx := context.Background()
+
+	for _, requester := range []*entities.User{citizen, author} {
+		_, err := service.UpdateReportStatus(ctx, report.ID, entities.StatusUnderVerification, "", nil, requester.ID)
+		if !stderrors.Is(err, errors.ErrUnauthorizedAccess) {
+			t.Errorf("UpdateReportStatus() by a citizen error = %v, want ErrUnauthorizedAccess", err)
+		}
+	}
+	if len(repo.statusChanges) != 0 || len(bus.events) != 0 {
+		t.Fatalf("citizen requests saved %d status changes and published %d events, want none", len(repo.statusChanges), len(bus.events))
+	}
+
+	road, err := service.UpdateReportStatus(ctx, report.ID, entities.StatusUnderVerification, "", nil, verificator.ID)
+	if err != nil {
+		t.Fatalf("UpdateReportStatus() by a verificator error = %v", err)
+	}
+	if road.Status != entities.StatusUnderVerification {
+		t.Errorf("status = %s, want %s", road.Status, entities.StatusUnderVerification)
+	}
+	if len(repo.statusChanges) != 1 || len(bus.events) != 1 {
+		t.Errorf("verificator request saved %d status changes and published %d events, want 1 each", len(repo.statusChanges), len(bus.events))
+	}
+}
+
+func TestUpdateReportKeepsStatusChangedDuringEdit(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	report := newTestReport(t, author.ID, time.Now())
+	service, repo, _ := newReportTestService(ReportServiceConfig{}, []*entities.DamagedRoad{report}, author)
+
+	// A verificator picks the report up between the author's load and save
+	repo.afterFind = func() {
+		repo.setStatus(report.ID, entities.StatusUnderVerification)
+	}
+
+	_, err := service.UpdateReport(context.Background(), report.ID, author.ID, "Judul baru", nil,
+		[]string{"https://photos.example.com/2.jpg"}, testPath)
+	if !stderrors.Is(err, errors.ErrReportNotEditable) {
+		t.Fatalf("UpdateReport() error = %v, want ErrReportNotEditable", err)
+	}
+
+	stored := repo.stored(report.ID)
+	if stored.Status != entities.StatusUnderVerification {
+		t.Errorf("status = %s, want the verificator's %s kept", stored.Status, entities.StatusUnderVerification)
+	}
+	if stored.Title != "Jalan berlubang" {
+		t.Errorf("title = %q, want the edit refused", stored.Title)
+	}
+}
+
+func TestUpdateReportPathKeepsStatusChangedDuringEdit(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	report := newTestReport(t, author.ID, time.Now())
+	service, repo, _ := newReportTestService(ReportServiceConfig{}, []*entities.DamagedRoad{report}, author)
+	repo.afterFind = func() {
+		repo.setStatus(report.ID, entities.StatusVerified)
+	}
+
+	if _, err := service.UpdateReportPath(context.Background(), report.ID, author.ID, testPath[:1]); !stderrors.Is(err, errors.ErrReportNotEditable) {
+		t.Fatalf("UpdateReportPath() error = %v, want ErrReportNotEditable", err)
+	}
+	if stored := repo.stored(report.ID); stored.Status != entities.StatusVerified || len(stored.Path.Coordinates) != len(testPath) {
+		t.Errorf("stored report = %s with %d points, want status and path untouched", stored.Status, len(stored.Path.Coordinates))
+	}
+}
+
+func TestUpdateReportSavesEditOfSubmittedReport(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	report := newTestReport(t, author.ID, time.Now())
+	service, repo, _ := newReportTestService(ReportServiceConfig{}, []*entities.DamagedRoad{report}, author)
+
+	road, err := service.UpdateReport(context.Background(), report.ID, author.ID, "Judul baru", nil,
+		[]string{"https://photos.example.com/2.jpg"}, testPath)
+	if err != nil {
+		t.Fatalf("UpdateReport() error = %v", err)
+	}
+	if road.Status != entities.StatusSubmitted {
+		t.Errorf("status = %s, want it still submitted", road.Status)
+	}
+	if stored := repo.stored(report.ID); stored.Title != "Judul baru" {
+		t.Errorf("stored title = %q, want the edit saved", stored.Title)
+	}
+}
+
+func TestUpdateReportEditGate(t *testing.T) {
+	tests := []struct {
+		name    string
+		age     time.Duration
+		status  entities.Status
+		window  time.Duration
+		wantErr error
+	}{
+		{"inside window", 10 * time.Minute, entities.StatusSubmitted, time.Hour, nil},
+		{"window expired", 2 * time.Hour, entities.StatusSubmitted, time.Hour, errors.ErrEditWindowExpired},
+		{"no window configured", 30 * 24 * time.Hour, entities.StatusSubmitted, 0, nil},
+		{"wrong status inside window", 10 * time.Minute, entities.StatusUnderVerification, time.Hour, errors.ErrReportNotEditable},
+		{"wrong status after window", 2 * time.Hour, entities.StatusVerified, time.Hour, errors.ErrReportNotEditable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+			report := newTestReport(t, author.ID, time.Now().Add(-tt.age))
+			report.Status = tt.status
+			service, repo, _ := newReportTestService(ReportServiceConfig{EditWindow: tt.window}, []*entities.DamagedRoad{report}, author)
+			ctx := context.Background()
+
+			_, err := service.UpdateReport(ctx, report.ID, author.ID, "Judul baru", nil,
+				[]string{"https://photos.example.com/2.jpg"}, testPath)
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateReport() error = %v, want %v", err, tt.wantErr)
+			}
+			_, err = service.UpdateReportPath(ctx, report.ID, author.ID, testPath[:1])
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateReportPath() error = %v, want %v", err, tt.wantErr)
+			}
+
+			stored := repo.stored(report.ID)
+			if edited := stored.Title == "Judul baru"; edited != (tt.wantErr == nil) {
+				t.Errorf("title = %q, want the edit saved only when allowed", stored.Title)
+			}
+		})
+	}
+}
+
+// createTestReport submits a report by authorID, skipping the duplicate check
+func createTestReport(service *ReportServiceImpl, authorID uuid.UUID) (*entities.DamagedRoad, error) {
+	return service.CreateReport(context.Background(), "Jalan berlubang", "35.78.01.1001", testPath,
+		[]string{"https://photos.example.com/1.jpg"}, authorID, nil, nil, true)
+}
+
+func TestCreateReportEnforcesIntervalAcrossConcurrentSubmissions(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	service, repo, _ := newReportTestService(ReportServiceConfig{MinReportInterval: time.Minute}, nil, author)
+
+	const submissions = 16
+	errs := make([]error, submissions)
+	var wg sync.WaitGroup
+	for i := 0; i < submissions; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = createTestReport(service, author.ID)
+		}(i)
+	}
+	wg.Wait()
+
+	created := 0
+	for _, err := range errs {
+		var tooSoonErr *errors.TooSoonError
+		switch {
+		case err == nil:
+			created++
+		case !stderrors.As(err, &tooSoonErr):
+			t.Errorf("CreateReport() error = %v, want nil or TooSoonError", err)
+		}
+	}
+	if created != 1 || len(repo.reports) != 1 {
+		t.Errorf("%d submissions succeeded and %d stored, want exactly 1", created, len(repo.reports))
+	}
+}
+
+func TestCreateReportFailsWhenIntervalLookupFails(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	service, repo, _ := newReportTestService(ReportServiceConfig{MinReportInterval: time.Minute}, nil, author)
+	repo.latestErr = stderrors.New("connection refused")
+
+	if _, err := createTestReport(service, author.ID); err == nil {
+		t.Fatal("CreateReport() succeeded although the interval could not be checked")
+	}
+	if len(repo.reports) != 0 {
+		t.Errorf("%d reports stored, want none", len(repo.reports))
+	}
+}
+
+func TestCreateReportIntervalExemptsAdmins(t *testing.T) {
+	admin := &entities.User{ID: uuid.New(), Role: entities.RoleAdmin}
+	service, repo, _ := newReportTestService(ReportServiceConfig{MinReportInterval: time.Minute}, nil, admin)
+
+	for i := 0; i < 2; i++ {
+		if _, err := createTestReport(service, admin.ID); err != nil {
+			t.Fatalf("CreateReport() #%d error = %v", i+1, err)
+		}
+	}
+	if len(repo.reports) != 2 {
+		t.Errorf("%d reports stored, want both admin reports", len(repo.reports))
+	}
+}
+
+func TestFindReportsInBoundingBoxFlagsTruncation(t *testing.T) {
+	box := entities.BoundingBox{MinLat: -7.35, MinLng: 112.6, MaxLat: -7.2, MaxLng: 112.8}
+	start := time.Now().Add(-time.Hour)
+	var reports []*entities.DamagedRoad
+	for i := 0; i < 5; i++ {
+		reports = append(reports, newTestReport(t, uuid.New(), start.Add(time.Duration(i)*time.Minute)))
+	}
+
+	tests := []struct {
+		name          string
+		limit         int
+		wantCount     int
+		wantTruncated bool
+	}{
+		{name: "more reports than the limit", limit: 3, wantCount: 3, wantTruncated: true},
+		{name: "exactly the limit", limit: 5, wantCount: 5, wantTruncated: false},
+		{name: "unlimited", limit: 0, wantCount: 5, wantTruncated: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _ := newReportTestService(ReportServiceConfig{MapResultLimit: tt.limit}, reports)
+
+			roads, truncated, err := service.FindReportsInBoundingBox(context.Background(), box)
+			if err != nil {
+				t.Fatalf("FindReportsInBoundingBox() error = %v", err)
+			}
+			if len(roads) != tt.wantCount || truncated != tt.wantTruncated {
+				t.Errorf("got %d reports, truncated = %v; want %d, %v", len(roads), truncated, tt.wantCount, tt.wantTruncated)
+			}
+			if roads[0].ID != reports[4].ID {
+				t.Errorf("first report = %s, want the newest", roads[0].ID)
+			}
+		})
+	}
+}
+
+func TestFindReportsInBoundingBoxRejectsInvalidBox(t *testing.T) {
+	service, _, _ := newReportTestService(ReportServiceConfig{}, nil)
+
+	for _, box := range []entities.BoundingBox{
+		{MinLat: -7.2, MinLng: 112.6, MaxLat: -7.35, MaxLng: 112.8},
+		{MinLat: 1.0, MinLng: 103.5, MaxLat: 7.5, MaxLng: 104.0},
+	} {
+		var validationErr *errors.ValidationError
+		if _, _, err := service.FindReportsInBoundingBox(context.Background(), box); !stderrors.As(err, &validationErr) {
+			t.Errorf("FindReportsInBoundingBox(%+v) error = %v, want a validation error", box, err)
+		}
+	}
+}
+
+func TestUpdateReportStatusRecordsHistory(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	verificator := &entities.User{ID: uuid.New(), Role: entities.RoleVerificator}
+	report := newTestReport(t, author.ID, time.Now().Add(-time.Hour))
+	service, _, _ := newReportTestService(ReportServiceConfig{}, []*entities.DamagedRoad{report}, author, verificator)
+
+	for _, status := range []entities.Status{entities.StatusUnderVerification, entities.StatusVerified} {
+		if _, err := service.UpdateReportStatus(context.Background(), report.ID, status, "", nil, verificator.ID); err != nil {
+			t.Fatalf("UpdateReportStatus(%s) error = %v", status, err)
+		}
+	}
+
+	history, err := service.GetStatusHistory(context.Background(), report.ID)
+	if err != nil {
+		t.Fatalf("GetStatusHistory() error = %v", err)
+	}
+	want := []struct{ from, to entities.Status }{
+		{entities.StatusSubmitted, entities.StatusUnderVerification},
+		{entities.StatusUnderVerification, entities.StatusVerified},
+	}
+	if len(history) != len(want) {
+		t.Fatalf("history has %d entries, want %d", len(history), len(want))
+	}
+	for i, change := range history {
+		if change.FromStatus != want[i].from || change.ToStatus != want[i].to {
+			t.Errorf("history[%d] = %s -> %s, want %s -> %s", i, change.FromStatus, change.ToStatus, want[i].from, want[i].to)
+		}
+		if change.ChangedBy != verificator.ID {
+			t.Errorf("history[%d] changed by %s, want the verificator", i, change.ChangedBy)
+		}
+	}
+}
+
+func TestGetStatusHistoryOfMissingReport(t *testing.T) {
+	service, _, _ := newReportTestService(ReportServiceConfig{}, nil)
+
+	if _, err := service.GetStatusHistory(context.Background(), uuid.New()); !stderrors.Is(err, errors.ErrReportNotFound) {
+		t.Errorf("GetStatusHistory() error = %v, want ErrReportNotFound", err)
+	}
+}
+
+// fakeCategoryRepo holds a fixed set of category IDs
+type fakeCategoryRepo struct {
+	external.CategoryRepository
+	ids []string
+}
+
+func (r *fakeCategoryRepo) Exists(ctx context.Context, id string) (bool, error) {
+	for _, existing := range r.ids {
+		if existing == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestCreateReportValidatesCategory(t *testing.T) {
+	pothole, sinkhole := "pothole", "sinkhole"
+	tests := []struct {
+		name            string
+		requireCategory bool
+		categoryID      *string
+		wantErr         error
+	}{
+		{name: "known category", categoryID: &pothole},
+		{name: "unknown category", categoryID: &sinkhole, wantErr: errors.ErrCategoryNotFound},
+		{name: "no category when optional"},
+		{name: "no category when required", requireCategory: true, wantErr: errors.ErrCategoryRequired},
+		{name: "known category when required", requireCategory: true, categoryID: &pothole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+			service, repo, _ := newReportTestService(ReportServiceConfig{RequireCategory: tt.requireCategory}, nil, author)
+			service.categoryRepo = &fakeCategoryRepo{ids: []string{"pothole", "cracking"}}
+
+			road, err := service.CreateReport(context.Background(), "Jalan berlubang", "35.78.01.1001", testPath,
+				[]string{"https://photos.example.com/1.jpg"}, author.ID, nil, tt.categoryID, true)
+
+			if tt.wantErr != nil {
+				var validationErr *errors.ValidationError
+				if !stderrors.Is(err, tt.wantErr) || !stderrors.As(err, &validationErr) || validationErr.Field != "category_id" {
+					t.Errorf("CreateReport() error = %v, want a category_id validation error wrapping %v", err, tt.wantErr)
+				}
+				if len(repo.reports) != 0 {
+					t.Error("report stored despite the invalid category")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateReport() error = %v", err)
+			}
+			if (road.CategoryID == nil) != (tt.categoryID == nil) || (road.CategoryID != nil && *road.CategoryID != *tt.categoryID) {
+				t.Errorf("CategoryID = %v, want %v", road.CategoryID, tt.categoryID)
+			}
+		})
+	}
+}
+
+func TestUpdateReportStatusRequiresReason(t *testing.T) {
+	verificator := &entities.User{ID: uuid.New(), Role: entities.RoleVerificator}
+	tests := []struct {
+		name     string
+		required []entities.Status
+		reason   string
+		wantErr  bool
+	}{
+		{name: "rule enabled, no reason", required: []entities.Status{entities.StatusResolved}, wantErr: true},
+		{name: "rule enabled, blank reason", required: []entities.Status{entities.StatusResolved}, reason: "   ", wantErr: true},
+		{name: "rule enabled, reason given", required: []entities.Status{entities.StatusResolved}, reason: "Ditambal oleh Dinas PU"},
+		{name: "rule for another status", required: []entities.Status{entities.StatusArchived}},
+		{name: "rule disabled"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := newTestReport(t, uuid.New(), time.Now().Add(-time.Hour))
+			report.Status = entities.StatusPendingResolved
+			service, repo, _ := newReportTestService(ReportServiceConfig{RequireReasonStatuses: tt.required}, []*entities.DamagedRoad{report}, verificator)
+
+			_, err := service.UpdateReportStatus(context.Background(), report.ID, entities.StatusResolved, tt.reason, nil, verificator.ID)
+			if tt.wantErr {
+				var validationErr *errors.ValidationError
+				if !stderrors.Is(err, errors.ErrStatusReasonRequired) || !stderrors.As(err, &validationErr) || validationErr.Field != "reason" {
+					t.Errorf("UpdateReportStatus() error = %v, want a reason validation error", err)
+				}
+				if len(repo.statusChanges) != 0 || repo.stored(report.ID).Status != entities.StatusPendingResolved {
+					t.Error("status changed despite the missing reason")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateReportStatus() error = %v", err)
+			}
+			if len(repo.statusChanges) != 1 {
+				t.Fatalf("saved %d status changes, want 1", len(repo.statusChanges))
+			}
+			if reason := repo.statusChanges[0].Reason; tt.reason != "" && (reason == nil || reason.String() != tt.reason) {
+				t.Errorf("recorded reason = %v, want %q", reason, tt.reason)
+			}
+		})
+	}
+}
+
+func TestCreateReportReturnsWarningsForSoftIssues(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	const photoURL = "https://photos.example.com/1.jpg"
+	farFromCentroid := stderrors.New("no point within 200 meters of the subdistrict centroid")
+
+	service, repo, _ := newReportTestService(ReportServiceConfig{CentroidRadiusMeters: 200}, nil, author)
+	service.photoValidator = &fakePhotoValidator{warnings: map[string]string{photoURL: "photo size could not be verified: no Content-Length"}}
+	service.geometrySvc = &fakeGeometryService{centroidErr: farFromCentroid}
+
+	road, err := createTestReport(service, author.ID)
+	if err != nil {
+		t.Fatalf("CreateReport() error = %v, want the report created despite the warnings", err)
+	}
+	if len(repo.reports) != 1 {
+		t.Fatalf("%d reports stored, want 1", len(repo.reports))
+	}
+	want := []string{
+		photoURL + ": photo size could not be verified: no Content-Length",
+		"path is more than 200 meters from the subdistrict centroid",
+	}
+	if len(road.Warnings) != len(want) {
+		t.Fatalf("warnings = %q, want %q", road.Warnings, want)
+	}
+	for i := range want {
+		if road.Warnings[i] != want[i] {
+			t.Errorf("warnings[%d] = %q, want %q", i, road.Warnings[i], want[i])
+		}
+	}
+
+	// The same centroid issue blocks the report once the check is strict
+	service.config.StrictCentroidCheck = true
+	if _, err := createTestReport(service, uuid.New()); !stderrors.Is(err, farFromCentroid) {
+		t.Errorf("strict CreateReport() error = %v, want the centroid error", err)
+	}
+}
+
+func TestCreateReportWithoutSoftIssuesHasNoWarnings(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	service, _, _ := newReportTestService(ReportServiceConfig{}, nil, author)
+
+	road, err := createTestReport(service, author.ID)
+	if err != nil {
+		t.Fatalf("CreateReport() error = %v", err)
+	}
+	if len(road.Warnings) != 0 {
+		t.Errorf("warnings = %q, want none", road.Warnings)
+	}
+}
+
+func TestCreateReportRejectsDuplicateNearUnresolvedReport(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	existing := newTestReport(t, uuid.New(), time.Now().Add(-time.Hour))
+	service, repo, _ := newReportTestService(ReportServiceConfig{DuplicateRadiusMeters: 20}, []*entities.DamagedRoad{existing}, author)
+	submit := func(force bool) (*entities.DamagedRoad, error) {
+		return service.CreateReport(context.Background(), "Jalan berlubang", "35.78.01.1001", testPath,
+			[]string{"https://photos.example.com/1.jpg"}, author.ID, nil, nil, force)
+	}
+
+	_, err := submit(false)
+	var duplicateErr *errors.DuplicateReportError
+	if !stderrors.As(err, &duplicateErr) || !stderrors.Is(err, errors.ErrDuplicateReport) {
+		t.Fatalf("CreateReport() error = %v, want a DuplicateReportError", err)
+	}
+	if duplicateErr.ExistingReportID != existing.ID || duplicateErr.RadiusMeters != 20 {
+		t.Errorf("duplicate of %s within %.0fm, want %s within 20m", duplicateErr.ExistingReportID, duplicateErr.RadiusMeters, existing.ID)
+	}
+	if len(repo.reports) != 1 {
+		t.Fatalf("%d reports stored, want the duplicate rejected", len(repo.reports))
+	}
+
+	if _, err := submit(true); err != nil {
+		t.Errorf("forced CreateReport() error = %v, want the duplicate check skipped", err)
+	}
+}
+
+func TestCreateReportDuplicateCheckDoesNotBlock(t *testing.T) {
+	resolved := newTestReport(t, uuid.New(), time.Now().Add(-time.Hour))
+	resolved.Status = entities.StatusResolved
+	tests := []struct {
+		name      string
+		radius    float64
+		reports   []*entities.DamagedRoad
+		nearbyErr error
+	}{
+		{name: "check disabled", reports: []*entities.DamagedRoad{newTestReport(t, uuid.New(), time.Now())}},
+		{name: "only a resolved report nearby", radius: 20, reports: []*entities.DamagedRoad{resolved}},
+		{name: "lookup failed", radius: 20, reports: []*entities.DamagedRoad{newTestReport(t, uuid.New(), time.Now())}, nearbyErr: stderrors.New("database unavailable")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+			service, repo, _ := newReportTestService(ReportServiceConfig{DuplicateRadiusMeters: tt.radius}, tt.reports, author)
+			repo.nearbyErr = tt.nearbyErr
+
+			if _, err := service.CreateReport(context.Background(), "Jalan berlubang", "35.78.01.1001", testPath,
+				[]string{"https://photos.example.com/1.jpg"}, author.ID, nil, nil, false); err != nil {
+				t.Errorf("CreateReport() error = %v, want the report created", err)
+			}
+		})
+	}
+}
+
+func TestGetReportDistanceToCentroid(t *testing.T) {
+	report := newTestReport(t, uuid.New(), time.Now())
+	service, _, _ := newReportTestService(ReportServiceConfig{}, []*entities.DamagedRoad{report})
+
+	// The centroid lies 0.001° east of the path's second point, about 110 meters away
+	service.geometrySvc = NewGeometryService(&fakeBoundaryRepo{centroids: map[entities.SubDistrictCode]entities.Point{
+		report.SubDistrictCode: {Lat: -7.2580, Lng: 112.7540},
+	}}, GeometryServiceConfig{})
+	road, err := service.GetReport(context.Background(), report.ID, entities.SRIDWGS84)
+	if err != nil {
+		t.Fatalf("GetReport() error = %v", err)
+	}
+	if road.DistanceToCentroidMeters == nil || math.Abs(*road.DistanceToCentroidMeters-110.30) > 0.5 {
+		t.Errorf("DistanceToCentroidMeters = %v, want about 110.3 from the closest point", road.DistanceToCentroidMeters)
+	}
+
+	// Without centroid data for the subdistrict the distance is omitted
+	service.geometrySvc = NewGeometryService(&fakeBoundaryRepo{}, GeometryServiceConfig{})
+	road, err = service.GetReport(context.Background(), report.ID, entities.SRIDWGS84)
+	if err != nil {
+		t.Fatalf("GetReport() without centroid error = %v", err)
+	}
+	if road.DistanceToCentroidMeters != nil {
+		t.Errorf("DistanceToCentroidMeters = %v, want nil without centroid data", *road.DistanceToCentroidMeters)
+	}
+}
+
+func TestFindNearbyReportsCapsRadius(t *testing.T) {
+	service, repo, _ := newReportTestService(ReportServiceConfig{}, nil)
+	center := entities.Point{Lat: -7.2575, Lng: 112.7521}
+	ctx := context.Background()
+
+	for _, radius := range []float64{0, -1, entities.MaxNearbyRadiusMeters + 1} {
+		_, err := service.FindNearbyReports(ctx, center, radius, 20)
+		var validationErr *errors.ValidationError
+		if !stderrors.As(err, &validationErr) || validationErr.Field != "radius" {
+			t.Errorf("FindNearbyReports() radius %v error = %v, want a radius validation error", radius, err)
+		}
+	}
+	if len(repo.radiusSearches) != 0 {
+		t.Fatalf("rejected radii reached the repository %d times, want none", len(repo.radiusSearches))
+	}
+
+	if _, err := service.FindNearbyReports(ctx, center, entities.MaxNearbyRadiusMeters, 20); err != nil {
+		t.Errorf("FindNearbyReports() at the cap error = %v", err)
+	}
+	if len(repo.radiusSearches) != 1 || repo.radiusSearches[0] != entities.MaxNearbyRadiusMeters {
+		t.Errorf("repository searched %v, want the capped radius once", repo.radiusSearches)
+	}
+
+	if _, err := service.FindNearbyReports(ctx, entities.Point{Lat: 35.68, Lng: 139.69}, 1000, 20); !stderrors.Is(err, errors.ErrCoordinatesOutOfBounds) {
+		t.Errorf("FindNearbyReports() outside Indonesia error = %v, want ErrCoordinatesOutOfBounds", err)
+	}
+}
+
+func TestCreateReportPublishesReportCreated(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	service, _, bus := newReportTestService(ReportServiceConfig{}, nil, author)
+
+	road, err := createTestReport(service, author.ID)
+	if err != nil {
+		t.Fatalf("CreateReport() error = %v", err)
+	}
+
+	if len(bus.events) != 1 {
+		t.Fatalf("published %d events, want 1", len(bus.events))
+	}
+	created, ok := bus.events[0].(entities.ReportCreatedEvent)
+	if !ok || created.Report.ID != road.ID {
+		t.Errorf("published %#v, want a ReportCreatedEvent for the new report", bus.events[0])
+	}
+}
+
+func TestCreateReportSubDistrictModes(t *testing.T) {
+	notFound := fmt.Errorf("%w: 35.78.99.9999", errors.ErrSubDistrictNotFound)
+	lookupFailed := stderrors.New("connection refused")
+
+	tests := []struct {
+		name      string
+		require   bool
+		lookupErr error
+		wantErr   error
+	}{
+		{"lenient accepts unknown code", false, notFound, nil},
+		{"strict rejects unknown code", true, notFound, errors.ErrSubDistrictNotFound},
+		{"strict accepts known code", true, nil, nil},
+		{"strict does not block on lookup failure", true, lookupFailed, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+			service, repo, _ := newReportTestService(ReportServiceConfig{RequireKnownSubDistrict: tt.require}, nil, author)
+			service.geometrySvc = &fakeGeometryService{subDistrictErr: tt.lookupErr}
+
+			_, err := createTestReport(service, author.ID)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("CreateReport() error = %v, want the report accepted", err)
+			}
+			if tt.wantErr != nil && !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateReport() error = %v, want %v", err, tt.wantErr)
+			}
+			if stored := len(repo.reports) == 1; stored != (tt.wantErr == nil) {
+				t.Errorf("%d reports stored, want the report stored only when accepted", len(repo.reports))
+			}
+		})
+	}
+}
+
+func TestCreateReportCollapsesRepeatedPhotoURLs(t *testing.T) {
+	const (
+		valid  = "https://photos.example.com/1.jpg"
+		broken = "https://photos.example.com/broken.jpg"
+	)
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	service, repo, _ := newReportTestService(ReportServiceConfig{LenientPhotoValidation: true}, nil, author)
+	service.photoValidator = &fakePhotoValidator{invalid: map[string]string{broken: "HTTP status 404"}}
+
+	_, err := service.CreateReport(context.Background(), "Jalan berlubang", "35.78.01.1001", testPath,
+		[]string{valid, broken, valid, broken}, author.ID, nil, nil, true)
+	if err != nil {
+		t.Fatalf("CreateReport() error = %v", err)
+	}
+
+	stored := repo.reports[0]
+	if len(stored.PhotoURLs) != 1 || stored.PhotoURLs[0] != valid {
+		t.Errorf("photo URLs = %v, want %s once", stored.PhotoURLs, valid)
+	}
+	if len(stored.DroppedPhotos) != 1 || stored.DroppedPhotos[0].URL != broken {
+		t.Errorf("dropped photos = %v, want %s once", stored.DroppedPhotos, broken)
+	}
+}
+
+func TestCreateReportLenientRejectsPhotosPastTheFetchCap(t *testing.T) {
+	const (
+		valid     = "https://photos.example.com/1.jpg"
+		broken    = "https://photos.example.com/broken.jpg"
+		unchecked = "https://photos.example.com/11.jpg"
+	)
+	author := &entities.User{ID: uuid.New(), Role: entities.RoleUser}
+	service, repo, _ := newReportTestService(ReportServiceConfig{LenientPhotoValidation: true}, nil, author)
+	service.photoValidator = &fakePhotoValidator{
+		invalid:    map[string]string{broken: "HTTP status 404"},
+		notChecked: map[string]bool{unchecked: true},
+	}
+
+	_, err := service.CreateReport(context.Background(), "Jalan berlubang", "35.78.01.1001", testPath,
+		[]string{valid, broken, unchecked}, author.ID, nil, nil, true)
+	if !stderrors.Is(err, errors.ErrInvalidPhotoURLs) || !strings.Contains(err.Error(), unchecked) {
+		t.Fatalf("CreateReport() error = %v, want the unchecked URL reported as ErrInvalidPhotoURLs", err)
+	}
+	if len(repo.reports) != 0 {
+		t.Errorf("%d reports stored, want the report rejected rather than the photo dropped", len(repo.reports))
+	}
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// ReportStatusNotifier emails a report's author when its status changes, unless the author
+// made the change or opted out in their preferences. Emails are sent in the background so a
+// slow SMTP server never holds up the status change request
+type ReportStatusNotifier struct {
+	userRepo           external.UserRepository
+	preferencesService usecases.UserPreferencesService
+	emailService       external.EmailService
+	inFlight           sync.WaitGroup
+}
+
+// NewReportStatusNotifier creates a new ReportStatusNotifier
+func NewReportStatusNotifier(
+	userRepo external.UserRepository,
+	preferencesService usecases.UserPreferencesService,
+	emailService external.EmailService,
+) *ReportStatusNotifier {
+	return &ReportStatusNotifier{
+		userRepo:           userRepo,
+		preferencesService: preferencesService,
+		emailService:       emailService,
+	}
+}
+
+// Subscribe registers the notifier for status change events
+func (n *ReportStatusNotifier) Subscribe(bus external.EventBus) {
+	bus.Subscribe(entities.EventReportStatusChanged, n.HandleStatusChanged)
+}
+
+// Wait blocks until every email started by HandleStatusChanged has been sent or has failed
+func (n *ReportStatusNotifier) Wait() {
+	n.inFlight.Wait()
+}
+
+// HandleStatusChanged starts sending the status change email for a ReportStatusChangedEvent.
+// The email outlives the request that published the event; failures are logged
+func (n *ReportStatusNotifier) HandleStatusChanged(ctx context.Context, event entities.DomainEvent) error {
+	changed, ok := event.(entities.ReportStatusChangedEvent)
+	if !ok {
+		return nil
+	}
+
+	// Authors don't need to be told about their own changes
+	if changed.Change.ChangedBy == changed.Report.AuthorID {
+		return nil
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	n.inFlight.Add(1)
+	go func() {
+		defer n.inFlight.Done()
+		if err := n.notify(ctx, changed); err != nil {
+			logger.ErrorContext(ctx, "Failed to send status change email", map[string]interface{}{
+				"report_id": changed.Report.ID.String(),
+				"error":     err.Error(),
+			})
+		}
+	}()
+	return nil
+}
+
+// notify emails the author of changed.Report unless they opted out
+func (n *ReportStatusNotifier) notify(ctx context.Context, changed entities.ReportStatusChangedEvent) error {
+	report := changed.Report
+	preferences, err := n.preferencesService.GetPreferences(ctx, report.AuthorID)
+	if err != nil {
+		return err
+	}
+	if !preferences.EmailOnStatusChange {
+		logger.DebugContext(ctx, "Author opted out of status change emails", map[string]interface{}{
+			"report_id": report.ID.String(),
+			"author_id": report.AuthorID.String(),
+		})
+		return nil
+	}
+
+	author, err := n.userRepo.FindByID(ctx, report.AuthorID)
+	if err != nil {
+		return fmt.Errorf("failed to find report author: %w", err)
+	}
+	if author == nil {
+		return nil
+	}
+
+	return n.emailService.SendReportStatusChangedEmail(
+		ctx,
+		author.Email,
+		author.Name,
+		report.Title.String(),
+		changed.Change.ToStatus.String(),
+		report.ID.String(),
+	)
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+)
+
+// fakePreferencesService serves the same preferences to every user
+type fakePreferencesService struct {
+	usecases.UserPreferencesService
+	preferences entities.UserPreferences
+}
+
+func (s *fakePreferencesService) GetPreferences(ctx context.Context, userID uuid.UUID) (*entities.UserPreferences, error) {
+	preferences := s.preferences
+	return &preferences, nil
+}
+
+// recordingEmailService records status change emails. When release is set, sending blocks
+// until it is closed
+type recordingEmailService struct {
+	external.EmailService
+	release chan struct{}
+	mu      sync.Mutex
+	sent    []string
+	ctxErrs []error
+}
+
+func (s *recordingEmailService) SendReportStatusChangedEmail(ctx context.Context, to, name, reportTitle, status, reportID string) error {
+	if s.release != nil {
+		<-s.release
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, to)
+	s.ctxErrs = append(s.ctxErrs, ctx.Err())
+	return nil
+}
+
+// newStatusChangedEvent returns a verificator's status change on a report by author
+func newStatusChangedEvent(author *entities.User) entities.ReportStatusChangedEvent {
+	report := &entities.DamagedRoad{ID: uuid.New(), AuthorID: author.ID, Title: "Jalan berlubang"}
+	change := &entities.StatusChange{
+		FromStatus: entities.StatusSubmitted,
+		ToStatus:   entities.StatusVerified,
+		ChangedBy:  uuid.New(),
+	}
+	return entities.NewReportStatusChangedEvent(report, change)
+}
+
+func TestHandleStatusChangedHonorsOptOut(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Email: "warga@example.com", Name: "Warga"}
+	tests := []struct {
+		name     string
+		optedIn  bool
+		wantSent int
+	}{
+		{name: "opted in", optedIn: true, wantSent: 1},
+		{name: "opted out", optedIn: false, wantSent: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emails := &recordingEmailService{}
+			notifier := NewReportStatusNotifier(
+				&fakeUserRepo{users: map[uuid.UUID]*entities.User{author.ID: author}},
+				&fakePreferencesService{preferences: entities.UserPreferences{EmailOnStatusChange: tt.optedIn}},
+				emails,
+			)
+
+			if err := notifier.HandleStatusChanged(context.Background(), newStatusChangedEvent(author)); err != nil {
+				t.Fatalf("HandleStatusChanged() error = %v", err)
+			}
+			notifier.Wait()
+
+			if len(emails.sent) != tt.wantSent {
+				t.Errorf("%d emails sent, want %d", len(emails.sent), tt.wantSent)
+			}
+		})
+	}
+}
+
+func TestHandleStatusChangedSendsAfterRequestEnds(t *testing.T) {
+	author := &entities.User{ID: uuid.New(), Email: "warga@example.com", Name: "Warga"}
+	emails := &recordingEmailService{release: make(chan struct{})}
+	notifier := NewReportStatusNotifier(
+		&fakeUserRepo{users: map[uuid.UUID]*entities.User{author.ID: author}},
+		&fakePreferencesService{preferences: entities.UserPreferences{EmailOnStatusChange: true}},
+		emails,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	returned := make(chan error, 1)
+	go func() {
+		returned <- notifier.HandleStatusChanged(ctx, newStatusChangedEvent(author))
+	}()
+
+	// The SMTP send is still blocked, so the handler must not be waiting on it
+	select {
+	case err := <-returned:
+		if err != nil {
+			t.Fatalf("HandleStatusChanged() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("HandleStatusChanged() waited for the email to be sent")
+	}
+
+	// The request finishes before the email goes out
+	cancel()
+	close(emails.release)
+	notifier.Wait()
+
+	if len(emails.sent) != 1 {
+		t.Fatalf("%d emails sent, want 1", len(emails.sent))
+	}
+	if emails.ctxErrs[0] != nil {
+		t.Errorf("email sent with a cancelled context (%v), want it detached from the request", emails.ctxErrs[0])
+	}
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nicklaros/jalanrusak-be/core/domain/entities"
+	"github.com/nicklaros/jalanrusak-be/core/ports/external"
+	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
+)
+
+// UserPreferencesServiceImpl implements the UserPreferencesService use case
+type UserPreferencesServiceImpl struct {
+	preferencesRepo external.UserPreferencesRepository
+}
+
+// NewUserPreferencesService creates a new UserPreferencesService implementation
+func NewUserPreferencesService(preferencesRepo external.UserPreferencesRepository) usecases.UserPreferencesService {
+	return &UserPreferencesServiceImpl{preferencesRepo: preferencesRepo}
+}
+
+// GetPreferences retrieves a user's preferences, falling back to the defaults
+func (s *UserPreferencesServiceImpl) GetPreferences(ctx context.Context, userID uuid.UUID) (*entities.UserPreferences, error) {
+	preferences, err := s.preferencesRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get user preferences", map[string]interface{}{
+			"user_id": userID.String(),
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to get preferences: %w", err)
+	}
+	if preferences == nil {
+		return entities.DefaultUserPreferences(userID), nil
+	}
+	return preferences, nil
+}
+
+// UpdatePreferences applies a partial update and returns the resulting preferences
+func (s *UserPreferencesServiceImpl) UpdatePreferences(
+	ctx context.Context,
+	userID uuid.UUID,
+	update entities.UserPreferencesUpdate,
+) (*entities.UserPreferences, error) {
+	preferences, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	update.Apply(preferences)
+	if err := s.preferencesRepo.Upsert(ctx, preferences); err != nil {
+		logger.ErrorContext(ctx, "Failed to update user preferences", map[string]interface{}{
+			"user_id": userID.String(),
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to update preferences: %w", err)
+	}
+
+	logger.InfoContext(ctx, "Updated user preferences", map[string]interface{}{
+		"user_id":                userID.String(),
+		"email_on_status_change": preferences.EmailOnStatusChange,
+	})
+	return preferences, nil
+}
@@ -9,12 +9,14 @@ import (
 	"github.com/nicklaros/jalanrusak-be/core/domain/errors"
 	"github.com/nicklaros/jalanrusak-be/core/ports/external"
 	"github.com/nicklaros/jalanrusak-be/core/ports/usecases"
+	"github.com/nicklaros/jalanrusak-be/pkg/logger"
 )
 
 // UserServiceImpl implements the UserService use case
 type UserServiceImpl struct {
 	userRepo       external.UserRepository
 	passwordHasher external.PasswordHasher
+	emailService   external.EmailService
 	eventLogRepo   external.AuthEventLogRepository
 }
 
@@ -22,11 +24,13 @@ type UserServiceImpl struct {
 func NewUserService(
 	userRepo external.UserRepository,
 	passwordHasher external.PasswordHasher,
+	emailService external.EmailService,
 	eventLogRepo external.AuthEventLogRepository,
 ) usecases.UserService {
 	return &UserServiceImpl{
 		userRepo:       userRepo,
 		passwordHasher: passwordHasher,
+		emailService:   emailService,
 		eventLogRepo:   eventLogRepo,
 	}
 }
@@ -81,6 +85,14 @@ func (s *UserServiceImpl) Register(ctx context.Context, name, email, password, i
 	// Log successful registration
 	s.logAuthEvent(ctx, &user.ID, entities.EventTypeRegistration, ipAddress, userAgent, true)
 
+	// Send welcome email; the account exists either way, so a failure doesn't fail registration
+	if err := s.emailService.SendWelcomeEmail(ctx, user.Email, user.Name); err != nil {
+		logger.WarnContext(ctx, "Failed to send welcome email", map[string]interface{}{
+			"user_id": user.ID.String(),
+			"error":   err.Error(),
+		})
+	}
+
 	return user, nil
 }
 
@@ -15,6 +15,86 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/admin/flags": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Return the feature flag values the server was started with. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Show feature flags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.FeatureFlagsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/maintenance/cleanup-tokens": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Synchronously delete expired refresh and password reset tokens and return how many were removed. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Delete expired tokens now",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CleanupTokensResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/validate-location": {
             "post": {
                 "security": [
@@ -113,7 +193,7 @@ const docTemplate = `{
         },
         "/auth/login": {
             "post": {
-                "description": "Login with email and password to receive access and refresh tokens.",
+                "description": "Login with email and password to receive access and refresh tokens.\nWhen cookie auth is enabled the tokens are also set as HttpOnly cookies.\nuser.role is a snapshot taken at login; use GET /users/me for the current role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -133,6 +213,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.LoginRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client device identifier used for device-scoped session revocation",
+                        "name": "X-Device-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -170,7 +256,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Revoke the active session and optional refresh token.",
+                "description": "Revoke the access token used for this request and the optional refresh token.\nWithout a refresh token every session of the user is revoked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -216,6 +302,46 @@ const docTemplate = `{
                 }
             }
         },
+        "/auth/logout-all": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke every refresh token of the authenticated user and the access token used for this request.\nAccess tokens held by other sessions remain valid until they expire.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Logout from all sessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/auth/password/change": {
             "post": {
                 "security": [
@@ -379,7 +505,7 @@ const docTemplate = `{
         },
         "/auth/refresh": {
             "post": {
-                "description": "Exchange a valid refresh token for a new access token.",
+                "description": "Exchange a valid refresh token for a new access token and a new refresh token. The presented refresh token is revoked.\nWhen cookie auth is enabled the refresh token may be sent as a cookie instead of in the body, and the new tokens are set as cookies.",
                 "consumes": [
                     "application/json"
                 ],
@@ -481,55 +607,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/damaged-roads": {
+        "/auth/sessions": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get paginated list of damaged road reports with optional filters",
+                "description": "List the authenticated user's active sessions (unexpired, non-revoked refresh tokens), newest first.\nThe client address and user agent are only known for sessions started after they began to be recorded.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Damaged Roads"
-                ],
-                "summary": "List damaged road reports",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 100,
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by status",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by subdistrict code",
-                        "name": "subdistrict_code",
-                        "in": "query"
-                    }
+                    "Auth"
                 ],
+                "summary": "List active sessions",
                 "responses": {
                     "200": {
-                        "description": "List of reports",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.DamagedRoadListResponse"
+                            "$ref": "#/definitions/dto.SessionListResponse"
                         }
                     },
                     "401": {
@@ -539,62 +636,65 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/auth/sessions/device/{deviceId}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Logged-in users can submit a new damaged road report with title, location coordinates, photos, and optional description",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Revoke every refresh token the authenticated user holds on the given device.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Damaged Roads"
+                    "Auth"
                 ],
-                "summary": "Create a new damaged road report",
+                "summary": "Revoke all sessions on a device",
                 "parameters": [
                     {
-                        "description": "Create damaged road request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateDamagedRoadRequest"
-                        }
+                        "type": "string",
+                        "description": "Device identifier sent as X-Device-ID at login",
+                        "name": "deviceId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Report created successfully",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.DamagedRoadResponse"
+                            "$ref": "#/definitions/dto.RevokeDeviceSessionsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation errors",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - authentication required",
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -602,36 +702,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/damaged-roads/{id}": {
-            "get": {
+        "/auth/sessions/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve detailed information about a specific damaged road report",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Revoke one of the authenticated user's active sessions by the ID returned from GET /auth/sessions.\nAccess tokens already issued to that session stay valid until they expire.",
                 "tags": [
-                    "Damaged Roads"
+                    "Auth"
                 ],
-                "summary": "Get a specific damaged road report",
+                "summary": "Revoke a session",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Report ID",
+                        "description": "Session ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Report details",
+                    "204": {
+                        "description": "Session revoked"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.DamagedRoadResponse"
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
@@ -641,13 +740,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Report not found",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -655,54 +754,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/damaged-roads/{id}/status": {
-            "patch": {
+        "/categories": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update the status of a damaged road report (for administrators/verificators)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List the categories reports can be classified by, in display order. Use the id as category_id when creating a report",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Damaged Roads"
                 ],
-                "summary": "Update report status",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Report ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update status request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateStatusRequest"
-                        }
-                    }
-                ],
+                "summary": "List report categories",
                 "responses": {
                     "200": {
-                        "description": "Status updated successfully",
-                        "schema": {
-                            "$ref": "#/definitions/dto.DamagedRoadResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid status transition",
+                        "description": "Categories",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.CategoryListResponse"
                         }
                     },
                     "401": {
@@ -711,18 +782,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Report not found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -732,34 +791,1638 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
+        "/damaged-roads": {
             "get": {
-                "description": "Returns the health status of the application and its dependencies",
-                "produces": [
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get paginated list of damaged road reports with optional filters",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "List damaged road reports",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque pagination.next_cursor of the previous page; replaces page and requires sorting by created_at",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by subdistrict code",
+                        "name": "subdistrict_code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by category id (see GET /categories)",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 100,
+                        "type": "string",
+                        "description": "Case-insensitive text to find in the title or description",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by whether the report exceeded the SLA of its current status",
+                        "name": "sla_breached",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Only reports created at or after this time (RFC3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Only reports created at or before this time (RFC3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "created_at:desc",
+                        "description": "Sort field (created_at, updated_at, title) with optional direction, e.g. title:asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 4326,
+                        "description": "CRS of the returned paths, e.g. 3857 for Web Mercator; must be allowlisted",
+                        "name": "srid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return pagination.unfiltered_total, the report count ignoring filters",
+                        "name": "include_unfiltered_total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Admins only: also list soft-deleted reports, marked with deleted_at",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Admins only: stream every matching report as a bare JSON array of dto.DamagedRoadResponse, ignoring pagination",
+                        "name": "stream",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of reports",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DamagedRoadListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid sla_breached, date range, sort, srid or cursor",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Streaming or deleted reports requested without a privileged role",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Logged-in users can submit a new damaged road report with title, location coordinates, photos, and optional description",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "Create a new damaged road report",
+                "parameters": [
+                    {
+                        "description": "Create damaged road request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateDamagedRoadRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Send return=minimal to receive only the Location header and an empty body",
+                        "name": "Prefer",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Report created successfully (empty body with return=minimal)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DamagedRoadResponse"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created report"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation errors",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "An unresolved report already exists nearby; resubmit with force to create anyway",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "details": {
+                                            "$ref": "#/definitions/dto.DuplicateReportDetails"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "429": {
+                        "description": "Too soon since the previous report (see Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/damaged-roads/events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Server-sent events (text/event-stream) for live dashboards: one status_changed event per report\nstatus change, with the status change ID as the event ID. Citizens only receive changes to their\nown reports; verificators and admins receive every change. Idle streams receive a comment line\nevery 30 seconds.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "Stream report status changes",
+                "responses": {
+                    "200": {
+                        "description": "Stream of status_changed events carrying this data",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReportStatusEventResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/damaged-roads/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stream every report matching the filters as a GeoJSON FeatureCollection, one Feature per report\nwith the path as its geometry. Accepts the same filters as the report list, without pagination.\nWith format=csv the reports are downloaded as damaged-roads.csv instead, one row per report with the\ncolumns id, title, subdistrict_code, status, author_id, created_at, first_lat, first_lng, photo_count;\nfirst_lat and first_lng are always WGS84. Text cells that a spreadsheet would read as a formula are prefixed with '.\nIf the export fails after the download has begun, the connection is dropped so a partial file is never mistaken for a complete one.",
+                "produces": [
+                    "application/geo+json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "Export damaged road reports as GeoJSON or CSV",
+                "parameters": [
+                    {
+                        "enum": [
+                            "geojson",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "geojson",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by subdistrict code",
+                        "name": "subdistrict_code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by category id (see GET /categories)",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 100,
+                        "type": "string",
+                        "description": "Case-insensitive text to find in the title or description",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by whether the report exceeded the SLA of its current status",
+                        "name": "sla_breached",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Only reports created at or after this time (RFC3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Only reports created at or before this time (RFC3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "created_at:desc",
+                        "description": "Sort field (created_at, updated_at, title) with optional direction, e.g. title:asc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 4326,
+                        "description": "CRS of the returned paths, e.g. 3857 for Web Mercator; must be allowlisted",
+                        "name": "srid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Admins only: also export soft-deleted reports",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "GeoJSON FeatureCollection",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GeoJSONFeatureCollection"
+                        }
+                    },
+                    "400": {
+                        "description": "Unsupported format or invalid filters",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Deleted reports requested without a privileged role",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many exports",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/damaged-roads/map": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the reports whose path intersects the bounding box, newest first. Results are capped; truncated is true when more reports match",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "List damaged road reports inside a map viewport",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Southern edge latitude",
+                        "name": "min_lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Western edge longitude",
+                        "name": "min_lng",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Northern edge latitude",
+                        "name": "max_lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Eastern edge longitude",
+                        "name": "max_lng",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reports in the viewport",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DamagedRoadMapResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid or out of bounds bounding box",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/damaged-roads/nearby": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get reports whose path comes within the radius of a point, closest first, with the distance to each",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "List damaged road reports near a point",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude of the search center",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude of the search center",
+                        "name": "lng",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 50000,
+                        "type": "number",
+                        "default": 1000,
+                        "description": "Search radius in meters",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of reports",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reports ordered by distance",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NearbyDamagedRoadsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid coordinates or radius",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/damaged-roads/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve detailed information about a specific damaged road report, including the distance from\nits path to the subdistrict centroid when the centroid is known",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "Get a specific damaged road report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 4326,
+                        "description": "CRS of the returned path, e.g. 3857 for Web Mercator; must be allowlisted",
+                        "name": "srid",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report details",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DamagedRoadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid report ID or unsupported srid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the title, description, photos and path of a report. Only the author may edit,\nand only while the report is still submitted and within the edit window after creation. Photos and path are validated as on create.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "Edit a damaged road report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update damaged road request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateDamagedRoadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DamagedRoadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation errors",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Report is no longer editable or its edit window has passed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a damaged road report. Only the report's author may delete it. The report is soft-deleted: it disappears from every listing but is kept for auditing.",
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "Delete a damaged road report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Report deleted"
+                    },
+                    "400": {
+                        "description": "Invalid report ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/damaged-roads/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get every status transition of a report, oldest first, with who made it and when",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "Get the status history of a report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status timeline",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StatusHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid report ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/damaged-roads/{id}/notes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the notes left on a report, oldest first. Internal notes are only included for verificators and admins",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "List the notes of a report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notes",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReportNoteListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid report ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Verificators and admins can leave working notes on a report. Notes are internal (hidden from the public and the reporter) unless internal is set to false",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "Add a note to a report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Note",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateReportNoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Note added",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReportNoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not a verificator or admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/damaged-roads/{id}/path": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace only the path of a report, leaving title, description and photos untouched.\nOnly the author may edit, and only while the report is still submitted and within the edit window after creation. The path is validated as on create.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "Correct the path of a damaged road report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update path request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateDamagedRoadPathRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Path updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DamagedRoadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation errors",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Report is no longer editable or its edit window has passed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/damaged-roads/{id}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the status of a damaged road report. Only verificators and admins may change a status; other users get 403.\nProof-of-repair photos may be attached in resolution_photo_urls when resolving, and are required when the server enforces it.\nA reason is recorded in the status history and is required for the statuses the server is configured to enforce it for.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "Update report status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update status request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DamagedRoadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid status transition or missing reason",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Returns the health status of the application and its dependencies",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "Service is healthy",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service is unhealthy",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/damaged-roads": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the authenticated user's own reports, newest first, for a \"my reports\" screen",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Damaged Roads"
+                ],
+                "summary": "List my damaged road reports",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The user's reports",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DamagedRoadListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/subdistricts/exists": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Bulk existence check against the boundary dataset, e.g. to initialize a subdistrict dropdown. Codes with an invalid format are reported as missing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "validation"
+                ],
+                "summary": "Check which subdistrict codes exist",
+                "parameters": [
+                    {
+                        "description": "Codes to check",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SubDistrictsExistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Existence per code",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SubDistrictsExistResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the authenticated user's profile. The role is always read from the database, so a role\nchanged after login shows here immediately, while the role claim of already issued access tokens only changes on refresh",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "Users"
                 ],
-                "summary": "Health check",
+                "summary": "Get my profile",
                 "responses": {
                     "200": {
-                        "description": "Service is healthy",
+                        "description": "User profile",
                         "schema": {
-                            "$ref": "#/definitions/handlers.HealthResponse"
+                            "$ref": "#/definitions/dto.UserInfo"
                         }
                     },
-                    "503": {
-                        "description": "Service is unhealthy",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/handlers.HealthResponse"
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/activity": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the authenticated user's auth events and report submissions merged into a single timeline, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get my activity timeline",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Activity timeline",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ActivityListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Page ends beyond the first 1000 items",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download a JSON bundle of the authenticated user's profile, reports and auth event history for data portability.\nThe bundle is streamed; a truncated document (missing the closing brace) means the export failed midway.\nLimited to a few requests per hour per user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Export my data",
+                "responses": {
+                    "200": {
+                        "description": "User data export",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserExportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many export requests",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/preferences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the authenticated user's notification preferences. Notifications are opt-out, so users who never changed them get the defaults",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get my notification preferences",
+                "responses": {
+                    "200": {
+                        "description": "Notification preferences",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserPreferencesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the authenticated user's notification preferences. Omitted fields keep their current value.\nemail_on_status_change controls the email sent when someone else changes the status of one of the user's reports",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update my notification preferences",
+                "parameters": [
+                    {
+                        "description": "Preferences to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserPreferencesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated notification preferences",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserPreferencesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
+        }
+    },
+    "definitions": {
+        "dto.ActivityItemResponse": {
+            "type": "object",
+            "properties": {
+                "auth_event": {
+                    "$ref": "#/definitions/dto.AuthEventDTO"
+                },
+                "occurred_at": {
+                    "type": "string",
+                    "example": "2025-10-20T10:00:00Z"
+                },
+                "report": {
+                    "$ref": "#/definitions/dto.DamagedRoadResponse"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "auth_event",
+                        "report"
+                    ],
+                    "example": "report"
+                }
+            }
+        },
+        "dto.ActivityListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ActivityItemResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/dto.ActivityPaginationMeta"
+                }
+            }
+        },
+        "dto.ActivityPaginationMeta": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.AuthEventDTO": {
+            "type": "object",
+            "properties": {
+                "event_type": {
+                    "type": "string",
+                    "example": "login"
+                },
+                "ip_address": {
+                    "type": "string",
+                    "example": "203.0.113.10"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0"
+                }
+            }
+        },
+        "dto.AuthEventExportDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-10-20T10:00:00Z"
+                },
+                "event_type": {
+                    "type": "string",
+                    "example": "login"
+                },
+                "ip_address": {
+                    "type": "string",
+                    "example": "203.0.113.10"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0"
+                }
+            }
+        },
+        "dto.CategoryListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryResponse"
+                    }
+                }
+            }
+        },
+        "dto.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Holes in the road surface"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "pothole"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Pothole"
+                }
+            }
+        },
+        "dto.CleanupTokensResponse": {
+            "type": "object",
+            "properties": {
+                "password_reset_tokens_removed": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "refresh_tokens_removed": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
         "dto.CreateDamagedRoadRequest": {
             "type": "object",
             "required": [
@@ -769,12 +2432,24 @@ const docTemplate = `{
                 "title"
             ],
             "properties": {
+                "category_id": {
+                    "description": "CategoryID is an id from GET /categories; required when the server enforces categories",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "pothole"
+                },
                 "description": {
                     "type": "string",
                     "maxLength": 500,
                     "example": "Jalan berlubang sepanjang 50 meter"
                 },
+                "force": {
+                    "description": "Force creates the report even when an unresolved report already exists nearby",
+                    "type": "boolean",
+                    "example": false
+                },
                 "path_points": {
+                    "description": "PathPoints is stored as a Point when it holds a single location and as a LineString otherwise",
                     "type": "array",
                     "maxItems": 100,
                     "minItems": 1,
@@ -784,66 +2459,287 @@ const docTemplate = `{
                 },
                 "photo_urls": {
                     "type": "array",
-                    "maxItems": 10,
-                    "minItems": 1,
                     "items": {
                         "type": "string"
                     }
                 },
-                "subdistrict_code": {
-                    "type": "string",
-                    "example": "35.10.02.2005"
+                "subdistrict_code": {
+                    "type": "string",
+                    "example": "35.10.02.2005"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Jalan berlubang di depan SDN 01"
+                }
+            }
+        },
+        "dto.CreateReportNoteRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Checked with the district office, repair is scheduled"
+                },
+                "internal": {
+                    "description": "Internal notes are only visible to verificators and admins. Defaults to true",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.DamagedRoadListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DamagedRoadResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/dto.PaginationMeta"
+                }
+            }
+        },
+        "dto.DamagedRoadMapResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DamagedRoadResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "truncated": {
+                    "description": "Truncated is true when the viewport holds more reports than the limit; zoom in to see all",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "dto.DamagedRoadResponse": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "description": "Author is null when the author's account no longer exists",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.ReportAuthorDTO"
+                        }
+                    ]
+                },
+                "author_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "category_id": {
+                    "type": "string",
+                    "example": "pothole"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-10-20T10:00:00Z"
+                },
+                "deleted_at": {
+                    "description": "DeletedAt is only present on soft-deleted reports, which admins can list with include_deleted",
+                    "type": "string",
+                    "example": "2025-10-21T08:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Jalan berlubang sepanjang 50 meter"
+                },
+                "distance_meters": {
+                    "description": "DistanceMeters is the distance from the search center, returned by nearby searches",
+                    "type": "number",
+                    "example": 120.4
+                },
+                "distance_to_centroid_meters": {
+                    "description": "DistanceToCentroidMeters is returned on report details when the subdistrict has centroid data;\na large value hints at a mis-tagged location",
+                    "type": "number",
+                    "example": 85.3
+                },
+                "dropped_photos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DroppedPhotoDTO"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "length_meters": {
+                    "description": "0 for single-point reports",
+                    "type": "number",
+                    "example": 52.37
+                },
+                "nearest_report_distance_meters": {
+                    "description": "NearestReportDistanceMeters is advisory, returned on create when an unresolved report is nearby",
+                    "type": "number",
+                    "example": 42.5
+                },
+                "path": {
+                    "$ref": "#/definitions/dto.GeometryDTO"
+                },
+                "photo_urls": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resolution_photo_urls": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sla_breached": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "status": {
+                    "type": "string",
+                    "example": "submitted"
+                },
+                "status_changed_at": {
+                    "type": "string",
+                    "example": "2025-10-20T10:00:00Z"
+                },
+                "subdistrict_code": {
+                    "type": "string",
+                    "example": "35.10.02.2005"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Jalan berlubang di depan SDN 01"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-10-20T10:00:00Z"
+                },
+                "warnings": {
+                    "description": "Warnings are non-fatal concerns about a created or edited report, for the client to surface",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "path is more than 200 meters from the subdistrict centroid"
+                    ]
+                }
+            }
+        },
+        "dto.DroppedPhotoDTO": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "HTTP 404: URL not accessible"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/broken.jpg"
+                }
+            }
+        },
+        "dto.DuplicateReportDetails": {
+            "type": "object",
+            "properties": {
+                "existing_report": {
+                    "description": "ExistingReport is omitted if the existing report could not be loaded",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.DamagedRoadResponse"
+                        }
+                    ]
+                },
+                "existing_report_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "dto.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "details": {},
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.FeatureFlagsResponse": {
+            "type": "object",
+            "properties": {
+                "lenient_photo_validation": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "public_read_mode": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "response_envelope": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "strict_centroid_check": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "dto.GeoJSONFeature": {
+            "type": "object",
+            "properties": {
+                "geometry": {
+                    "$ref": "#/definitions/dto.GeometryDTO"
+                },
+                "properties": {
+                    "$ref": "#/definitions/dto.GeoJSONFeatureProperties"
                 },
-                "title": {
+                "type": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3,
-                    "example": "Jalan berlubang di depan SDN 01"
+                    "example": "Feature"
                 }
             }
         },
-        "dto.DamagedRoadListResponse": {
+        "dto.GeoJSONFeatureCollection": {
             "type": "object",
             "properties": {
-                "data": {
+                "features": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.DamagedRoadResponse"
+                        "$ref": "#/definitions/dto.GeoJSONFeature"
                     }
                 },
-                "pagination": {
-                    "$ref": "#/definitions/dto.PaginationMeta"
+                "type": {
+                    "type": "string",
+                    "example": "FeatureCollection"
                 }
             }
         },
-        "dto.DamagedRoadResponse": {
+        "dto.GeoJSONFeatureProperties": {
             "type": "object",
             "properties": {
-                "author_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
-                },
-                "created_at": {
-                    "type": "string",
-                    "example": "2025-10-20T10:00:00Z"
-                },
-                "description": {
-                    "type": "string",
-                    "example": "Jalan berlubang sepanjang 50 meter"
-                },
                 "id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "path": {
-                    "$ref": "#/definitions/dto.GeometryDTO"
-                },
-                "photo_urls": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
                 "status": {
                     "type": "string",
                     "example": "submitted"
@@ -855,21 +2751,6 @@ const docTemplate = `{
                 "title": {
                     "type": "string",
                     "example": "Jalan berlubang di depan SDN 01"
-                },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2025-10-20T10:00:00Z"
-                }
-            }
-        },
-        "dto.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
                 }
             }
         },
@@ -877,15 +2758,17 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "coordinates": {
+                    "description": "Coordinates is a single [lng, lat] pair for a Point and a list of pairs otherwise,\nwritten with a fixed number of decimal places",
                     "type": "array",
                     "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "number",
-                            "format": "float64"
-                        }
+                        "type": "number"
                     }
                 },
+                "srid": {
+                    "description": "SRID names the CRS of the coordinates when it is not WGS84 (EPSG:4326), e.g. 3857 for Web Mercator",
+                    "type": "integer",
+                    "example": 3857
+                },
                 "type": {
                     "type": "string",
                     "example": "LineString"
@@ -936,6 +2819,21 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.NearbyDamagedRoadsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DamagedRoadResponse"
+                    }
+                },
+                "radius_meters": {
+                    "type": "number",
+                    "example": 1000
+                }
+            }
+        },
         "dto.PaginationMeta": {
             "type": "object",
             "properties": {
@@ -943,6 +2841,11 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 20
                 },
+                "next_cursor": {
+                    "description": "NextCursor continues the listing after this page when passed as cursor. Only present when\nsorting by created_at and the page is full. Offset and Page are 0 in cursor mode",
+                    "type": "string",
+                    "example": "MjAyNS0xMC0yMFQxMDowMDowMFp8MTIzZTQ1NjctZTg5Yi0xMmQzLWE0NTYtNDI2NjE0MTc0MDAw"
+                },
                 "offset": {
                     "type": "integer",
                     "example": 0
@@ -954,6 +2857,11 @@ const docTemplate = `{
                 "total": {
                     "type": "integer",
                     "example": 100
+                },
+                "unfiltered_total": {
+                    "description": "UnfilteredTotal is the number of reports ignoring filters, only present when requested",
+                    "type": "integer",
+                    "example": 1234
                 }
             }
         },
@@ -969,170 +2877,450 @@ const docTemplate = `{
                 },
                 "new_password": {
                     "type": "string",
-                    "minLength": 8
+                    "minLength": 8
+                }
+            }
+        },
+        "dto.PasswordChangeResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PasswordResetConfirmRequest": {
+            "type": "object",
+            "required": [
+                "new_password",
+                "token"
+            ],
+            "properties": {
+                "new_password": {
+                    "type": "string",
+                    "minLength": 8
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PasswordResetConfirmResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PasswordResetRequestRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PasswordResetRequestResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PhotoValidationResult": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string",
+                    "example": "image/jpeg"
+                },
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "size_bytes": {
+                    "type": "integer",
+                    "example": 524288
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/photo1.jpg"
+                },
+                "valid": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "warning": {
+                    "type": "string",
+                    "example": "photo too old: last modified 2021-03-01, more than 365 days ago"
+                }
+            }
+        },
+        "dto.PointDTO": {
+            "type": "object",
+            "required": [
+                "lat",
+                "lng"
+            ],
+            "properties": {
+                "lat": {
+                    "type": "number",
+                    "example": -7.2575
+                },
+                "lng": {
+                    "type": "number",
+                    "example": 112.7521
+                }
+            }
+        },
+        "dto.RefreshTokenRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RefreshTokenResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "description": "in seconds",
+                    "type": "integer"
+                },
+                "refresh_token": {
+                    "description": "replaces the presented refresh token, which is now revoked",
+                    "type": "string"
+                },
+                "token_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RegistrationRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                }
+            }
+        },
+        "dto.RegistrationResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-10-20T10:00:00Z"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReportAuthorDTO": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "admin callers only",
+                    "type": "string",
+                    "example": "budi@example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Budi Santoso"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "user"
                 }
             }
         },
-        "dto.PasswordChangeResponse": {
+        "dto.ReportNoteListResponse": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReportNoteResponse"
+                    }
                 }
             }
         },
-        "dto.PasswordResetConfirmRequest": {
+        "dto.ReportNoteResponse": {
             "type": "object",
-            "required": [
-                "new_password",
-                "token"
-            ],
             "properties": {
-                "new_password": {
+                "author_id": {
                     "type": "string",
-                    "minLength": 8
+                    "example": "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
                 },
-                "token": {
-                    "type": "string"
+                "body": {
+                    "type": "string",
+                    "example": "Checked with the district office, repair is scheduled"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-10-20T10:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "4b1e4d2a-8c9f-4f7e-9a51-2f0c6d9e1a23"
+                },
+                "internal": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "report_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 }
             }
         },
-        "dto.PasswordResetConfirmResponse": {
+        "dto.ReportStatusEventResponse": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "changed_at": {
+                    "type": "string",
+                    "example": "2025-10-20T10:00:00Z"
+                },
+                "changed_by": {
+                    "type": "string",
+                    "example": "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+                },
+                "from_status": {
+                    "type": "string",
+                    "example": "verified"
+                },
+                "reason": {
+                    "description": "Reason explains the change; omitted when none was given",
+                    "type": "string",
+                    "example": "Pothole patched by the public works office"
+                },
+                "report_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "subdistrict_code": {
+                    "type": "string",
+                    "example": "35.10.02.2005"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Jalan berlubang di depan SDN 01"
+                },
+                "to_status": {
+                    "type": "string",
+                    "example": "resolved"
                 }
             }
         },
-        "dto.PasswordResetRequestRequest": {
+        "dto.RevokeDeviceSessionsResponse": {
             "type": "object",
-            "required": [
-                "email"
-            ],
             "properties": {
-                "email": {
+                "message": {
                     "type": "string"
+                },
+                "revoked_sessions": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.PasswordResetRequestResponse": {
+        "dto.SessionListResponse": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SessionResponse"
+                    }
                 }
             }
         },
-        "dto.PhotoValidationResult": {
+        "dto.SessionResponse": {
             "type": "object",
             "properties": {
-                "content_type": {
+                "created_at": {
                     "type": "string",
-                    "example": "image/jpeg"
+                    "example": "2025-10-20T10:00:00Z"
                 },
-                "error": {
+                "device_id": {
                     "type": "string",
-                    "example": ""
+                    "example": "pixel-7"
                 },
-                "size_bytes": {
-                    "type": "integer",
-                    "example": 524288
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-10-27T10:00:00Z"
                 },
-                "url": {
+                "id": {
                     "type": "string",
-                    "example": "https://example.com/photo1.jpg"
+                    "example": "8f14e45f-ceea-467f-a0e6-0d6b9c2f1a3e"
                 },
-                "valid": {
-                    "type": "boolean",
-                    "example": true
+                "ip_address": {
+                    "type": "string",
+                    "example": "203.0.113.10"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2025-10-21T08:30:00Z"
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0"
                 }
             }
         },
-        "dto.PointDTO": {
+        "dto.StatusChangeResponse": {
             "type": "object",
-            "required": [
-                "lat",
-                "lng"
-            ],
             "properties": {
-                "lat": {
-                    "type": "number",
-                    "maximum": 6,
-                    "minimum": -11,
-                    "example": -7.2575
+                "changed_at": {
+                    "type": "string",
+                    "example": "2025-10-20T10:00:00Z"
                 },
-                "lng": {
-                    "type": "number",
-                    "maximum": 141,
-                    "minimum": 95,
-                    "example": 112.7521
+                "changed_by": {
+                    "type": "string",
+                    "example": "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+                },
+                "from_status": {
+                    "type": "string",
+                    "example": "verified"
+                },
+                "reason": {
+                    "description": "Reason explains the change; omitted when none was given",
+                    "type": "string",
+                    "example": "Pothole patched by the public works office"
+                },
+                "to_status": {
+                    "type": "string",
+                    "example": "resolved"
                 }
             }
         },
-        "dto.RefreshTokenRequest": {
+        "dto.StatusHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StatusChangeResponse"
+                    }
+                }
+            }
+        },
+        "dto.SubDistrictsExistRequest": {
             "type": "object",
             "required": [
-                "refresh_token"
+                "codes"
             ],
             "properties": {
-                "refresh_token": {
-                    "type": "string"
+                "codes": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "35.10.02.2005",
+                        "35.10.02.2006"
+                    ]
                 }
             }
         },
-        "dto.RefreshTokenResponse": {
+        "dto.SubDistrictsExistResponse": {
             "type": "object",
             "properties": {
-                "access_token": {
-                    "type": "string"
-                },
-                "expires_in": {
-                    "description": "in seconds",
-                    "type": "integer"
+                "degraded": {
+                    "description": "boundary dataset not seeded; every code is missing",
+                    "type": "boolean",
+                    "example": false
                 },
-                "token_type": {
-                    "type": "string"
+                "results": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    }
                 }
             }
         },
-        "dto.RegistrationRequest": {
+        "dto.UpdateDamagedRoadPathRequest": {
             "type": "object",
             "required": [
-                "email",
-                "name",
-                "password"
+                "path_points"
             ],
             "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "password": {
-                    "type": "string",
-                    "minLength": 8
+                "path_points": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/dto.PointDTO"
+                    }
                 }
             }
         },
-        "dto.RegistrationResponse": {
+        "dto.UpdateDamagedRoadRequest": {
             "type": "object",
+            "required": [
+                "path_points",
+                "photo_urls",
+                "title"
+            ],
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "email": {
-                    "type": "string"
+                "description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Jalan berlubang sepanjang 50 meter"
                 },
-                "id": {
-                    "type": "string"
+                "path_points": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/dto.PointDTO"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "photo_urls": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "role": {
-                    "type": "string"
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Jalan berlubang di depan SDN 01"
                 }
             }
         },
@@ -1142,17 +3330,65 @@ const docTemplate = `{
                 "status"
             ],
             "properties": {
+                "reason": {
+                    "description": "Reason documents the outcome and is kept in the status history; the server may require it for some statuses",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Pothole patched by the public works office"
+                },
+                "resolution_photo_urls": {
+                    "description": "ResolutionPhotoURLs are proof-of-repair photos, only accepted when resolving",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "status": {
                     "type": "string",
                     "example": "under_verification"
                 }
             }
         },
+        "dto.UpdateUserPreferencesRequest": {
+            "type": "object",
+            "properties": {
+                "email_on_status_change": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "dto.UserExportResponse": {
+            "type": "object",
+            "properties": {
+                "auth_events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AuthEventExportDTO"
+                    }
+                },
+                "exported_at": {
+                    "type": "string",
+                    "example": "2025-10-20T10:00:00Z"
+                },
+                "profile": {
+                    "$ref": "#/definitions/dto.UserInfo"
+                },
+                "reports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DamagedRoadResponse"
+                    }
+                }
+            }
+        },
         "dto.UserInfo": {
             "type": "object",
             "properties": {
                 "created_at": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "2025-10-20T10:00:00Z"
                 },
                 "email": {
                     "type": "string"
@@ -1161,13 +3397,25 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "last_login": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "2025-10-21T08:30:00Z"
                 },
                 "name": {
                     "type": "string"
                 },
                 "role": {
-                    "type": "string"
+                    "description": "Role is read from the database when the response is built. In the login response it is\na snapshot; GET /users/me always returns the current role",
+                    "type": "string",
+                    "example": "user"
+                }
+            }
+        },
+        "dto.UserPreferencesResponse": {
+            "type": "object",
+            "properties": {
+                "email_on_status_change": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
@@ -1203,6 +3451,11 @@ const docTemplate = `{
                     "type": "number",
                     "example": 112.75209
                 },
+                "degraded": {
+                    "description": "boundary dataset not seeded; only national bounds checked",
+                    "type": "boolean",
+                    "example": false
+                },
                 "message": {
                     "type": "string",
                     "example": "Coordinates are valid"
@@ -1237,8 +3490,6 @@ const docTemplate = `{
             "properties": {
                 "photo_urls": {
                     "type": "array",
-                    "maxItems": 10,
-                    "minItems": 1,
                     "items": {
                         "type": "string"
                     },
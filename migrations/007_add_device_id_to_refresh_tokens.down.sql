@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_refresh_tokens_user_device;
+ALTER TABLE refresh_tokens DROP COLUMN IF EXISTS device_id;
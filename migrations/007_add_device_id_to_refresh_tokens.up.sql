@@ -0,0 +1,8 @@
+-- Migration: Track the client device each refresh token was issued to
+-- Purpose: Allow revoking every session belonging to a single device (e.g. "this phone")
+
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS device_id VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_device ON refresh_tokens(user_id, device_id);
+
+COMMENT ON COLUMN refresh_tokens.device_id IS 'Client-supplied device identifier from the X-Device-ID header at login';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_damaged_roads_status_changed_at;
+ALTER TABLE damaged_roads DROP COLUMN IF EXISTS status_changed_at;
@@ -0,0 +1,11 @@
+-- Migration: Track when a report last changed status
+-- Purpose: Per-status SLA tracking measures time spent in the current status
+
+ALTER TABLE damaged_roads ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+-- Best available approximation for existing reports
+UPDATE damaged_roads SET status_changed_at = updated_at;
+
+CREATE INDEX IF NOT EXISTS idx_damaged_roads_status_changed_at ON damaged_roads(status, status_changed_at);
+
+COMMENT ON COLUMN damaged_roads.status_changed_at IS 'Time of the last status transition, used for SLA breach detection';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_damaged_road_photos_road_kind;
+DELETE FROM damaged_road_photos WHERE kind = 'resolution';
+ALTER TABLE damaged_road_photos DROP CONSTRAINT IF EXISTS unique_photo_url;
+ALTER TABLE damaged_road_photos ADD CONSTRAINT unique_photo_url UNIQUE(road_id, url);
+ALTER TABLE damaged_road_photos DROP CONSTRAINT IF EXISTS valid_photo_kind;
+ALTER TABLE damaged_road_photos DROP COLUMN IF EXISTS kind;
@@ -0,0 +1,15 @@
+-- Migration: Distinguish proof-of-repair photos from the original evidence photos
+-- Purpose: Resolution photos are attached when a report is resolved and stored separately
+
+ALTER TABLE damaged_road_photos ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'evidence';
+
+ALTER TABLE damaged_road_photos
+    ADD CONSTRAINT valid_photo_kind CHECK (kind IN ('evidence', 'resolution'));
+
+-- The same URL may legitimately appear as both evidence and proof of repair
+ALTER TABLE damaged_road_photos DROP CONSTRAINT IF EXISTS unique_photo_url;
+ALTER TABLE damaged_road_photos ADD CONSTRAINT unique_photo_url UNIQUE(road_id, kind, url);
+
+CREATE INDEX IF NOT EXISTS idx_damaged_road_photos_road_kind ON damaged_road_photos(road_id, kind);
+
+COMMENT ON COLUMN damaged_road_photos.kind IS 'evidence: submitted with the report; resolution: proof of repair attached when resolving';
@@ -0,0 +1,16 @@
+-- Migration: Track refresh token rotation lineage
+-- Purpose: Detect reuse of an already-rotated refresh token (a sign of theft)
+
+-- family_id is shared by every token descending from the same login
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS family_id UUID;
+UPDATE refresh_tokens SET family_id = id WHERE family_id IS NULL;
+ALTER TABLE refresh_tokens ALTER COLUMN family_id SET NOT NULL;
+
+-- parent_id points at the token this one replaced during rotation
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES refresh_tokens(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family ON refresh_tokens(family_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_parent ON refresh_tokens(parent_id);
+
+COMMENT ON COLUMN refresh_tokens.family_id IS 'Shared by all tokens rotated from the same login';
+COMMENT ON COLUMN refresh_tokens.parent_id IS 'Token this one replaced; a revoked token with a child was rotated, not logged out';
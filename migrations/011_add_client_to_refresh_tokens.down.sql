@@ -0,0 +1,2 @@
+ALTER TABLE refresh_tokens DROP COLUMN IF EXISTS user_agent;
+ALTER TABLE refresh_tokens DROP COLUMN IF EXISTS ip_address;
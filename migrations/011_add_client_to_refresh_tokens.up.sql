@@ -0,0 +1,8 @@
+-- Migration: Record the client each refresh token was issued to
+-- Purpose: Let users see where they are logged in (GET /auth/sessions)
+
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS ip_address VARCHAR(45);
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS user_agent TEXT;
+
+COMMENT ON COLUMN refresh_tokens.ip_address IS 'Client IP address at login or refresh; NULL for tokens issued before it was recorded';
+COMMENT ON COLUMN refresh_tokens.user_agent IS 'Client User-Agent header at login or refresh';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_admin_audit_log_action;
+DROP INDEX IF EXISTS idx_admin_audit_log_created_at;
+DROP INDEX IF EXISTS idx_admin_audit_log_admin_id;
+DROP TABLE IF EXISTS admin_audit_log;
@@ -0,0 +1,22 @@
+-- Migration: Audit trail of admin actions
+-- Purpose: Record who performed each /admin mutation, on what and with which outcome
+
+CREATE TABLE IF NOT EXISTS admin_audit_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    admin_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    action VARCHAR(100) NOT NULL,
+    target TEXT,
+    method VARCHAR(10) NOT NULL,
+    path TEXT NOT NULL,
+    status_code INTEGER NOT NULL,
+    ip_address VARCHAR(45),
+    user_agent TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_admin_audit_log_admin_id ON admin_audit_log(admin_id);
+CREATE INDEX idx_admin_audit_log_created_at ON admin_audit_log(created_at);
+CREATE INDEX idx_admin_audit_log_action ON admin_audit_log(action);
+
+COMMENT ON COLUMN admin_audit_log.target IS 'Identifier of the affected resource (user ID, report ID, ...); NULL for actions without a single target';
+COMMENT ON COLUMN admin_audit_log.status_code IS 'HTTP status of the response, so failed and rejected attempts are audited too';
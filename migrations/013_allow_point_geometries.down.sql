@@ -0,0 +1,15 @@
+ALTER TABLE damaged_roads DROP CONSTRAINT IF EXISTS valid_path_geometry;
+
+-- Points become zero-length lines and MultiPoints lines through their points. Zero-length
+-- lines are not valid geometries, so the restored constraint only applies to new rows
+ALTER TABLE damaged_roads ALTER COLUMN path TYPE GEOMETRY(LINESTRING, 4326)
+    USING CASE ST_GeometryType(path)
+        WHEN 'ST_Point' THEN ST_MakeLine(path, path)
+        WHEN 'ST_MultiPoint' THEN ST_LineFromMultiPoint(path)
+        ELSE path
+    END;
+
+ALTER TABLE damaged_roads ADD CONSTRAINT valid_path_geometry
+    CHECK (ST_IsValid(path) AND ST_GeometryType(path) = 'ST_LineString') NOT VALID;
+
+COMMENT ON COLUMN damaged_roads.path IS NULL;
@@ -0,0 +1,11 @@
+-- Migration: Accept Point and MultiPoint report geometries
+-- Purpose: A single pothole is stored as a Point instead of a degenerate LineString
+
+ALTER TABLE damaged_roads DROP CONSTRAINT IF EXISTS valid_path_geometry;
+
+ALTER TABLE damaged_roads ALTER COLUMN path TYPE GEOMETRY(GEOMETRY, 4326);
+
+ALTER TABLE damaged_roads ADD CONSTRAINT valid_path_geometry
+    CHECK (ST_IsValid(path) AND ST_GeometryType(path) IN ('ST_Point', 'ST_LineString', 'ST_MultiPoint'));
+
+COMMENT ON COLUMN damaged_roads.path IS 'Report location: Point for a single spot, LineString for a stretch of road, MultiPoint for separate spots';
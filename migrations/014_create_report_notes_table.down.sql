@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_report_notes_report_id;
+DROP TABLE IF EXISTS report_notes;
@@ -0,0 +1,15 @@
+-- Migration: Verifier notes on damaged road reports
+-- Purpose: Working notes left by staff during verification, separate from status changes
+
+CREATE TABLE IF NOT EXISTS report_notes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    report_id UUID NOT NULL REFERENCES damaged_roads(id) ON DELETE CASCADE,
+    author_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    internal BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_report_notes_report_id ON report_notes(report_id, created_at);
+
+COMMENT ON COLUMN report_notes.internal IS 'Internal notes are only shown to verificators and admins, never to the public or the reporter';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_report_status_history_report_id;
+DROP TABLE IF EXISTS report_status_history;
@@ -0,0 +1,15 @@
+-- Migration: Status transition history of damaged road reports
+-- Purpose: Keep who changed a report's status and when, for disputes over resolved markings
+
+CREATE TABLE IF NOT EXISTS report_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    report_id UUID NOT NULL REFERENCES damaged_roads(id) ON DELETE CASCADE,
+    from_status VARCHAR(50) NOT NULL,
+    to_status VARCHAR(50) NOT NULL,
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_report_status_history_report_id ON report_status_history(report_id, changed_at);
+
+COMMENT ON TABLE report_status_history IS 'Written in the same transaction as the status update on damaged_roads';
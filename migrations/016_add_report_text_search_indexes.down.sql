@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_damaged_roads_description_trgm;
+DROP INDEX IF EXISTS idx_damaged_roads_title_trgm;
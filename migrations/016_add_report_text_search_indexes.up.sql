@@ -0,0 +1,7 @@
+-- Migration: Indexes for free-text search on reports
+-- Purpose: Keep case-insensitive substring search (ILIKE '%...%') on title and description fast
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_damaged_roads_title_trgm ON damaged_roads USING GIN (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_damaged_roads_description_trgm ON damaged_roads USING GIN (description gin_trgm_ops);
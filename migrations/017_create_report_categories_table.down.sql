@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_damaged_roads_category_id;
+ALTER TABLE damaged_roads DROP COLUMN IF EXISTS category_id;
+DROP TABLE IF EXISTS report_categories;
@@ -0,0 +1,24 @@
+-- Migration: Report category taxonomy
+-- Purpose: Managed list of damage types reports are classified by, referenced by damaged_roads.category_id
+
+CREATE TABLE IF NOT EXISTS report_categories (
+    id VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO report_categories (id, name, description, sort_order) VALUES
+    ('pothole', 'Pothole', 'Holes in the road surface', 10),
+    ('cracking', 'Cracking', 'Cracked or broken asphalt', 20),
+    ('subsidence', 'Subsidence', 'Sunken or collapsed road sections', 30),
+    ('flooding', 'Flooding', 'Standing water or drainage damage', 40),
+    ('other', 'Other', 'Damage that fits no other category', 100)
+ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE damaged_roads ADD COLUMN IF NOT EXISTS category_id VARCHAR(50) REFERENCES report_categories(id);
+
+CREATE INDEX idx_damaged_roads_category_id ON damaged_roads(category_id);
+
+COMMENT ON COLUMN damaged_roads.category_id IS 'Damage category; NULL for reports created before categories or without one';
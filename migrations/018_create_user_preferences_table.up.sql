@@ -0,0 +1,10 @@
+-- Migration: Per-user notification preferences
+-- Purpose: Opt-outs for notification emails; users without a row get the defaults
+
+CREATE TABLE IF NOT EXISTS user_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    email_on_status_change BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN user_preferences.email_on_status_change IS 'Email the author when one of their reports changes status';
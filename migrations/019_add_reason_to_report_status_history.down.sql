@@ -0,0 +1 @@
+ALTER TABLE report_status_history DROP COLUMN IF EXISTS reason;
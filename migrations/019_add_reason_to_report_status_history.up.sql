@@ -0,0 +1,6 @@
+-- Migration: Reason for a status transition
+-- Purpose: Document the outcome of terminal statuses such as resolved; required per status by configuration
+
+ALTER TABLE report_status_history ADD COLUMN IF NOT EXISTS reason TEXT;
+
+COMMENT ON COLUMN report_status_history.reason IS 'Why the status was changed; NULL for transitions made without one';
@@ -0,0 +1,5 @@
+-- Migration: Expiry indexes on token tables
+-- Purpose: Let the batched expired token cleanup find each batch without scanning the whole table
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_expires_at ON refresh_tokens(expires_at);
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_expires_at ON password_reset_tokens(expires_at);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_damaged_roads_created_at_id;
@@ -0,0 +1,4 @@
+-- Migration: Keyset index on damaged_roads
+-- Purpose: Serve cursor pagination, which orders and seeks by (created_at, id)
+
+CREATE INDEX IF NOT EXISTS idx_damaged_roads_created_at_id ON damaged_roads(created_at DESC, id DESC);
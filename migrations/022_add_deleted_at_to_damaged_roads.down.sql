@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_damaged_roads_deleted_at;
+ALTER TABLE damaged_roads DROP COLUMN IF EXISTS deleted_at;
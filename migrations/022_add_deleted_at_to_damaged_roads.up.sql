@@ -0,0 +1,6 @@
+-- Migration: Soft delete for damaged_roads
+-- Purpose: Keep deleted reports for auditing, statistics and recovery; reads skip rows with deleted_at set
+
+ALTER TABLE damaged_roads ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_damaged_roads_deleted_at ON damaged_roads(deleted_at) WHERE deleted_at IS NOT NULL;
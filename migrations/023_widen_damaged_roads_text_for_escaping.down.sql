@@ -0,0 +1,22 @@
+-- Rows stored longer than the old limits, such as escaped text, cannot fit the narrower column and
+-- constraints. Refuse with a clear message rather than cut report text (or an escape sequence) short
+
+DO $$
+DECLARE
+    too_long INTEGER;
+BEGIN
+    SELECT COUNT(*) INTO too_long FROM damaged_roads
+    WHERE LENGTH(title) > 100 OR LENGTH(description) > 500;
+
+    IF too_long > 0 THEN
+        RAISE EXCEPTION '% damaged_roads rows have a title over 100 or a description over 500 characters; shorten them before rolling back', too_long;
+    END IF;
+END $$;
+
+ALTER TABLE damaged_roads DROP CONSTRAINT IF EXISTS valid_title_length;
+ALTER TABLE damaged_roads DROP CONSTRAINT IF EXISTS valid_description_length;
+
+ALTER TABLE damaged_roads ALTER COLUMN title TYPE VARCHAR(100);
+
+ALTER TABLE damaged_roads ADD CONSTRAINT valid_title_length CHECK (LENGTH(title) >= 3 AND LENGTH(title) <= 100);
+ALTER TABLE damaged_roads ADD CONSTRAINT valid_description_length CHECK (description IS NULL OR LENGTH(description) <= 500);
@@ -0,0 +1,12 @@
+-- Migration: Room for escaped report text
+-- Purpose: With REPORT_TEXT_SANITIZATION=escape, angle brackets are stored as &lt; and &gt;, so a title or
+-- description within the 100/500 character limit can be up to four times longer once stored.
+-- The limits on the text as typed are enforced by the application
+
+ALTER TABLE damaged_roads DROP CONSTRAINT IF EXISTS valid_title_length;
+ALTER TABLE damaged_roads DROP CONSTRAINT IF EXISTS valid_description_length;
+
+ALTER TABLE damaged_roads ALTER COLUMN title TYPE VARCHAR(400);
+
+ALTER TABLE damaged_roads ADD CONSTRAINT valid_title_length CHECK (LENGTH(title) >= 3 AND LENGTH(title) <= 400);
+ALTER TABLE damaged_roads ADD CONSTRAINT valid_description_length CHECK (description IS NULL OR LENGTH(description) <= 2000);
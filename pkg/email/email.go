@@ -0,0 +1,130 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"net/url"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// Message is a rendered email with an HTML body and its plain-text fallback
+type Message struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// data is what every template is rendered with; fields unused by an email are left empty
+type data struct {
+	Subject     string
+	Name        string
+	Token       string
+	Link        string
+	ReportTitle string
+	Status      string
+}
+
+// emailTemplate pairs the HTML and plain-text variant of one email
+type emailTemplate struct {
+	subject string
+	html    *htmltemplate.Template
+	text    *texttemplate.Template
+}
+
+// Renderer renders the transactional emails, building links into the frontend
+type Renderer struct {
+	frontendBaseURL *url.URL
+	passwordReset   emailTemplate
+	welcome         emailTemplate
+	passwordChanged emailTemplate
+	statusChanged   emailTemplate
+}
+
+// NewRenderer parses the embedded templates. frontendBaseURL is the absolute URL of the web app,
+// e.g. https://app.jalanrusak.id, that links in emails point to
+func NewRenderer(frontendBaseURL string) (*Renderer, error) {
+	base, err := url.Parse(strings.TrimRight(frontendBaseURL, "/"))
+	if err != nil || (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return nil, fmt.Errorf("invalid frontend base URL %q: must be an absolute http(s) URL", frontendBaseURL)
+	}
+
+	r := &Renderer{frontendBaseURL: base}
+	for _, t := range []struct {
+		target  *emailTemplate
+		name    string
+		subject string
+	}{
+		{&r.passwordReset, "password_reset", "Reset Your Password"},
+		{&r.welcome, "welcome", "Welcome to JalanRusak!"},
+		{&r.passwordChanged, "password_changed", "Your Password Was Changed"},
+		{&r.statusChanged, "report_status_changed", "Your Report Status Was Updated"},
+	} {
+		html, err := htmltemplate.ParseFS(templateFS, "templates/layout.html", "templates/"+t.name+".html")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s HTML template: %w", t.name, err)
+		}
+		text, err := texttemplate.ParseFS(templateFS, "templates/"+t.name+".txt")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s text template: %w", t.name, err)
+		}
+		*t.target = emailTemplate{subject: t.subject, html: html, text: text}
+	}
+
+	return r, nil
+}
+
+// PasswordReset renders the password reset email with a link carrying the reset token
+func (r *Renderer) PasswordReset(name, token string) (*Message, error) {
+	return r.render(r.passwordReset, data{Name: name, Token: token, Link: r.link("/reset", url.Values{"token": {token}})})
+}
+
+// Welcome renders the welcome email sent after registration
+func (r *Renderer) Welcome(name string) (*Message, error) {
+	return r.render(r.welcome, data{Name: name, Link: r.link("", nil)})
+}
+
+// PasswordChanged renders the notification sent after a password change or reset
+func (r *Renderer) PasswordChanged(name string) (*Message, error) {
+	return r.render(r.passwordChanged, data{Name: name})
+}
+
+// ReportStatusChanged renders the notification sent to a report's author when its status changes
+func (r *Renderer) ReportStatusChanged(name, reportTitle, status, reportID string) (*Message, error) {
+	return r.render(r.statusChanged, data{
+		Name:        name,
+		ReportTitle: reportTitle,
+		Status:      status,
+		Link:        r.link("/reports/"+url.PathEscape(reportID), nil),
+	})
+}
+
+// link builds an absolute frontend URL for path with the given query
+func (r *Renderer) link(path string, query url.Values) string {
+	link := *r.frontendBaseURL
+	link.Path += path
+	link.RawQuery = query.Encode()
+	return link.String()
+}
+
+// render executes both variants of a template
+func (r *Renderer) render(t emailTemplate, d data) (*Message, error) {
+	d.Subject = t.subject
+
+	var html bytes.Buffer
+	if err := t.html.ExecuteTemplate(&html, "layout", d); err != nil {
+		return nil, fmt.Errorf("failed to render %q HTML: %w", t.subject, err)
+	}
+
+	var text bytes.Buffer
+	if err := t.text.Execute(&text, d); err != nil {
+		return nil, fmt.Errorf("failed to render %q text: %w", t.subject, err)
+	}
+
+	return &Message{Subject: t.subject, Text: text.String(), HTML: html.String()}, nil
+}
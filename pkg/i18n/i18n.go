@@ -0,0 +1,114 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Supported locales
+const (
+	// LocaleEnglish is the language messages are written in
+	LocaleEnglish = "en"
+	// LocaleIndonesian is the primary language of the user base
+	LocaleIndonesian = "id"
+)
+
+// messages maps a locale to localized messages keyed by error code (ErrorResponse.Error).
+// English is the source language and needs no entries. Codes whose message carries
+// request-specific details (validation_error, coordinates_out_of_bounds, ...) are left out
+// so the detailed message is kept.
+var messages = map[string]map[string]string{
+	LocaleIndonesian: {
+		"internal_error":             "Terjadi kesalahan pada server, silakan coba lagi nanti",
+		"unauthorized":               "Autentikasi diperlukan",
+		"forbidden":                  "Anda tidak memiliki izin untuk mengakses sumber daya ini",
+		"not_found":                  "Data tidak ditemukan",
+		"invalid_id":                 "Format ID tidak valid",
+		"invalid_request":            "Isi permintaan tidak valid",
+		"missing_token":              "Header Authorization wajib diisi",
+		"invalid_token_format":       "Header Authorization harus berformat: Bearer <token>",
+		"invalid_token":              "Token tidak valid atau sudah kedaluwarsa",
+		"token_expired":              "Token sudah kedaluwarsa",
+		"token_revoked":              "Token akses sudah dicabut",
+		"token_reused":               "Token refresh sudah pernah dipakai, semua sesi telah dicabut demi keamanan",
+		"invalid_credentials":        "Email atau kata sandi salah",
+		"invalid_email":              "Format email tidak valid",
+		"invalid_password":           "Kata sandi saat ini salah",
+		"weak_password":              "Kata sandi minimal 8 karakter dan harus mengandung huruf besar, huruf kecil, dan angka",
+		"user_already_exists":        "Pengguna dengan email ini sudah terdaftar",
+		"user_not_found":             "Pengguna tidak ditemukan",
+		"session_not_found":          "Sesi tidak ditemukan",
+		"invalid_status":             "Nilai status tidak valid",
+		"report_not_editable":        "Laporan hanya dapat diubah selama masih berstatus submitted",
+		"edit_window_expired":        "Batas waktu untuk mengubah laporan sudah lewat, hubungi admin untuk perubahan",
+		"resolution_photos_required": "Foto bukti perbaikan wajib dilampirkan untuk menyelesaikan laporan",
+		"subdistrict_not_found":      "Kode kelurahan/desa tidak ditemukan",
+		"location_mismatch":          "Koordinat tidak berada di wilayah kelurahan/desa yang dipilih",
+		"csrf_origin_mismatch":       "Permintaan dengan cookie harus berasal dari origin yang diizinkan",
+		"too_soon":                   "Laporan terlalu cepat setelah laporan sebelumnya, silakan tunggu sebentar",
+	},
+}
+
+// IsSupported reports whether locale has a message catalog (or is the source language)
+func IsSupported(locale string) bool {
+	if locale == LocaleEnglish {
+		return true
+	}
+	_, ok := messages[locale]
+	return ok
+}
+
+// Message returns the localized message for an error code, and false when the locale
+// has no translation for it and the original message should be kept
+func Message(locale, code string) (string, bool) {
+	message, ok := messages[locale][code]
+	return message, ok
+}
+
+// Negotiate picks the best supported locale from an Accept-Language header value,
+// e.g. "id-ID,id;q=0.9,en;q=0.8". Returns fallback when nothing supported is listed.
+func Negotiate(acceptLanguage, fallback string) string {
+	type candidate struct {
+		locale string
+		q      float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+
+		// Match on the primary subtag; "in" is the legacy code for Indonesian
+		primary, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		if primary == "in" {
+			primary = LocaleIndonesian
+		}
+		if IsSupported(primary) {
+			candidates = append(candidates, candidate{locale: primary, q: q})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return fallback
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	return candidates[0].locale
+}